@@ -126,6 +126,12 @@ const docTemplate = `{
                         "name": "hash",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Return balances at the end of the block",
+                        "name": "at_height",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -153,18 +159,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/address/{hash}/txs": {
+        "/v1/address/{hash}/balance/history": {
             "get": {
-                "description": "Get address transactions",
+                "description": "Get changes of address balances",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "address"
                 ],
-                "summary": "Get address transactions",
-                "operationId": "address-transactions",
+                "summary": "Get address balance history",
+                "operationId": "address-balance-history",
                 "parameters": [
+                    {
+                        "maxLength": 48,
+                        "minLength": 48,
+                        "type": "string",
+                        "description": "Hash",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "maximum": 100,
                         "type": "integer",
@@ -189,69 +204,9 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "success",
-                            "failed"
-                        ],
-                        "type": "string",
-                        "description": "Comma-separated status list",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "MsgUnknown",
-                            "MsgSetWithdrawAddress",
-                            "MsgWithdrawDelegatorReward",
-                            "MsgWithdrawValidatorCommission",
-                            "MsgFundCommunityPool",
-                            "MsgCreateValidator",
-                            "MsgEditValidator",
-                            "MsgDelegate",
-                            "MsgBeginRedelegate",
-                            "MsgUndelegate",
-                            "MsgCancelUnbondingDelegation",
-                            "MsgUnjail",
-                            "MsgSend",
-                            "MsgMultiSend",
-                            "MsgCreateVestingAccount",
-                            "MsgCreatePermanentLockedAccount",
-                            "MsgCreatePeriodicVestingAccount",
-                            "MsgPayForBlobs",
-                            "MsgGrant",
-                            "MsgExec",
-                            "MsgRevoke",
-                            "MsgGrantAllowance",
-                            "MsgRevokeAllowance",
-                            "MsgRegisterEVMAddress",
-                            "MsgSubmitProposal",
-                            "MsgExecLegacyContent",
-                            "MsgVote",
-                            "MsgVoteWeighted",
-                            "MsgDeposit",
-                            "IBCTransfer"
-                        ],
                         "type": "string",
-                        "description": "Comma-separated message types list",
-                        "name": "msg_type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Time from in unix timestamp",
-                        "name": "from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Time to in unix timestamp",
-                        "name": "to",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Block number",
-                        "name": "height",
+                        "description": "Currency",
+                        "name": "currency",
                         "in": "query"
                     }
                 ],
@@ -261,7 +216,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.Tx"
+                                "$ref": "#/definitions/responses.BalanceUpdate"
                             }
                         }
                     },
@@ -280,18 +235,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/block": {
+        "/v1/address/{hash}/delegations": {
             "get": {
-                "description": "List blocks info",
+                "description": "Get current delegations of the address",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "block"
+                    "address"
                 ],
-                "summary": "List blocks info",
-                "operationId": "list-block",
+                "summary": "Get address delegations",
+                "operationId": "address-delegations",
                 "parameters": [
+                    {
+                        "maxLength": 48,
+                        "minLength": 48,
+                        "type": "string",
+                        "description": "Hash",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "maximum": 100,
                         "type": "integer",
@@ -304,22 +268,6 @@ const docTemplate = `{
                         "description": "Offset",
                         "name": "offset",
                         "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "description": "Sort order",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Need join stats for block",
-                        "name": "stats",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -328,7 +276,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.Block"
+                                "$ref": "#/definitions/responses.Delegation"
                             }
                         }
                     },
@@ -347,57 +295,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/block/count": {
-            "get": {
-                "description": "Get count of blocks in network",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "block"
-                ],
-                "summary": "Get count of blocks in network",
-                "operationId": "get-block-count",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/handler.Error"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/block/{height}": {
+        "/v1/address/{hash}/fee_allowances": {
             "get": {
-                "description": "Get block info",
+                "description": "Get active fee allowances where the address is granter or grantee",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "block"
+                    "address"
                 ],
-                "summary": "Get block info",
-                "operationId": "get-block",
+                "summary": "Get address fee allowances",
+                "operationId": "address-fee-allowances",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "Block height",
-                        "name": "height",
+                        "maxLength": 48,
+                        "minLength": 48,
+                        "type": "string",
+                        "description": "Hash",
+                        "name": "hash",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Need join stats for block",
-                        "name": "stats",
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
                         "in": "query"
                     }
                 ],
@@ -405,12 +334,12 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.Block"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.FeeAllowance"
+                            }
                         }
                     },
-                    "204": {
-                        "description": "No Content"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -426,25 +355,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/block/{height}/events": {
+        "/v1/address/{hash}/grants": {
             "get": {
-                "description": "Get events from begin and end of block",
+                "description": "Get active authz grants where the address is granter or grantee. Spend limit is the value at grant time.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "block"
+                    "address"
                 ],
-                "summary": "Get events from begin and end of block",
-                "operationId": "get-block-events",
+                "summary": "Get address grants",
+                "operationId": "address-grants",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "Block height",
-                        "name": "height",
+                        "maxLength": 48,
+                        "minLength": 48,
+                        "type": "string",
+                        "description": "Hash",
+                        "name": "hash",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -453,7 +396,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.Event"
+                                "$ref": "#/definitions/responses.Grant"
                             }
                         }
                     },
@@ -472,23 +415,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/block/{height}/namespace": {
+        "/v1/address/{hash}/redelegations": {
             "get": {
-                "description": "Get namesapces affected in the block",
+                "description": "Get redelegations of the address",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "block"
+                    "address"
                 ],
-                "summary": "Get namesapces affected in the block",
-                "operationId": "get-block-namespaces",
+                "summary": "Get address redelegations",
+                "operationId": "address-redelegations",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "Block height",
-                        "name": "height",
+                        "maxLength": 48,
+                        "minLength": 48,
+                        "type": "string",
+                        "description": "Hash",
+                        "name": "hash",
                         "in": "path",
                         "required": true
                     },
@@ -512,7 +456,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.NamespaceMessage"
+                                "$ref": "#/definitions/responses.Redelegation"
                             }
                         }
                     },
@@ -531,69 +475,134 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/block/{height}/namespace/count": {
+        "/v1/address/{hash}/txs": {
             "get": {
-                "description": "Get count of affected in the block namespaces",
+                "description": "Get address transactions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "block"
+                    "address"
                 ],
-                "summary": "Get count of affected in the block namespaces",
-                "operationId": "get-block-namespaces-count",
+                "summary": "Get address transactions",
+                "operationId": "address-transactions",
                 "parameters": [
                     {
-                        "minimum": 1,
+                        "maximum": 100,
                         "type": "integer",
-                        "description": "Block height",
-                        "name": "height",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "integer"
-                        }
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/handler.Error"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/block/{height}/stats": {
-            "get": {
-                "description": "Get block stats by height",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "block"
-                ],
-                "summary": "Get block stats by height",
-                "operationId": "get-block-stats",
-                "parameters": [
                     {
-                        "minimum": 1,
                         "type": "integer",
-                        "description": "Block height",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Comma-separated status list",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "MsgUnknown",
+                            "MsgSetWithdrawAddress",
+                            "MsgWithdrawDelegatorReward",
+                            "MsgWithdrawValidatorCommission",
+                            "MsgFundCommunityPool",
+                            "MsgCreateValidator",
+                            "MsgEditValidator",
+                            "MsgDelegate",
+                            "MsgBeginRedelegate",
+                            "MsgUndelegate",
+                            "MsgCancelUnbondingDelegation",
+                            "MsgUnjail",
+                            "MsgSend",
+                            "MsgMultiSend",
+                            "MsgCreateVestingAccount",
+                            "MsgCreatePermanentLockedAccount",
+                            "MsgCreatePeriodicVestingAccount",
+                            "MsgPayForBlobs",
+                            "MsgGrant",
+                            "MsgExec",
+                            "MsgRevoke",
+                            "MsgGrantAllowance",
+                            "MsgRevokeAllowance",
+                            "MsgRegisterEVMAddress",
+                            "MsgSubmitProposal",
+                            "MsgExecLegacyContent",
+                            "MsgVote",
+                            "MsgVoteWeighted",
+                            "MsgDeposit",
+                            "IBCTransfer",
+                            "MsgCreateClient",
+                            "MsgUpdateClient",
+                            "MsgUpgradeClient",
+                            "MsgSubmitMisbehaviour",
+                            "MsgConnectionOpenInit",
+                            "MsgConnectionOpenTry",
+                            "MsgConnectionOpenAck",
+                            "MsgConnectionOpenConfirm",
+                            "MsgChannelOpenInit",
+                            "MsgChannelOpenTry",
+                            "MsgChannelOpenAck",
+                            "MsgChannelOpenConfirm",
+                            "MsgChannelCloseInit",
+                            "MsgChannelCloseConfirm",
+                            "MsgRecvPacket",
+                            "MsgTimeout",
+                            "MsgTimeoutOnClose",
+                            "MsgAcknowledgement"
+                        ],
+                        "type": "string",
+                        "description": "Comma-separated message types list",
+                        "name": "msg_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Block number",
                         "name": "height",
-                        "in": "path",
-                        "required": true
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.BlockStats"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Tx"
+                            }
                         }
                     },
                     "400": {
@@ -611,57 +620,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/constants": {
+        "/v1/address/{hash}/unbondings": {
             "get": {
-                "description": "Get network constants",
+                "description": "Get unbonding delegation entries of the address",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "general"
+                    "address"
                 ],
-                "summary": "Get network constants",
-                "operationId": "get-constants",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/responses.Constants"
-                        }
+                "summary": "Get address unbondings",
+                "operationId": "address-unbondings",
+                "parameters": [
+                    {
+                        "maxLength": 48,
+                        "minLength": 48,
+                        "type": "string",
+                        "description": "Hash",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
                     },
-                    "204": {
-                        "description": "No Content"
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/handler.Error"
-                        }
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
                     }
-                }
-            }
-        },
-        "/v1/head": {
-            "get": {
-                "description": "Get current indexer head",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "general"
                 ],
-                "summary": "Get current indexer head",
-                "operationId": "head",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.State"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Unbonding"
+                            }
                         }
                     },
-                    "204": {
-                        "description": "No Content"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -677,17 +680,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/namespace": {
+        "/v1/block": {
             "get": {
-                "description": "List namespace info",
+                "description": "List blocks info",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "namespace"
+                    "block"
                 ],
-                "summary": "List namespace info",
-                "operationId": "list-namespace",
+                "summary": "List blocks info",
+                "operationId": "list-block",
                 "parameters": [
                     {
                         "maximum": 100,
@@ -711,6 +714,12 @@ const docTemplate = `{
                         "description": "Sort order",
                         "name": "sort",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Need join stats for block",
+                        "name": "stats",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -719,7 +728,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.Namespace"
+                                "$ref": "#/definitions/responses.Block"
                             }
                         }
                     },
@@ -738,25 +747,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/namespace/active": {
+        "/v1/block/count": {
             "get": {
-                "description": "Get last used namespace",
+                "description": "Get count of blocks in network",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "namespace"
+                    "block"
                 ],
-                "summary": "Get last used namespace",
-                "operationId": "get-namespace-active",
+                "summary": "Get count of blocks in network",
+                "operationId": "get-block-count",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/responses.ActiveNamespace"
-                            }
+                            "type": "integer"
                         }
                     },
                     "500": {
@@ -768,22 +774,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/namespace/count": {
+        "/v1/block/{height}": {
             "get": {
-                "description": "Get count of namespaces in network",
+                "description": "Get block info",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "namespace"
+                    "block"
+                ],
+                "summary": "Get block info",
+                "operationId": "get-block",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Block height",
+                        "name": "height",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Need join stats for block",
+                        "name": "stats",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get count of namespaces in network",
-                "operationId": "get-namespace-count",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "integer"
+                            "$ref": "#/definitions/responses.Block"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
                         }
                     },
                     "500": {
@@ -795,24 +826,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/namespace/{id}": {
+        "/v1/block/{height}/events": {
             "get": {
-                "description": "Returns array of namespace versions",
+                "description": "Get events from begin and end of block",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "namespace"
+                    "block"
                 ],
-                "summary": "Get namespace info",
-                "operationId": "get-namespace",
+                "summary": "Get events from begin and end of block",
+                "operationId": "get-block-events",
                 "parameters": [
                     {
-                        "maxLength": 56,
-                        "minLength": 56,
-                        "type": "string",
-                        "description": "Namespace id in hexadecimal",
-                        "name": "id",
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Block height",
+                        "name": "height",
                         "in": "path",
                         "required": true
                     }
@@ -823,7 +853,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.Namespace"
+                                "$ref": "#/definitions/responses.Event"
                             }
                         }
                     },
@@ -842,45 +872,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/namespace/{id}/{version}": {
+        "/v1/block/{height}/namespace": {
             "get": {
-                "description": "Returns namespace by version byte and namespace id",
+                "description": "Get namesapces affected in the block",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "namespace"
+                    "block"
                 ],
-                "summary": "Get namespace info by id and version",
-                "operationId": "get-namespace-by-version-and-id",
+                "summary": "Get namesapces affected in the block",
+                "operationId": "get-block-namespaces",
                 "parameters": [
                     {
-                        "maxLength": 56,
-                        "minLength": 56,
-                        "type": "string",
-                        "description": "Namespace id in hexadecimal",
-                        "name": "id",
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Block height",
+                        "name": "height",
                         "in": "path",
                         "required": true
                     },
                     {
+                        "maximum": 100,
                         "type": "integer",
-                        "description": "Version of namespace",
-                        "name": "version",
-                        "in": "path",
-                        "required": true
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.Namespace"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.NamespaceMessage"
+                            }
                         }
                     },
-                    "204": {
-                        "description": "No Content"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -896,65 +931,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/namespace/{id}/{version}/messages": {
+        "/v1/block/{height}/namespace/count": {
             "get": {
-                "description": "Returns namespace messages by version byte and namespace id",
+                "description": "Get count of affected in the block namespaces",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "namespace"
+                    "block"
                 ],
-                "summary": "Get namespace messages by id and version",
-                "operationId": "get-namespace-messages",
+                "summary": "Get count of affected in the block namespaces",
+                "operationId": "get-block-namespaces-count",
                 "parameters": [
                     {
-                        "maxLength": 56,
-                        "minLength": 56,
-                        "type": "string",
-                        "description": "Namespace id in hexadecimal",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "Version of namespace",
-                        "name": "version",
+                        "description": "Block height",
+                        "name": "height",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "maximum": 100,
-                        "type": "integer",
-                        "description": "Count of requested entities",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/responses.NamespaceMessage"
-                            }
-                        }
-                    },
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/handler.Error"
+                            "type": "integer"
                         }
                     },
                     "500": {
@@ -966,22 +968,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/namespace_by_hash/{hash}": {
+        "/v1/block/{height}/stats": {
             "get": {
-                "description": "Returns namespace by base64 encoded identity",
+                "description": "Get block stats by height",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "namespace"
+                    "block"
                 ],
-                "summary": "Get namespace info by base64",
-                "operationId": "get-namespace-base64",
+                "summary": "Get block stats by height",
+                "operationId": "get-block-stats",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Base64-encoded namespace id and version",
-                        "name": "hash",
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Block height",
+                        "name": "height",
                         "in": "path",
                         "required": true
                     }
@@ -990,12 +993,9 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.Namespace"
+                            "$ref": "#/definitions/responses.BlockStats"
                         }
                     },
-                    "204": {
-                        "description": "No Content"
-                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1011,46 +1011,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/namespace_by_hash/{hash}/{height}": {
+        "/v1/constants": {
             "get": {
-                "description": "Returns blobs",
+                "description": "Get network constants",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "namespace"
-                ],
-                "summary": "Get namespace blobs on height",
-                "operationId": "get-namespace-blobs",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Base64-encoded namespace id and version",
-                        "name": "hash",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "Block heigth",
-                        "name": "height",
-                        "in": "path",
-                        "required": true
-                    }
+                    "general"
                 ],
+                "summary": "Get network constants",
+                "operationId": "get-constants",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/responses.Blob"
-                            }
+                            "$ref": "#/definitions/responses.Constants"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
@@ -1058,46 +1041,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/namespace_by_hash/{hash}/{height}/{commitment}": {
+        "/v1/evidence": {
             "get": {
-                "description": "Returns blob",
+                "description": "List evidence of validators misbehavior: duplicate votes and light client attacks",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "namespace"
+                    "evidence"
                 ],
-                "summary": "Get namespace blob by commitment on height",
-                "operationId": "get-namespace-blob",
+                "summary": "List evidence of validators misbehavior",
+                "operationId": "list-evidence",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Base64-encoded namespace id and version",
-                        "name": "hash",
-                        "in": "path",
-                        "required": true
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
-                        "minimum": 1,
                         "type": "integer",
-                        "description": "Block heigth",
-                        "name": "height",
-                        "in": "path",
-                        "required": true
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
                     },
                     {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
                         "type": "string",
-                        "description": "Blob commitment",
-                        "name": "commitment",
-                        "in": "path",
-                        "required": true
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.Blob"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Evidence"
+                            }
                         }
                     },
                     "400": {
@@ -1105,35 +1092,32 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/handler.Error"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
                     }
                 }
             }
         },
-        "/v1/search": {
+        "/v1/head": {
             "get": {
-                "description": "Endpoint finds entity by hash (block, address, namespace and tx)\n\n### Block\n\nBlock will be found by its hash. Hash example: ` + "`" + `652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF` + "`" + `.\nHash should be hexadecimal and has a length of 64.\n\n#### Example response \n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"type\": \"block\",\n    \"result\": {\n        \"id\": 1,\n        \"hash\": \"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF\",\n        // ... rest fields from response.Block type\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n### Tx\n\nTx will be found by its hash. Hash example: ` + "`" + `652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF` + "`" + `.\nTx should be hexadecimal and has a length of 64.\n\n#### Example response \n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"type\": \"tx\",\n    \"result\": {\n        \"id\": 1,\n        \"hash\": \"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF\",\n        // ... rest fields from response.Tx type\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n### Address\n\nThe Address will be found by its hash.\nHash example: ` + "`" + `celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60` + "`" + `.\nAddress has prefix ` + "`" + `celestia` + "`" + ` and has length 47.\nAlso, it should be decoded by ` + "`" + `bech32` + "`" + `.\n\n#### Example response \n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"type\": \"address\",\n    \"result\": {\n        \"id\": 1,\n        \"hash\": \"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60\",\n        \"height\": 100,\n        \"balance\": \"6525472354\"\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n### Namespace\n\nNamespace can be found by base64 hash and identity pair version + namespace id. \nHash example: ` + "`" + `U3dhZ2dlciByb2Nrcw==` + "`" + `. \nIdentity pair example: ` + "`" + `014723ce10b187716adfc55ff7e6d9179c226e6b5440b02577cca49d02` + "`" + `\n\n#### Example response \n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"type\": \"namespace\",\n    \"result\": {\n        \"id\": 1,\n        \"hash\": \"U3dhZ2dlciByb2Nrcw==\",\n        \"version\": 1,\n        \"namespace_id\": \"4723ce10b187716adfc55ff7e6d9179c226e6b5440b02577cca49d02\"\n        // ... rest fields from response.Namespace type\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n",
+                "description": "Get current indexer head",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "search"
-                ],
-                "summary": "Search by hash",
-                "operationId": "search",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Search string",
-                        "name": "query",
-                        "in": "query",
-                        "required": true
-                    }
+                    "general"
                 ],
+                "summary": "Get current indexer head",
+                "operationId": "head",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/SearchResponse-responses_Searchable"
+                            "$ref": "#/definitions/responses.State"
                         }
                     },
                     "204": {
@@ -1154,75 +1138,63 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/stats/histogram/{table}/{function}/{timeframe}": {
+        "/v1/ibc/transfers": {
             "get": {
-                "description": "Returns histogram by table, function and timeframe\n\n### Parameters\n\n` + "`" + `table` + "`" + `, ` + "`" + `function` + "`" + ` and ` + "`" + `column` + "`" + ` parameters are the same as summary endpoint.\n\n\n### Timeframe\n\n* ` + "`" + `hour` + "`" + `\n* ` + "`" + `day` + "`" + `\n* ` + "`" + `week` + "`" + `\n* ` + "`" + `month` + "`" + `\n* ` + "`" + `year` + "`" + `",
+                "description": "List ICS-20 fungible token transfers sent from celestia and received by it. Outgoing transfer is pending until it's acknowledged or timed out.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "stats"
+                    "ibc"
                 ],
-                "summary": "Get histogram",
-                "operationId": "stats-histogram",
+                "summary": "List IBC transfers",
+                "operationId": "list-ibc-transfers",
                 "parameters": [
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
                     {
                         "enum": [
-                            "block",
-                            "tx",
-                            "event",
-                            "message"
+                            "asc",
+                            "desc"
                         ],
                         "type": "string",
-                        "description": "Table name",
-                        "name": "table",
-                        "in": "path",
-                        "required": true
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
                     },
                     {
-                        "enum": [
-                            "min",
-                            "max",
-                            "avg",
-                            "sum",
-                            "count"
-                        ],
                         "type": "string",
-                        "description": "Function name",
-                        "name": "function",
-                        "in": "path",
-                        "required": true
+                        "description": "Address of sender or receiver on celestia or counterparty chain",
+                        "name": "address",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Channel identifier on celestia side",
+                        "name": "channel",
+                        "in": "query"
                     },
                     {
                         "enum": [
-                            "hour",
-                            "day",
-                            "week",
-                            "month",
-                            "year"
+                            "pending",
+                            "success",
+                            "failed",
+                            "timeout"
                         ],
                         "type": "string",
-                        "description": "Timeframe",
-                        "name": "timeframe",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Column name which will be used for computation. Optional for count",
-                        "name": "column",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Time from in unix timestamp",
-                        "name": "from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Time to in unix timestamp",
-                        "name": "to",
+                        "description": "Comma-separated transfer status list",
+                        "name": "status",
                         "in": "query"
                     }
                 ],
@@ -1232,7 +1204,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.HistogramItem"
+                                "$ref": "#/definitions/responses.IbcTransfer"
                             }
                         }
                     },
@@ -1251,63 +1223,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/stats/summary/{table}/{function}": {
+        "/v1/namespace": {
             "get": {
-                "description": "Returns string value by passed table and function.\n\n### Availiable tables\n* ` + "`" + `block` + "`" + `\n* ` + "`" + `tx` + "`" + `\n* ` + "`" + `message` + "`" + `\n* ` + "`" + `event` + "`" + `\n\n\n### Availiable functions\n* ` + "`" + `sum` + "`" + `\n* ` + "`" + `min` + "`" + `\n* ` + "`" + `max` + "`" + `\n* ` + "`" + `avg` + "`" + `\n* ` + "`" + `count` + "`" + `\n\n\n` + "`" + `Column` + "`" + ` query parameter is required for functions ` + "`" + `sum` + "`" + `, ` + "`" + `min` + "`" + `, ` + "`" + `max` + "`" + ` and ` + "`" + `avg` + "`" + ` and should not pass for ` + "`" + `count` + "`" + `.\n\n\n###  Availiable columns and functions for tables:\n\n#### Block\n* ` + "`" + `height` + "`" + `         -- min max\n* ` + "`" + `time` + "`" + `           -- min max\n* ` + "`" + `tx_count` + "`" + `       -- min max sum avg\n* ` + "`" + `events_count` + "`" + `   -- min max sum avg\n* ` + "`" + `blobs_size` + "`" + `     -- min max sum avg\n* ` + "`" + `fee` + "`" + `            -- min max sum avg\n\n#### Tx\n* ` + "`" + `height` + "`" + `         -- min max\n* ` + "`" + `time` + "`" + `           -- min max\n* ` + "`" + `gas_wanted` + "`" + `     -- min max sum avg\n* ` + "`" + `gas_used` + "`" + `       -- min max sum avg\n* ` + "`" + `timeout_height` + "`" + ` -- min max avg\n* ` + "`" + `events_count` + "`" + `   -- min max sum avg\n* ` + "`" + `messages_count` + "`" + ` -- min max sum avg\n* ` + "`" + `fee` + "`" + `            -- min max sum avg\n\n#### Event\n* ` + "`" + `height` + "`" + `         -- min max\n* ` + "`" + `time` + "`" + `           -- min max\n\n#### Message\n* ` + "`" + `height` + "`" + `         -- min max\n* ` + "`" + `time` + "`" + `           -- min max",
+                "description": "List namespace info",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "stats"
+                    "namespace"
                 ],
-                "summary": "Get value by table and function",
-                "operationId": "stats-summary",
+                "summary": "List namespace info",
+                "operationId": "list-namespace",
                 "parameters": [
                     {
-                        "enum": [
-                            "block",
-                            "block_stats",
-                            "tx",
-                            "event",
-                            "message",
-                            "validator"
-                        ],
-                        "type": "string",
-                        "description": "Table name",
-                        "name": "table",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "min",
-                            "max",
-                            "avg",
-                            "sum",
-                            "count"
-                        ],
-                        "type": "string",
-                        "description": "Function name",
-                        "name": "function",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Column name which will be used for computation. Optional for count.",
-                        "name": "column",
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Time from in unix timestamp",
-                        "name": "from",
+                        "description": "Offset",
+                        "name": "offset",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Time to in unix timestamp",
-                        "name": "to",
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
                         "in": "query"
                     }
                 ],
@@ -1315,7 +1263,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Namespace"
+                            }
                         }
                     },
                     "400": {
@@ -1333,130 +1284,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tx": {
+        "/v1/namespace/active": {
             "get": {
-                "description": "List transactions info",
+                "description": "Get last used namespace",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
-                ],
-                "summary": "List transactions info",
-                "operationId": "list-transactions",
-                "parameters": [
-                    {
-                        "maximum": 100,
-                        "type": "integer",
-                        "description": "Count of requested entities",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "description": "Sort order",
-                        "name": "sort",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "success",
-                            "failed"
-                        ],
-                        "type": "string",
-                        "description": "Comma-separated status list",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "MsgUnknown",
-                            "MsgSetWithdrawAddress",
-                            "MsgWithdrawDelegatorReward",
-                            "MsgWithdrawValidatorCommission",
-                            "MsgFundCommunityPool",
-                            "MsgCreateValidator",
-                            "MsgEditValidator",
-                            "MsgDelegate",
-                            "MsgBeginRedelegate",
-                            "MsgUndelegate",
-                            "MsgCancelUnbondingDelegation",
-                            "MsgUnjail",
-                            "MsgSend",
-                            "MsgMultiSend",
-                            "MsgCreateVestingAccount",
-                            "MsgCreatePermanentLockedAccount",
-                            "MsgCreatePeriodicVestingAccount",
-                            "MsgPayForBlobs",
-                            "MsgGrant",
-                            "MsgExec",
-                            "MsgRevoke",
-                            "MsgGrantAllowance",
-                            "MsgRevokeAllowance",
-                            "MsgRegisterEVMAddress",
-                            "MsgSubmitProposal",
-                            "MsgExecLegacyContent",
-                            "MsgVote",
-                            "MsgVoteWeighted",
-                            "MsgDeposit",
-                            "IBCTransfer"
-                        ],
-                        "type": "string",
-                        "description": "Comma-separated message types list",
-                        "name": "msg_type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Time from in unix timestamp",
-                        "name": "from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Time to in unix timestamp",
-                        "name": "to",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Block number",
-                        "name": "height",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "If true join messages",
-                        "name": "messages",
-                        "in": "query"
-                    }
+                    "namespace"
                 ],
+                "summary": "Get last used namespace",
+                "operationId": "get-namespace-active",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.Tx"
+                                "$ref": "#/definitions/responses.ActiveNamespace"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/handler.Error"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1466,17 +1314,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tx/count": {
+        "/v1/namespace/count": {
             "get": {
-                "description": "Get count of transactions in network",
+                "description": "Get count of namespaces in network",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "namespace"
                 ],
-                "summary": "Get count of transactions in network",
-                "operationId": "get-transactions-count",
+                "summary": "Get count of namespaces in network",
+                "operationId": "get-namespace-count",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1493,36 +1341,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tx/genesis": {
+        "/v1/namespace/{id}": {
             "get": {
-                "description": "List genesis transactions info",
+                "description": "Returns array of namespace versions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "namespace"
                 ],
-                "summary": "List genesis transactions info",
-                "operationId": "list-genesis -transactions",
+                "summary": "Get namespace info",
+                "operationId": "get-namespace",
                 "parameters": [
                     {
-                        "maximum": 100,
-                        "type": "integer",
-                        "description": "Count of requested entities",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
+                        "maxLength": 56,
+                        "minLength": 56,
                         "type": "string",
-                        "description": "Sort order",
-                        "name": "sort",
-                        "in": "query"
+                        "description": "Namespace id in hexadecimal",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1531,7 +1369,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.Tx"
+                                "$ref": "#/definitions/responses.Namespace"
                             }
                         }
                     },
@@ -1550,24 +1388,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tx/{hash}": {
+        "/v1/namespace/{id}/{version}": {
             "get": {
-                "description": "Get transaction by hash",
+                "description": "Returns namespace by version byte and namespace id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "namespace"
                 ],
-                "summary": "Get transaction by hash",
-                "operationId": "get-transaction",
+                "summary": "Get namespace info by id and version",
+                "operationId": "get-namespace-by-version-and-id",
                 "parameters": [
                     {
-                        "maxLength": 64,
-                        "minLength": 64,
+                        "maxLength": 56,
+                        "minLength": 56,
                         "type": "string",
-                        "description": "Transaction hash in hexadecimal",
-                        "name": "hash",
+                        "description": "Namespace id in hexadecimal",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version of namespace",
+                        "name": "version",
                         "in": "path",
                         "required": true
                     }
@@ -1576,7 +1421,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/responses.Tx"
+                            "$ref": "#/definitions/responses.Namespace"
                         }
                     },
                     "204": {
@@ -1597,26 +1442,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tx/{hash}/events": {
+        "/v1/namespace/{id}/{version}/messages": {
             "get": {
-                "description": "Get transaction events",
+                "description": "Returns namespace messages by version byte and namespace id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "namespace"
                 ],
-                "summary": "Get transaction events",
-                "operationId": "get-transaction-events",
+                "summary": "Get namespace messages by id and version",
+                "operationId": "get-namespace-messages",
                 "parameters": [
                     {
-                        "maxLength": 64,
-                        "minLength": 64,
+                        "maxLength": 56,
+                        "minLength": 56,
                         "type": "string",
-                        "description": "Transaction hash in hexadecimal",
-                        "name": "hash",
+                        "description": "Namespace id in hexadecimal",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Version of namespace",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1625,10 +1490,13 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/responses.Event"
+                                "$ref": "#/definitions/responses.NamespaceMessage"
                             }
                         }
                     },
+                    "204": {
+                        "description": "No Content"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1644,23 +1512,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/tx/{hash}/messages": {
+        "/v1/namespace_by_hash/{hash}": {
             "get": {
-                "description": "Get transaction messages",
+                "description": "Returns namespace by base64 encoded identity",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "namespace"
                 ],
-                "summary": "Get transaction messages",
-                "operationId": "get-transaction-messages",
+                "summary": "Get namespace info by base64",
+                "operationId": "get-namespace-base64",
                 "parameters": [
                     {
-                        "maxLength": 64,
-                        "minLength": 64,
                         "type": "string",
-                        "description": "Transaction hash in hexadecimal",
+                        "description": "Base64-encoded namespace id and version",
                         "name": "hash",
                         "in": "path",
                         "required": true
@@ -1670,12 +1536,12 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/responses.Message"
-                            }
+                            "$ref": "#/definitions/responses.Namespace"
                         }
                     },
+                    "204": {
+                        "description": "No Content"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
@@ -1691,343 +1557,2115 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/ws": {
+        "/v1/namespace_by_hash/{hash}/{height}": {
             "get": {
-                "description": "## Documentation for websocket API\n\n### Subscribe\n\nTo receive updates from websocket API send ` + "`" + `subscribe` + "`" + ` request to server.\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"\u003cCHANNEL_NAME\u003e\",\n        \"filters\": {\n            // pass channel filters\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNow 2 channels are supported:\n\n* ` + "`" + `head` + "`" + ` - receive information about new block. Channel does not have any filters. Subscribe message should looks like:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"head\"\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nIn that channel messages of ` + "`" + `responses.Block` + "`" + ` type will be sent.\n\n* ` + "`" + `tx` + "`" + ` - receive information about new transactions. The channel has filters for target receiving information. Now 2 filters are supported:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"tx\",\n        \"filters\": {\n            \"status\": [  // array of transaction status. Can be emtpy.\n                types.Status\n            ],\n            \"msg_type\": [  // array of containing message types status. Can be emtpy.\n                types.MsgType\n            ]\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nIf all filers are empty subscription to all transaction will be created.\n\nIn that channel messages of ` + "`" + `responses.Tx` + "`" + ` type will be sent.\n\n\n### Unsubscribe\n\nTo unsubscribe send ` + "`" + `unsubscribe` + "`" + ` message containing one of channel name describing above.\n\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"unsubscribe\",\n    \"body\": {\n        \"channel\": \"\u003cCHANNEL_NAME\u003e\",\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n",
+                "description": "Returns blobs",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "websocket"
+                    "namespace"
                 ],
-                "summary": "Websocket API",
-                "operationId": "websocket",
-                "responses": {}
-            }
-        }
-    },
-    "definitions": {
-        "SearchResponse-responses_Searchable": {
-            "type": "object",
-            "properties": {
-                "result": {
-                    "description": "Search result. Can be one of folowwing types: Block, Address, Namespace, Tx",
-                    "type": "object"
-                },
-                "type": {
-                    "description": "Result type which is in the result. Can be 'block', 'address', 'namespace', 'tx'",
-                    "type": "string"
+                "summary": "Get namespace blobs on height",
+                "operationId": "get-namespace-blobs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Base64-encoded namespace id and version",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Block heigth",
+                        "name": "height",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Blob"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
                 }
             }
         },
-        "github_com_dipdup-io_celestia-indexer_internal_storage_types.Status": {
-            "type": "string",
-            "enum": [
-                "success",
-                "failed"
-            ],
-            "x-enum-varnames": [
-                "StatusSuccess",
-                "StatusFailed"
-            ]
-        },
-        "handler.Error": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "type": "string"
+        "/v1/namespace_by_hash/{hash}/{height}/{commitment}": {
+            "get": {
+                "description": "Returns blob",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "namespace"
+                ],
+                "summary": "Get namespace blob by commitment on height",
+                "operationId": "get-namespace-blob",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Base64-encoded namespace id and version",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Block heigth",
+                        "name": "height",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Blob commitment",
+                        "name": "commitment",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Blob"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
                 }
             }
         },
-        "responses.ActiveNamespace": {
-            "type": "object",
-            "properties": {
-                "hash": {
-                    "type": "string",
-                    "format": "base64",
-                    "example": "U3dhZ2dlciByb2Nrcw=="
-                },
-                "height": {
-                    "type": "integer",
-                    "format": "int64",
-                    "example": 100
-                },
-                "id": {
-                    "type": "integer",
-                    "format": "integer",
-                    "example": 321
-                },
-                "namespace_id": {
-                    "type": "string",
-                    "format": "binary",
-                    "example": "4723ce10b187716adfc55ff7e6d9179c226e6b5440b02577cca49d02"
-                },
-                "pfb_count": {
-                    "type": "integer",
-                    "format": "integer",
-                    "example": 12
-                },
-                "reserved": {
-                    "type": "boolean",
-                    "example": true
-                },
-                "size": {
-                    "type": "integer",
-                    "format": "integer",
-                    "example": 12345
-                },
-                "time": {
-                    "type": "string",
-                    "format": "date-time",
-                    "example": "2023-07-04T03:10:57+00:00"
-                },
-                "version": {
-                    "type": "integer",
-                    "format": "byte"
-                }
-            }
+        "/v1/proposals": {
+            "get": {
+                "description": "List governance proposals",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "List governance proposals",
+                "operationId": "list-proposal",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "deposit_period",
+                            "voting_period",
+                            "passed",
+                            "rejected",
+                            "failed",
+                            "removed"
+                        ],
+                        "type": "string",
+                        "description": "Comma-separated proposal status list",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Proposal"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/proposals/voter/{hash}": {
+            "get": {
+                "description": "Get governance votes cast by the address",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Get address votes",
+                "operationId": "voter-votes",
+                "parameters": [
+                    {
+                        "maxLength": 48,
+                        "minLength": 48,
+                        "type": "string",
+                        "description": "Hash",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Vote"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/proposals/{id}": {
+            "get": {
+                "description": "Get governance proposal with count of the last votes of voters per option",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Get governance proposal",
+                "operationId": "get-proposal",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Proposal id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Proposal"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/proposals/{id}/deposits": {
+            "get": {
+                "description": "Get deposits made to the proposal including initial deposit of proposer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Get proposal deposits",
+                "operationId": "proposal-deposits",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Proposal id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Deposit"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/proposals/{id}/votes": {
+            "get": {
+                "description": "Get votes cast for the proposal. Weighted vote is returned as one entry per option.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "proposal"
+                ],
+                "summary": "Get proposal votes",
+                "operationId": "proposal-votes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Proposal id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Vote"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/search": {
+            "get": {
+                "description": "Endpoint finds entity by hash (block, address, namespace and tx)\n\n### Block\n\nBlock will be found by its hash. Hash example: ` + "`" + `652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF` + "`" + `.\nHash should be hexadecimal and has a length of 64.\n\n#### Example response \n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"type\": \"block\",\n    \"result\": {\n        \"id\": 1,\n        \"hash\": \"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF\",\n        // ... rest fields from response.Block type\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n### Tx\n\nTx will be found by its hash. Hash example: ` + "`" + `652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF` + "`" + `.\nTx should be hexadecimal and has a length of 64.\n\n#### Example response \n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"type\": \"tx\",\n    \"result\": {\n        \"id\": 1,\n        \"hash\": \"652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF\",\n        // ... rest fields from response.Tx type\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n### Address\n\nThe Address will be found by its hash.\nHash example: ` + "`" + `celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60` + "`" + `.\nAddress has prefix ` + "`" + `celestia` + "`" + ` and has length 47.\nAlso, it should be decoded by ` + "`" + `bech32` + "`" + `.\n\n#### Example response \n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"type\": \"address\",\n    \"result\": {\n        \"id\": 1,\n        \"hash\": \"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60\",\n        \"height\": 100,\n        \"balance\": \"6525472354\"\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\n### Namespace\n\nNamespace can be found by base64 hash and identity pair version + namespace id. \nHash example: ` + "`" + `U3dhZ2dlciByb2Nrcw==` + "`" + `. \nIdentity pair example: ` + "`" + `014723ce10b187716adfc55ff7e6d9179c226e6b5440b02577cca49d02` + "`" + `\n\n#### Example response \n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"type\": \"namespace\",\n    \"result\": {\n        \"id\": 1,\n        \"hash\": \"U3dhZ2dlciByb2Nrcw==\",\n        \"version\": 1,\n        \"namespace_id\": \"4723ce10b187716adfc55ff7e6d9179c226e6b5440b02577cca49d02\"\n        // ... rest fields from response.Namespace type\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Search by hash",
+                "operationId": "search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search string",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SearchResponse-responses_Searchable"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stats/histogram/{table}/{function}/{timeframe}": {
+            "get": {
+                "description": "Returns histogram by table, function and timeframe\n\n### Parameters\n\n` + "`" + `table` + "`" + `, ` + "`" + `function` + "`" + ` and ` + "`" + `column` + "`" + ` parameters are the same as summary endpoint.\n\n\n### Timeframe\n\n* ` + "`" + `hour` + "`" + `\n* ` + "`" + `day` + "`" + `\n* ` + "`" + `week` + "`" + `\n* ` + "`" + `month` + "`" + `\n* ` + "`" + `year` + "`" + `",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get histogram",
+                "operationId": "stats-histogram",
+                "parameters": [
+                    {
+                        "enum": [
+                            "block",
+                            "tx",
+                            "event",
+                            "message"
+                        ],
+                        "type": "string",
+                        "description": "Table name",
+                        "name": "table",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "min",
+                            "max",
+                            "avg",
+                            "sum",
+                            "count"
+                        ],
+                        "type": "string",
+                        "description": "Function name",
+                        "name": "function",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "hour",
+                            "day",
+                            "week",
+                            "month",
+                            "year"
+                        ],
+                        "type": "string",
+                        "description": "Timeframe",
+                        "name": "timeframe",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column name which will be used for computation. Optional for count",
+                        "name": "column",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.HistogramItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/stats/summary/{table}/{function}": {
+            "get": {
+                "description": "Returns string value by passed table and function.\n\n### Availiable tables\n* ` + "`" + `block` + "`" + `\n* ` + "`" + `tx` + "`" + `\n* ` + "`" + `message` + "`" + `\n* ` + "`" + `event` + "`" + `\n\n\n### Availiable functions\n* ` + "`" + `sum` + "`" + `\n* ` + "`" + `min` + "`" + `\n* ` + "`" + `max` + "`" + `\n* ` + "`" + `avg` + "`" + `\n* ` + "`" + `count` + "`" + `\n\n\n` + "`" + `Column` + "`" + ` query parameter is required for functions ` + "`" + `sum` + "`" + `, ` + "`" + `min` + "`" + `, ` + "`" + `max` + "`" + ` and ` + "`" + `avg` + "`" + ` and should not pass for ` + "`" + `count` + "`" + `.\n\n\n###  Availiable columns and functions for tables:\n\n#### Block\n* ` + "`" + `height` + "`" + `         -- min max\n* ` + "`" + `time` + "`" + `           -- min max\n* ` + "`" + `tx_count` + "`" + `       -- min max sum avg\n* ` + "`" + `events_count` + "`" + `   -- min max sum avg\n* ` + "`" + `blobs_size` + "`" + `     -- min max sum avg\n* ` + "`" + `fee` + "`" + `            -- min max sum avg\n\n#### Tx\n* ` + "`" + `height` + "`" + `         -- min max\n* ` + "`" + `time` + "`" + `           -- min max\n* ` + "`" + `gas_wanted` + "`" + `     -- min max sum avg\n* ` + "`" + `gas_used` + "`" + `       -- min max sum avg\n* ` + "`" + `timeout_height` + "`" + ` -- min max avg\n* ` + "`" + `events_count` + "`" + `   -- min max sum avg\n* ` + "`" + `messages_count` + "`" + ` -- min max sum avg\n* ` + "`" + `fee` + "`" + `            -- min max sum avg\n\n#### Event\n* ` + "`" + `height` + "`" + `         -- min max\n* ` + "`" + `time` + "`" + `           -- min max\n\n#### Message\n* ` + "`" + `height` + "`" + `         -- min max\n* ` + "`" + `time` + "`" + `           -- min max",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get value by table and function",
+                "operationId": "stats-summary",
+                "parameters": [
+                    {
+                        "enum": [
+                            "block",
+                            "block_stats",
+                            "tx",
+                            "event",
+                            "message",
+                            "validator"
+                        ],
+                        "type": "string",
+                        "description": "Table name",
+                        "name": "table",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "min",
+                            "max",
+                            "avg",
+                            "sum",
+                            "count"
+                        ],
+                        "type": "string",
+                        "description": "Function name",
+                        "name": "function",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column name which will be used for computation. Optional for count.",
+                        "name": "column",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx": {
+            "get": {
+                "description": "List transactions info",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "List transactions info",
+                "operationId": "list-transactions",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Comma-separated status list",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "MsgUnknown",
+                            "MsgSetWithdrawAddress",
+                            "MsgWithdrawDelegatorReward",
+                            "MsgWithdrawValidatorCommission",
+                            "MsgFundCommunityPool",
+                            "MsgCreateValidator",
+                            "MsgEditValidator",
+                            "MsgDelegate",
+                            "MsgBeginRedelegate",
+                            "MsgUndelegate",
+                            "MsgCancelUnbondingDelegation",
+                            "MsgUnjail",
+                            "MsgSend",
+                            "MsgMultiSend",
+                            "MsgCreateVestingAccount",
+                            "MsgCreatePermanentLockedAccount",
+                            "MsgCreatePeriodicVestingAccount",
+                            "MsgPayForBlobs",
+                            "MsgGrant",
+                            "MsgExec",
+                            "MsgRevoke",
+                            "MsgGrantAllowance",
+                            "MsgRevokeAllowance",
+                            "MsgRegisterEVMAddress",
+                            "MsgSubmitProposal",
+                            "MsgExecLegacyContent",
+                            "MsgVote",
+                            "MsgVoteWeighted",
+                            "MsgDeposit",
+                            "IBCTransfer",
+                            "MsgCreateClient",
+                            "MsgUpdateClient",
+                            "MsgUpgradeClient",
+                            "MsgSubmitMisbehaviour",
+                            "MsgConnectionOpenInit",
+                            "MsgConnectionOpenTry",
+                            "MsgConnectionOpenAck",
+                            "MsgConnectionOpenConfirm",
+                            "MsgChannelOpenInit",
+                            "MsgChannelOpenTry",
+                            "MsgChannelOpenAck",
+                            "MsgChannelOpenConfirm",
+                            "MsgChannelCloseInit",
+                            "MsgChannelCloseConfirm",
+                            "MsgRecvPacket",
+                            "MsgTimeout",
+                            "MsgTimeoutOnClose",
+                            "MsgAcknowledgement"
+                        ],
+                        "type": "string",
+                        "description": "Comma-separated message types list",
+                        "name": "msg_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time from in unix timestamp",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Time to in unix timestamp",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Block number",
+                        "name": "height",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If true join messages",
+                        "name": "messages",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Tx"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/count": {
+            "get": {
+                "description": "Get count of transactions in network",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get count of transactions in network",
+                "operationId": "get-transactions-count",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/genesis": {
+            "get": {
+                "description": "List genesis transactions info",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "List genesis transactions info",
+                "operationId": "list-genesis -transactions",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Tx"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/{hash}": {
+            "get": {
+                "description": "Get transaction by hash",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get transaction by hash",
+                "operationId": "get-transaction",
+                "parameters": [
+                    {
+                        "maxLength": 64,
+                        "minLength": 64,
+                        "type": "string",
+                        "description": "Transaction hash in hexadecimal",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Tx"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/{hash}/events": {
+            "get": {
+                "description": "Get transaction events",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get transaction events",
+                "operationId": "get-transaction-events",
+                "parameters": [
+                    {
+                        "maxLength": 64,
+                        "minLength": 64,
+                        "type": "string",
+                        "description": "Transaction hash in hexadecimal",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Event"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/tx/{hash}/messages": {
+            "get": {
+                "description": "Get transaction messages",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get transaction messages",
+                "operationId": "get-transaction-messages",
+                "parameters": [
+                    {
+                        "maxLength": 64,
+                        "minLength": 64,
+                        "type": "string",
+                        "description": "Transaction hash in hexadecimal",
+                        "name": "hash",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Message"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/validators": {
+            "get": {
+                "description": "List validators",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "validator"
+                ],
+                "summary": "List validators",
+                "operationId": "list-validator",
+                "parameters": [
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "id",
+                            "height",
+                            "rate",
+                            "max_rate",
+                            "min_self_delegation",
+                            "power"
+                        ],
+                        "type": "string",
+                        "description": "Sort field",
+                        "name": "sort_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Validator"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/validators/{address}": {
+            "get": {
+                "description": "Get validator info",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "validator"
+                ],
+                "summary": "Get validator info",
+                "operationId": "get-validator",
+                "parameters": [
+                    {
+                        "maxLength": 54,
+                        "minLength": 54,
+                        "type": "string",
+                        "description": "Validator operator address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.Validator"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/validators/{address}/delegators": {
+            "get": {
+                "description": "Get current delegations to the validator",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "validator"
+                ],
+                "summary": "Get validator delegators",
+                "operationId": "validator-delegators",
+                "parameters": [
+                    {
+                        "maxLength": 54,
+                        "minLength": 54,
+                        "type": "string",
+                        "description": "Validator operator address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Delegation"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/validators/{address}/history": {
+            "get": {
+                "description": "Get changes of validator voting power and status: power updates, slashes and jails",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "validator"
+                ],
+                "summary": "Get validator history",
+                "operationId": "validator-history",
+                "parameters": [
+                    {
+                        "maxLength": 54,
+                        "minLength": 54,
+                        "type": "string",
+                        "description": "Validator operator address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.ValidatorHistory"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/validators/{address}/messages": {
+            "get": {
+                "description": "Get messages related to the validator",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "validator"
+                ],
+                "summary": "Get validator messages",
+                "operationId": "validator-messages",
+                "parameters": [
+                    {
+                        "maxLength": 54,
+                        "minLength": 54,
+                        "type": "string",
+                        "description": "Validator operator address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of requested entities",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/responses.Message"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/validators/{address}/missed_blocks": {
+            "get": {
+                "description": "Get heights of blocks which were not signed by the validator among the last blocks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "validator"
+                ],
+                "summary": "Get validator missed blocks",
+                "operationId": "validator-missed-blocks",
+                "parameters": [
+                    {
+                        "maxLength": 54,
+                        "minLength": 54,
+                        "type": "string",
+                        "description": "Validator operator address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of the last blocks",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/validators/{address}/uptime": {
+            "get": {
+                "description": "Get count of signed and missed blocks among the last blocks and total count of blocks proposed by the validator",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "validator"
+                ],
+                "summary": "Get validator uptime",
+                "operationId": "validator-uptime",
+                "parameters": [
+                    {
+                        "maxLength": 54,
+                        "minLength": 54,
+                        "type": "string",
+                        "description": "Validator operator address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "description": "Count of the last blocks",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.ValidatorUptime"
+                        }
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/ws": {
+            "get": {
+                "description": "## Documentation for websocket API\n\n### Subscribe\n\nTo receive updates from websocket API send ` + "`" + `subscribe` + "`" + ` request to server.\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"\u003cCHANNEL_NAME\u003e\",\n        \"filters\": {\n            // pass channel filters\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nNow 2 channels are supported:\n\n* ` + "`" + `head` + "`" + ` - receive information about new block. Channel does not have any filters. Subscribe message should looks like:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"head\"\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nIn that channel messages of ` + "`" + `responses.Block` + "`" + ` type will be sent.\n\n* ` + "`" + `tx` + "`" + ` - receive information about new transactions. The channel has filters for target receiving information. Now 2 filters are supported:\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"subscribe\",\n    \"body\": {\n        \"channel\": \"tx\",\n        \"filters\": {\n            \"status\": [  // array of transaction status. Can be emtpy.\n                types.Status\n            ],\n            \"msg_type\": [  // array of containing message types status. Can be emtpy.\n                types.MsgType\n            ]\n        }\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n\nIf all filers are empty subscription to all transaction will be created.\n\nIn that channel messages of ` + "`" + `responses.Tx` + "`" + ` type will be sent.\n\n\n### Unsubscribe\n\nTo unsubscribe send ` + "`" + `unsubscribe` + "`" + ` message containing one of channel name describing above.\n\n\n` + "`" + `` + "`" + `` + "`" + `json\n{\n    \"method\": \"unsubscribe\",\n    \"body\": {\n        \"channel\": \"\u003cCHANNEL_NAME\u003e\",\n    }\n}\n` + "`" + `` + "`" + `` + "`" + `\n",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "websocket"
+                ],
+                "summary": "Websocket API",
+                "operationId": "websocket",
+                "responses": {}
+            }
+        }
+    },
+    "definitions": {
+        "SearchResponse-responses_Searchable": {
+            "type": "object",
+            "properties": {
+                "result": {
+                    "description": "Search result. Can be one of folowwing types: Block, Address, Namespace, Tx",
+                    "type": "object"
+                },
+                "type": {
+                    "description": "Result type which is in the result. Can be 'block', 'address', 'namespace', 'tx'",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_dipdup-io_celestia-indexer_internal_storage_types.Status": {
+            "type": "string",
+            "enum": [
+                "success",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "StatusSuccess",
+                "StatusFailed"
+            ]
+        },
+        "handler.Error": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "responses.ActiveNamespace": {
+            "type": "object",
+            "properties": {
+                "hash": {
+                    "type": "string",
+                    "format": "base64",
+                    "example": "U3dhZ2dlciByb2Nrcw=="
+                },
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "integer",
+                    "example": 321
+                },
+                "namespace_id": {
+                    "type": "string",
+                    "format": "binary",
+                    "example": "4723ce10b187716adfc55ff7e6d9179c226e6b5440b02577cca49d02"
+                },
+                "pfb_count": {
+                    "type": "integer",
+                    "format": "integer",
+                    "example": 12
+                },
+                "reserved": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "size": {
+                    "type": "integer",
+                    "format": "integer",
+                    "example": 12345
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "version": {
+                    "type": "integer",
+                    "format": "byte"
+                }
+            }
+        },
+        "responses.Address": {
+            "description": "Celestia address information",
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "$ref": "#/definitions/responses.Balance"
+                },
+                "balances": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.Balance"
+                    }
+                },
+                "first_height": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "hash": {
+                    "type": "string",
+                    "example": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 321
+                },
+                "last_height": {
+                    "type": "integer",
+                    "example": 100
+                }
+            }
+        },
+        "responses.Balance": {
+            "description": "Balance of address information",
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "utia"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/responses.DenomMetadata"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "10000000000"
+                }
+            }
+        },
+        "responses.BalanceUpdate": {
+            "description": "Change of address balance",
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "utia"
+                },
+                "delta": {
+                    "type": "string",
+                    "example": "-10000"
+                },
+                "event_position": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1
+                },
+                "event_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.EventType"
+                        }
+                    ],
+                    "example": "coin_spent"
+                },
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 321
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "tx_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 11
+                }
+            }
+        },
+        "responses.Blob": {
+            "type": "object",
+            "properties": {
+                "commitment": {
+                    "type": "string",
+                    "format": "base64",
+                    "example": "vbGakK59+Non81TE3ULg5Ve5ufT9SFm/bCyY+WLR3gg="
+                },
+                "data": {
+                    "type": "string",
+                    "format": "base64",
+                    "example": "b2sgZGVtbyBkYQ=="
+                },
+                "namespace": {
+                    "type": "string",
+                    "format": "base64",
+                    "example": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAs2bWWU6FOB0="
+                },
+                "share_version": {
+                    "type": "integer",
+                    "format": "integer",
+                    "example": 0
+                }
+            }
+        },
+        "responses.Block": {
+            "type": "object",
+            "properties": {
+                "app_hash": {
+                    "type": "string",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "consensus_hash": {
+                    "type": "string",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "data_hash": {
+                    "type": "string",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "evidence": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.Evidence"
+                    }
+                },
+                "evidence_hash": {
+                    "type": "string",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "hash": {
+                    "type": "string",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "height": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 321
+                },
+                "last_commit_hash": {
+                    "type": "string",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "last_results_hash": {
+                    "type": "string",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "message_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "MsgSend",
+                        "MsgUnjail"
+                    ]
+                },
+                "next_validators_hash": {
+                    "type": "string",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "parent_hash": {
+                    "type": "string",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "proposer_address": {
+                    "type": "string",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "stats": {
+                    "$ref": "#/definitions/responses.BlockStats"
+                },
+                "time": {
+                    "type": "string",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "validators_hash": {
+                    "type": "string",
+                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                },
+                "version_app": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "version_block": {
+                    "type": "string",
+                    "example": "11"
+                }
+            }
         },
-        "responses.Address": {
-            "description": "Celestia address information",
+        "responses.BlockStats": {
             "type": "object",
             "properties": {
-                "balance": {
-                    "$ref": "#/definitions/responses.Balance"
+                "blobs_size": {
+                    "type": "integer",
+                    "example": 12354
                 },
-                "first_height": {
+                "block_time": {
                     "type": "integer",
-                    "example": 100
+                    "example": 12354
                 },
-                "hash": {
+                "events_count": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "fee": {
                     "type": "string",
-                    "example": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
+                    "example": "28347628346"
                 },
-                "id": {
-                    "type": "integer",
-                    "example": 321
+                "inflation_rate": {
+                    "type": "string",
+                    "example": "0.0800000"
                 },
-                "last_height": {
+                "messages_counts": {
+                    "type": "string",
+                    "example": "{MsgPayForBlobs:10,MsgUnjail:1}"
+                },
+                "supply_change": {
+                    "type": "string",
+                    "example": "8635234"
+                },
+                "tx_count": {
                     "type": "integer",
-                    "example": 100
+                    "example": 12
                 }
             }
         },
-        "responses.Balance": {
-            "description": "Balance of address information",
+        "responses.Constants": {
             "type": "object",
             "properties": {
-                "currency": {
+                "denom_metadata": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/responses.DenomMetadata"
+                    }
+                },
+                "module": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/responses.Params"
+                    }
+                }
+            }
+        },
+        "responses.Delegation": {
+            "description": "Current delegation",
+            "type": "object",
+            "properties": {
+                "amount": {
                     "type": "string",
-                    "example": "utia"
+                    "example": "10000"
                 },
-                "value": {
+                "delegator": {
                     "type": "string",
-                    "example": "10000000000"
+                    "example": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
+                },
+                "validator": {
+                    "type": "string",
+                    "example": "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x"
                 }
             }
         },
-        "responses.Blob": {
+        "responses.DenomMetadata": {
             "type": "object",
             "properties": {
-                "commitment": {
+                "base": {
                     "type": "string",
-                    "format": "base64",
-                    "example": "vbGakK59+Non81TE3ULg5Ve5ufT9SFm/bCyY+WLR3gg="
+                    "example": "utia"
                 },
-                "data": {
+                "description": {
                     "type": "string",
-                    "format": "base64",
-                    "example": "b2sgZGVtbyBkYQ=="
+                    "example": "Some description"
                 },
-                "namespace": {
+                "display": {
                     "type": "string",
-                    "format": "base64",
-                    "example": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAs2bWWU6FOB0="
+                    "example": "TIA"
                 },
-                "share_version": {
-                    "type": "integer",
-                    "format": "integer",
-                    "example": 0
+                "name": {
+                    "type": "string",
+                    "example": "TIA"
+                },
+                "symbol": {
+                    "type": "string",
+                    "example": "TIA"
+                },
+                "units": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "uri": {
+                    "type": "string",
+                    "example": "https://example.com"
                 }
             }
         },
-        "responses.Block": {
+        "responses.Deposit": {
+            "description": "Deposit to governance proposal",
             "type": "object",
             "properties": {
-                "app_hash": {
+                "amount": {
                     "type": "string",
-                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                    "example": "10000"
                 },
-                "consensus_hash": {
+                "depositor": {
                     "type": "string",
-                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                    "example": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
                 },
-                "data_hash": {
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 321
+                },
+                "proposal_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1
+                },
+                "time": {
                     "type": "string",
-                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
                 },
-                "evidence_hash": {
+                "tx_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 11
+                }
+            }
+        },
+        "responses.Event": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 321
+                },
+                "position": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1
+                },
+                "time": {
                     "type": "string",
-                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
                 },
-                "hash": {
+                "tx_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 11
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.EventType"
+                        }
+                    ],
+                    "example": "commission"
+                }
+            }
+        },
+        "responses.Evidence": {
+            "description": "Evidence of validator misbehavior included into the block",
+            "type": "object",
+            "properties": {
+                "cons_address": {
                     "type": "string",
-                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                    "example": "1AF8F0F4B0C4C2B1F3C6D3E0A4B5C6D7E8F9A0B1"
                 },
                 "height": {
                     "type": "integer",
+                    "format": "int64",
                     "example": 100
                 },
                 "id": {
                     "type": "integer",
+                    "format": "int64",
                     "example": 321
                 },
-                "last_commit_hash": {
+                "infraction_height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 99
+                },
+                "infraction_time": {
                     "type": "string",
-                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:50+00:00"
                 },
-                "last_results_hash": {
+                "moniker": {
                     "type": "string",
-                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                    "example": "Easy 2 Stake"
                 },
-                "message_types": {
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "total_voting_power": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100000
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_dipdup-io_celestia-indexer_internal_storage_types.EvidenceType"
+                        }
+                    ],
+                    "example": "duplicate_vote"
+                },
+                "validator": {
+                    "type": "string",
+                    "example": "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x"
+                },
+                "validator_power": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1000
+                }
+            }
+        },
+        "responses.FeeAllowance": {
+            "description": "Active feegrant allowance",
+            "type": "object",
+            "properties": {
+                "allowed_messages": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     },
                     "example": [
-                        "MsgSend",
-                        "MsgUnjail"
+                        "/cosmos.bank.v1beta1.MsgSend"
                     ]
                 },
-                "next_validators_hash": {
+                "expiration": {
                     "type": "string",
-                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                    "format": "date-time",
+                    "example": "2024-07-04T03:10:57+00:00"
                 },
-                "parent_hash": {
+                "grantee": {
                     "type": "string",
-                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                    "example": "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"
                 },
-                "proposer_address": {
+                "granter": {
                     "type": "string",
-                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                    "example": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
                 },
-                "stats": {
-                    "$ref": "#/definitions/responses.BlockStats"
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
                 },
-                "time": {
+                "period": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 3600
+                },
+                "period_spend_limit": {
                     "type": "string",
-                    "example": "2023-07-04T03:10:57+00:00"
+                    "example": "100"
                 },
-                "validators_hash": {
+                "spend_limit": {
                     "type": "string",
-                    "example": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"
+                    "example": "1000"
                 },
-                "version_app": {
+                "spent": {
                     "type": "string",
-                    "example": "1"
+                    "example": "200"
                 },
-                "version_block": {
+                "time": {
                     "type": "string",
-                    "example": "11"
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "tx_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 11
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.FeeAllowanceType"
+                        }
+                    ],
+                    "example": "basic"
                 }
             }
         },
-        "responses.BlockStats": {
+        "responses.Grant": {
+            "description": "Active authz grant. Spend limit is the value at grant time: it isn't decreased by messages executed with the grant.",
             "type": "object",
             "properties": {
-                "blobs_size": {
-                    "type": "integer",
-                    "example": 12354
+                "authorization": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.AuthorizationType"
+                        }
+                    ],
+                    "example": "send"
                 },
-                "block_time": {
-                    "type": "integer",
-                    "example": 12354
+                "expiration": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2024-07-04T03:10:57+00:00"
                 },
-                "events_count": {
-                    "type": "integer",
-                    "example": 18
+                "grantee": {
+                    "type": "string",
+                    "example": "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"
                 },
-                "fee": {
+                "granter": {
                     "type": "string",
-                    "example": "28347628346"
+                    "example": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
                 },
-                "inflation_rate": {
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "msg_type": {
                     "type": "string",
-                    "example": "0.0800000"
+                    "example": "/cosmos.bank.v1beta1.MsgSend"
                 },
-                "messages_counts": {
+                "spend_limit": {
                     "type": "string",
-                    "example": "{MsgPayForBlobs:10,MsgUnjail:1}"
+                    "example": "1000"
                 },
-                "supply_change": {
+                "time": {
                     "type": "string",
-                    "example": "8635234"
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
                 },
-                "tx_count": {
+                "tx_id": {
                     "type": "integer",
-                    "example": 12
+                    "format": "int64",
+                    "example": 11
                 }
             }
         },
-        "responses.Constants": {
+        "responses.HistogramItem": {
             "type": "object",
             "properties": {
-                "denom_metadata": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/responses.DenomMetadata"
-                    }
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
                 },
-                "module": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "$ref": "#/definitions/responses.Params"
-                    }
+                "value": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2223424"
                 }
             }
         },
-        "responses.DenomMetadata": {
+        "responses.IbcTransfer": {
+            "description": "IBC fungible token transfer",
             "type": "object",
             "properties": {
-                "base": {
-                    "type": "string",
-                    "example": "utia"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "Some description"
-                },
-                "display": {
+                "amount": {
                     "type": "string",
-                    "example": "TIA"
+                    "example": "1000000"
                 },
-                "name": {
+                "denom": {
                     "type": "string",
-                    "example": "TIA"
+                    "example": "utia"
                 },
-                "symbol": {
+                "destination_channel": {
                     "type": "string",
-                    "example": "TIA"
-                },
-                "units": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                    "example": "channel-6994"
                 },
-                "uri": {
+                "destination_port": {
                     "type": "string",
-                    "example": "https://example.com"
-                }
-            }
-        },
-        "responses.Event": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "type": "object",
-                    "additionalProperties": {}
+                    "example": "transfer"
                 },
                 "height": {
                     "type": "integer",
@@ -2039,43 +3677,67 @@ const docTemplate = `{
                     "format": "int64",
                     "example": 321
                 },
-                "position": {
+                "incoming": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "memo": {
+                    "type": "string",
+                    "example": "memo"
+                },
+                "receiver": {
+                    "type": "string",
+                    "example": "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkm8xnha"
+                },
+                "resolved_height": {
                     "type": "integer",
                     "format": "int64",
-                    "example": 1
+                    "example": 110
                 },
-                "time": {
+                "resolved_time": {
                     "type": "string",
                     "format": "date-time",
-                    "example": "2023-07-04T03:10:57+00:00"
+                    "example": "2023-07-04T03:11:57+00:00"
                 },
-                "tx_id": {
+                "resolved_tx_id": {
                     "type": "integer",
                     "format": "int64",
-                    "example": 11
+                    "example": 12
                 },
-                "type": {
+                "sender": {
+                    "type": "string",
+                    "example": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
+                },
+                "sequence": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1024
+                },
+                "source_channel": {
+                    "type": "string",
+                    "example": "channel-2"
+                },
+                "source_port": {
+                    "type": "string",
+                    "example": "transfer"
+                },
+                "status": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/types.EventType"
+                            "$ref": "#/definitions/types.IbcTransferStatus"
                         }
                     ],
-                    "example": "commission"
-                }
-            }
-        },
-        "responses.HistogramItem": {
-            "type": "object",
-            "properties": {
+                    "example": "success"
+                },
                 "time": {
                     "type": "string",
                     "format": "date-time",
                     "example": "2023-07-04T03:10:57+00:00"
                 },
-                "value": {
-                    "type": "string",
-                    "format": "string",
-                    "example": "2223424"
+                "tx_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 11
                 }
             }
         },
@@ -2096,6 +3758,11 @@ const docTemplate = `{
                     "format": "int64",
                     "example": 321
                 },
+                "parent_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 320
+                },
                 "position": {
                     "type": "integer",
                     "format": "int64",
@@ -2214,12 +3881,136 @@ const docTemplate = `{
                 }
             }
         },
-        "responses.Params": {
-            "type": "object",
-            "additionalProperties": {
-                "type": "string"
-            }
-        },
+        "responses.Params": {
+            "type": "object",
+            "additionalProperties": {
+                "type": "string"
+            }
+        },
+        "responses.Proposal": {
+            "description": "Governance proposal",
+            "type": "object",
+            "properties": {
+                "deposit": {
+                    "type": "string",
+                    "example": "10000000000"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Proposal description"
+                },
+                "end_height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 200
+                },
+                "end_time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-11T03:10:57+00:00"
+                },
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1
+                },
+                "proposer": {
+                    "type": "string",
+                    "example": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ProposalStatus"
+                        }
+                    ],
+                    "example": "voting_period"
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Increase max validators"
+                },
+                "tx_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 11
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ProposalType"
+                        }
+                    ],
+                    "example": "param_changed"
+                },
+                "vote_counts": {
+                    "$ref": "#/definitions/responses.VoteCounts"
+                },
+                "voting_start_height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 101
+                },
+                "voting_start_time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                }
+            }
+        },
+        "responses.Redelegation": {
+            "description": "Redelegation entry",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "10000"
+                },
+                "completion_time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-25T03:10:57+00:00"
+                },
+                "destination": {
+                    "type": "string",
+                    "example": "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y"
+                },
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 321
+                },
+                "source": {
+                    "type": "string",
+                    "example": "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x"
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "tx_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 11
+                }
+            }
+        },
         "responses.State": {
             "type": "object",
             "properties": {
@@ -2374,6 +4165,310 @@ const docTemplate = `{
                 }
             }
         },
+        "responses.Unbonding": {
+            "description": "Unbonding delegation entry",
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "10000"
+                },
+                "completion_time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-25T03:10:57+00:00"
+                },
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 321
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "tx_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 11
+                },
+                "validator": {
+                    "type": "string",
+                    "example": "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x"
+                }
+            }
+        },
+        "responses.Validator": {
+            "description": "Validator info",
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x"
+                },
+                "cons_address": {
+                    "type": "string",
+                    "example": "1AF8F0F4B0C4C2B1F3C6D3E0A4B5C6D7E8F9A0B1"
+                },
+                "contacts": {
+                    "type": "string",
+                    "example": "security@0xfury.com"
+                },
+                "delegator": {
+                    "type": "string",
+                    "example": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
+                },
+                "details": {
+                    "type": "string",
+                    "example": "Enterprise-grade staking provider"
+                },
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 321
+                },
+                "identity": {
+                    "type": "string",
+                    "example": "2C877AC873132C91"
+                },
+                "max_change_rate": {
+                    "type": "string",
+                    "example": "0.01"
+                },
+                "max_rate": {
+                    "type": "string",
+                    "example": "0.1"
+                },
+                "min_self_delegation": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "moniker": {
+                    "type": "string",
+                    "example": "Easy 2 Stake"
+                },
+                "power": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1000
+                },
+                "rate": {
+                    "type": "string",
+                    "example": "0.03"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ValidatorStatus"
+                        }
+                    ],
+                    "example": "bonded"
+                },
+                "website": {
+                    "type": "string",
+                    "example": "https://www.easy2stake.com/"
+                }
+            }
+        },
+        "responses.ValidatorHistory": {
+            "description": "Change of validator voting power or status",
+            "type": "object",
+            "properties": {
+                "burned_coins": {
+                    "type": "string",
+                    "example": "10000"
+                },
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 321
+                },
+                "power": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 990
+                },
+                "prev_power": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1000
+                },
+                "prev_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ValidatorStatus"
+                        }
+                    ],
+                    "example": "bonded"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "double_sign"
+                },
+                "slash_power": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1000
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ValidatorStatus"
+                        }
+                    ],
+                    "example": "jailed"
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.ValidatorHistoryType"
+                        }
+                    ],
+                    "example": "slash"
+                }
+            }
+        },
+        "responses.ValidatorUptime": {
+            "description": "Validator uptime over the last blocks",
+            "type": "object",
+            "properties": {
+                "blocks": {
+                    "type": "integer",
+                    "example": 100
+                },
+                "missed_blocks": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "proposed_blocks": {
+                    "type": "integer",
+                    "example": 1024
+                },
+                "signed_blocks": {
+                    "type": "integer",
+                    "example": 97
+                },
+                "uptime": {
+                    "type": "string",
+                    "example": "0.97"
+                }
+            }
+        },
+        "responses.Vote": {
+            "description": "Governance vote",
+            "type": "object",
+            "properties": {
+                "height": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 100
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 321
+                },
+                "option": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/types.VoteOption"
+                        }
+                    ],
+                    "example": "yes"
+                },
+                "proposal_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 1
+                },
+                "time": {
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2023-07-04T03:10:57+00:00"
+                },
+                "tx_id": {
+                    "type": "integer",
+                    "format": "int64",
+                    "example": 11
+                },
+                "voter": {
+                    "type": "string",
+                    "example": "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
+                },
+                "weight": {
+                    "type": "string",
+                    "example": "1"
+                }
+            }
+        },
+        "responses.VoteCountOption": {
+            "description": "Count of voters and summary weight of the option in their votes. Weight isn't weighted by stake.",
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "weight": {
+                    "type": "string",
+                    "example": "12.5"
+                }
+            }
+        },
+        "responses.VoteCounts": {
+            "description": "Per-option counts of the last votes of each voter. Votes are not weighted by stake, so it isn't an on-chain tally.",
+            "type": "object",
+            "properties": {
+                "abstain": {
+                    "$ref": "#/definitions/responses.VoteCountOption"
+                },
+                "no": {
+                    "$ref": "#/definitions/responses.VoteCountOption"
+                },
+                "no_with_veto": {
+                    "$ref": "#/definitions/responses.VoteCountOption"
+                },
+                "yes": {
+                    "$ref": "#/definitions/responses.VoteCountOption"
+                }
+            }
+        },
+        "types.AuthorizationType": {
+            "type": "string",
+            "enum": [
+                "generic",
+                "send",
+                "stake",
+                "unknown"
+            ],
+            "x-enum-varnames": [
+                "AuthorizationTypeGeneric",
+                "AuthorizationTypeSend",
+                "AuthorizationTypeStake",
+                "AuthorizationTypeUnknown"
+            ]
+        },
         "types.EventType": {
             "type": "string",
             "enum": [
@@ -2408,9 +4503,38 @@ const docTemplate = `{
                 "proposal_vote",
                 "proposal_deposit",
                 "submit_proposal",
+                "active_proposal",
+                "inactive_proposal",
                 "cosmos.authz.v1beta1.EventGrant",
+                "cosmos.authz.v1beta1.EventRevoke",
                 "send_packet",
-                "ibc_transfer"
+                "ibc_transfer",
+                "create_client",
+                "update_client",
+                "upgrade_client",
+                "client_misbehaviour",
+                "update_client_proposal",
+                "upgrade_client_proposal",
+                "connection_open_init",
+                "connection_open_try",
+                "connection_open_ack",
+                "connection_open_confirm",
+                "channel_open_init",
+                "channel_open_try",
+                "channel_open_ack",
+                "channel_open_confirm",
+                "channel_close_init",
+                "channel_close_confirm",
+                "channel_close",
+                "recv_packet",
+                "write_acknowledgement",
+                "acknowledge_packet",
+                "timeout_packet",
+                "timeout_on_close_packet",
+                "fungible_token_packet",
+                "denomination_trace",
+                "timeout",
+                "channel_closed"
             ],
             "x-enum-varnames": [
                 "EventTypeUnknown",
@@ -2444,9 +4568,68 @@ const docTemplate = `{
                 "EventTypeProposalVote",
                 "EventTypeProposalDeposit",
                 "EventTypeSubmitProposal",
+                "EventTypeActiveProposal",
+                "EventTypeInactiveProposal",
                 "EventTypeCosmosauthzv1beta1EventGrant",
+                "EventTypeCosmosauthzv1beta1EventRevoke",
                 "EventTypeSendPacket",
-                "EventTypeIbcTransfer"
+                "EventTypeIbcTransfer",
+                "EventTypeCreateClient",
+                "EventTypeUpdateClient",
+                "EventTypeUpgradeClient",
+                "EventTypeClientMisbehaviour",
+                "EventTypeUpdateClientProposal",
+                "EventTypeUpgradeClientProposal",
+                "EventTypeConnectionOpenInit",
+                "EventTypeConnectionOpenTry",
+                "EventTypeConnectionOpenAck",
+                "EventTypeConnectionOpenConfirm",
+                "EventTypeChannelOpenInit",
+                "EventTypeChannelOpenTry",
+                "EventTypeChannelOpenAck",
+                "EventTypeChannelOpenConfirm",
+                "EventTypeChannelCloseInit",
+                "EventTypeChannelCloseConfirm",
+                "EventTypeChannelClose",
+                "EventTypeRecvPacket",
+                "EventTypeWriteAcknowledgement",
+                "EventTypeAcknowledgePacket",
+                "EventTypeTimeoutPacket",
+                "EventTypeTimeoutOnClosePacket",
+                "EventTypeFungibleTokenPacket",
+                "EventTypeDenominationTrace",
+                "EventTypeTimeout",
+                "EventTypeChannelClosed"
+            ]
+        },
+        "types.FeeAllowanceType": {
+            "type": "string",
+            "enum": [
+                "basic",
+                "periodic",
+                "allowed_msg",
+                "unknown"
+            ],
+            "x-enum-varnames": [
+                "FeeAllowanceTypeBasic",
+                "FeeAllowanceTypePeriodic",
+                "FeeAllowanceTypeAllowedMsg",
+                "FeeAllowanceTypeUnknown"
+            ]
+        },
+        "types.IbcTransferStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "success",
+                "failed",
+                "timeout"
+            ],
+            "x-enum-varnames": [
+                "IbcTransferStatusPending",
+                "IbcTransferStatusSuccess",
+                "IbcTransferStatusFailed",
+                "IbcTransferStatusTimeout"
             ]
         },
         "types.MsgType": {
@@ -2481,7 +4664,25 @@ const docTemplate = `{
                 "MsgVote",
                 "MsgVoteWeighted",
                 "MsgDeposit",
-                "IBCTransfer"
+                "IBCTransfer",
+                "MsgCreateClient",
+                "MsgUpdateClient",
+                "MsgUpgradeClient",
+                "MsgSubmitMisbehaviour",
+                "MsgConnectionOpenInit",
+                "MsgConnectionOpenTry",
+                "MsgConnectionOpenAck",
+                "MsgConnectionOpenConfirm",
+                "MsgChannelOpenInit",
+                "MsgChannelOpenTry",
+                "MsgChannelOpenAck",
+                "MsgChannelOpenConfirm",
+                "MsgChannelCloseInit",
+                "MsgChannelCloseConfirm",
+                "MsgRecvPacket",
+                "MsgTimeout",
+                "MsgTimeoutOnClose",
+                "MsgAcknowledgement"
             ],
             "x-enum-varnames": [
                 "MsgUnknown",
@@ -2513,7 +4714,106 @@ const docTemplate = `{
                 "MsgVote",
                 "MsgVoteWeighted",
                 "MsgDeposit",
-                "IBCTransfer"
+                "IBCTransfer",
+                "MsgCreateClient",
+                "MsgUpdateClient",
+                "MsgUpgradeClient",
+                "MsgSubmitMisbehaviour",
+                "MsgConnectionOpenInit",
+                "MsgConnectionOpenTry",
+                "MsgConnectionOpenAck",
+                "MsgConnectionOpenConfirm",
+                "MsgChannelOpenInit",
+                "MsgChannelOpenTry",
+                "MsgChannelOpenAck",
+                "MsgChannelOpenConfirm",
+                "MsgChannelCloseInit",
+                "MsgChannelCloseConfirm",
+                "MsgRecvPacket",
+                "MsgTimeout",
+                "MsgTimeoutOnClose",
+                "MsgAcknowledgement"
+            ]
+        },
+        "types.ProposalStatus": {
+            "type": "string",
+            "enum": [
+                "deposit_period",
+                "voting_period",
+                "passed",
+                "rejected",
+                "failed",
+                "removed"
+            ],
+            "x-enum-varnames": [
+                "ProposalStatusDepositPeriod",
+                "ProposalStatusVotingPeriod",
+                "ProposalStatusPassed",
+                "ProposalStatusRejected",
+                "ProposalStatusFailed",
+                "ProposalStatusRemoved"
+            ]
+        },
+        "types.ProposalType": {
+            "type": "string",
+            "enum": [
+                "text",
+                "param_changed",
+                "community_pool_spend",
+                "client_update",
+                "unknown"
+            ],
+            "x-enum-varnames": [
+                "ProposalTypeText",
+                "ProposalTypeParamChanged",
+                "ProposalTypeCommunityPoolSpend",
+                "ProposalTypeClientUpdate",
+                "ProposalTypeUnknown"
+            ]
+        },
+        "types.ValidatorHistoryType": {
+            "type": "string",
+            "enum": [
+                "power_change",
+                "slash",
+                "jail"
+            ],
+            "x-enum-varnames": [
+                "ValidatorHistoryTypePowerChange",
+                "ValidatorHistoryTypeSlash",
+                "ValidatorHistoryTypeJail"
+            ]
+        },
+        "types.ValidatorStatus": {
+            "type": "string",
+            "enum": [
+                "unbonded",
+                "unbonding",
+                "bonded",
+                "jailed",
+                "tombstoned"
+            ],
+            "x-enum-varnames": [
+                "ValidatorStatusUnbonded",
+                "ValidatorStatusUnbonding",
+                "ValidatorStatusBonded",
+                "ValidatorStatusJailed",
+                "ValidatorStatusTombstoned"
+            ]
+        },
+        "types.VoteOption": {
+            "type": "string",
+            "enum": [
+                "yes",
+                "abstain",
+                "no",
+                "no_with_veto"
+            ],
+            "x-enum-varnames": [
+                "VoteOptionYes",
+                "VoteOptionAbstain",
+                "VoteOptionNo",
+                "VoteOptionNoWithVeto"
             ]
         }
     }
@@ -15,28 +15,47 @@ import (
 )
 
 type AddressHandler struct {
-	address     storage.IAddress
-	txs         storage.ITx
-	state       storage.IState
-	indexerName string
+	address        storage.IAddress
+	txs            storage.ITx
+	state          storage.IState
+	balanceUpdates storage.IBalanceUpdate
+	delegations    storage.IDelegation
+	unbondings     storage.IUnbonding
+	redelegations  storage.IRedelegation
+	grants         storage.IGrant
+	feeAllowances  storage.IFeeAllowance
+	indexerName    string
 }
 
 func NewAddressHandler(
 	address storage.IAddress,
 	txs storage.ITx,
 	state storage.IState,
+	balanceUpdates storage.IBalanceUpdate,
+	delegations storage.IDelegation,
+	unbondings storage.IUnbonding,
+	redelegations storage.IRedelegation,
+	grants storage.IGrant,
+	feeAllowances storage.IFeeAllowance,
 	indexerName string,
 ) *AddressHandler {
 	return &AddressHandler{
-		address:     address,
-		txs:         txs,
-		state:       state,
-		indexerName: indexerName,
+		address:        address,
+		txs:            txs,
+		state:          state,
+		balanceUpdates: balanceUpdates,
+		delegations:    delegations,
+		unbondings:     unbondings,
+		redelegations:  redelegations,
+		grants:         grants,
+		feeAllowances:  feeAllowances,
+		indexerName:    indexerName,
 	}
 }
 
 type getAddressRequest struct {
-	Hash string `param:"hash" validate:"required,address"`
+	Hash     string      `param:"hash"      validate:"required,address"`
+	AtHeight types.Level `query:"at_height" validate:"omitempty,min=1"`
 }
 
 // Get godoc
@@ -45,7 +64,8 @@ type getAddressRequest struct {
 //	@Description	Get address info
 //	@Tags			address
 //	@ID				get-address
-//	@Param			hash	path	string	true	"Hash"	minlength(48)	maxlength(48)
+//	@Param			hash		path	string	true	"Hash"	minlength(48)	maxlength(48)
+//	@Param			at_height	query	integer	false	"Return balances at the end of the block"	mininum(1)
 //	@Produce		json
 //	@Success		200	{object}	responses.Address
 //	@Success		204
@@ -68,6 +88,14 @@ func (handler *AddressHandler) Get(c echo.Context) error {
 		return err
 	}
 
+	if req.AtHeight > 0 {
+		balances, err := handler.balanceUpdates.BalancesAt(c.Request().Context(), address.Id, req.AtHeight)
+		if err := handleError(c, err, handler.balanceUpdates); err != nil {
+			return err
+		}
+		address.Balances = balances
+	}
+
 	return c.JSON(http.StatusOK, responses.NewAddress(address))
 }
 
@@ -172,6 +200,271 @@ func (handler *AddressHandler) Transactions(c echo.Context) error {
 	return returnArray(c, response)
 }
 
+// BalanceHistory godoc
+//
+//	@Summary		Get address balance history
+//	@Description	Get changes of address balances
+//	@Tags			address
+//	@ID				address-balance-history
+//	@Param			hash		path	string	true	"Hash"							minlength(48)	maxlength(48)
+//	@Param			limit		query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset		query	integer	false	"Offset"						mininum(1)
+//	@Param			sort		query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			currency	query	string	false	"Currency"
+//	@Produce		json
+//	@Success		200	{array}		responses.BalanceUpdate
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/address/{hash}/balance/history [get]
+func (handler *AddressHandler) BalanceHistory(c echo.Context) error {
+	req, err := bindAndValidate[addressBalanceHistoryRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err := handleError(c, err, handler.address); err != nil {
+		return err
+	}
+
+	updates, err := handler.balanceUpdates.ByAddress(c.Request().Context(), address.Id, storage.BalanceUpdateFilter{
+		Limit:    int(req.Limit),
+		Offset:   int(req.Offset),
+		Sort:     pgSort(req.Sort),
+		Currency: req.Currency,
+	})
+	if err := handleError(c, err, handler.balanceUpdates); err != nil {
+		return err
+	}
+
+	response := make([]responses.BalanceUpdate, len(updates))
+	for i := range updates {
+		response[i] = responses.NewBalanceUpdate(updates[i])
+	}
+	return returnArray(c, response)
+}
+
+// Delegations godoc
+//
+//	@Summary		Get address delegations
+//	@Description	Get current delegations of the address
+//	@Tags			address
+//	@ID				address-delegations
+//	@Param			hash	path	string	true	"Hash"							minlength(48)	maxlength(48)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Delegation
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/address/{hash}/delegations [get]
+func (handler *AddressHandler) Delegations(c echo.Context) error {
+	req, err := bindAndValidate[addressPageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err := handleError(c, err, handler.address); err != nil {
+		return err
+	}
+
+	delegations, err := handler.delegations.ByAddress(c.Request().Context(), address.Id, int(req.Limit), int(req.Offset))
+	if err := handleError(c, err, handler.delegations); err != nil {
+		return err
+	}
+
+	response := make([]responses.Delegation, len(delegations))
+	for i := range delegations {
+		response[i] = responses.NewDelegation(delegations[i])
+	}
+	return returnArray(c, response)
+}
+
+// Unbondings godoc
+//
+//	@Summary		Get address unbondings
+//	@Description	Get unbonding delegation entries of the address
+//	@Tags			address
+//	@ID				address-unbondings
+//	@Param			hash	path	string	true	"Hash"							minlength(48)	maxlength(48)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Unbonding
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/address/{hash}/unbondings [get]
+func (handler *AddressHandler) Unbondings(c echo.Context) error {
+	req, err := bindAndValidate[addressPageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err := handleError(c, err, handler.address); err != nil {
+		return err
+	}
+
+	unbondings, err := handler.unbondings.ByAddress(c.Request().Context(), address.Id, int(req.Limit), int(req.Offset))
+	if err := handleError(c, err, handler.unbondings); err != nil {
+		return err
+	}
+
+	response := make([]responses.Unbonding, len(unbondings))
+	for i := range unbondings {
+		response[i] = responses.NewUnbonding(unbondings[i])
+	}
+	return returnArray(c, response)
+}
+
+// Redelegations godoc
+//
+//	@Summary		Get address redelegations
+//	@Description	Get redelegations of the address
+//	@Tags			address
+//	@ID				address-redelegations
+//	@Param			hash	path	string	true	"Hash"							minlength(48)	maxlength(48)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Redelegation
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/address/{hash}/redelegations [get]
+func (handler *AddressHandler) Redelegations(c echo.Context) error {
+	req, err := bindAndValidate[addressPageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err := handleError(c, err, handler.address); err != nil {
+		return err
+	}
+
+	redelegations, err := handler.redelegations.ByAddress(c.Request().Context(), address.Id, int(req.Limit), int(req.Offset))
+	if err := handleError(c, err, handler.redelegations); err != nil {
+		return err
+	}
+
+	response := make([]responses.Redelegation, len(redelegations))
+	for i := range redelegations {
+		response[i] = responses.NewRedelegation(redelegations[i])
+	}
+	return returnArray(c, response)
+}
+
+// Grants godoc
+//
+//	@Summary		Get address grants
+//	@Description	Get active authz grants where the address is granter or grantee. Spend limit is the value at grant time.
+//	@Tags			address
+//	@ID				address-grants
+//	@Param			hash	path	string	true	"Hash"							minlength(48)	maxlength(48)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}	responses.Grant
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/address/{hash}/grants [get]
+func (handler *AddressHandler) Grants(c echo.Context) error {
+	req, err := bindAndValidate[addressPageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err := handleError(c, err, handler.address); err != nil {
+		return err
+	}
+
+	grants, err := handler.grants.ByAddress(c.Request().Context(), address.Id, int(req.Limit), int(req.Offset))
+	if err := handleError(c, err, handler.grants); err != nil {
+		return err
+	}
+
+	response := make([]responses.Grant, len(grants))
+	for i := range grants {
+		response[i] = responses.NewGrant(grants[i])
+	}
+	return returnArray(c, response)
+}
+
+// FeeAllowances godoc
+//
+//	@Summary		Get address fee allowances
+//	@Description	Get active fee allowances where the address is granter or grantee
+//	@Tags			address
+//	@ID				address-fee-allowances
+//	@Param			hash	path	string	true	"Hash"							minlength(48)	maxlength(48)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}	responses.FeeAllowance
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/address/{hash}/fee_allowances [get]
+func (handler *AddressHandler) FeeAllowances(c echo.Context) error {
+	req, err := bindAndValidate[addressPageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err := handleError(c, err, handler.address); err != nil {
+		return err
+	}
+
+	allowances, err := handler.feeAllowances.ByAddress(c.Request().Context(), address.Id, int(req.Limit), int(req.Offset))
+	if err := handleError(c, err, handler.feeAllowances); err != nil {
+		return err
+	}
+
+	response := make([]responses.FeeAllowance, len(allowances))
+	for i := range allowances {
+		response[i] = responses.NewFeeAllowance(allowances[i])
+	}
+	return returnArray(c, response)
+}
+
 // Count godoc
 //
 //	@Summary		Get count of addresses in network
@@ -11,11 +11,13 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dipdup-io/celestia-indexer/cmd/api/handler/responses"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	"github.com/dipdup-io/celestia-indexer/internal/storage/mock"
 	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
 	"github.com/labstack/echo/v4"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/suite"
@@ -34,17 +36,25 @@ var (
 	}
 	testAddress     = "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
 	testHashAddress = []byte{0x96, 0xa, 0xa0, 0x36, 0x6b, 0x25, 0x4e, 0x1e, 0xa7, 0x9b, 0xda, 0x46, 0x7e, 0xb3, 0xaa, 0x5c, 0x97, 0xcb, 0xa5, 0xae}
+
+	testValidatorAddress = "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x"
 )
 
 // AddressTestSuite -
 type AddressTestSuite struct {
 	suite.Suite
-	address *mock.MockIAddress
-	txs     *mock.MockITx
-	state   *mock.MockIState
-	echo    *echo.Echo
-	handler *AddressHandler
-	ctrl    *gomock.Controller
+	address        *mock.MockIAddress
+	txs            *mock.MockITx
+	state          *mock.MockIState
+	balanceUpdates *mock.MockIBalanceUpdate
+	delegations    *mock.MockIDelegation
+	unbondings     *mock.MockIUnbonding
+	redelegations  *mock.MockIRedelegation
+	grants         *mock.MockIGrant
+	feeAllowances  *mock.MockIFeeAllowance
+	echo           *echo.Echo
+	handler        *AddressHandler
+	ctrl           *gomock.Controller
 }
 
 // SetupSuite -
@@ -55,7 +65,13 @@ func (s *AddressTestSuite) SetupSuite() {
 	s.address = mock.NewMockIAddress(s.ctrl)
 	s.txs = mock.NewMockITx(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewAddressHandler(s.address, s.txs, s.state, testIndexerName)
+	s.balanceUpdates = mock.NewMockIBalanceUpdate(s.ctrl)
+	s.delegations = mock.NewMockIDelegation(s.ctrl)
+	s.unbondings = mock.NewMockIUnbonding(s.ctrl)
+	s.redelegations = mock.NewMockIRedelegation(s.ctrl)
+	s.grants = mock.NewMockIGrant(s.ctrl)
+	s.feeAllowances = mock.NewMockIFeeAllowance(s.ctrl)
+	s.handler = NewAddressHandler(s.address, s.txs, s.state, s.balanceUpdates, s.delegations, s.unbondings, s.redelegations, s.grants, s.feeAllowances, testIndexerName)
 }
 
 // TearDownSuite -
@@ -151,9 +167,18 @@ func (s *AddressTestSuite) TestList() {
 				Address:    testAddress,
 				Height:     100,
 				LastHeight: 100,
-				Balance: storage.Balance{
-					Currency: "utia",
-					Total:    decimal.RequireFromString("100"),
+				Balances: []storage.Balance{
+					{
+						Currency: "utia",
+						Total:    decimal.RequireFromString("100"),
+						Metadata: &storage.DenomMetadata{
+							Base:   "utia",
+							Symbol: "TIA",
+						},
+					}, {
+						Currency: "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+						Total:    decimal.RequireFromString("5"),
+					},
 				},
 			},
 		}, nil)
@@ -171,6 +196,12 @@ func (s *AddressTestSuite) TestList() {
 	s.Require().Equal(testAddress, address[0].Hash)
 	s.Require().Equal("100", address[0].Balance.Value)
 	s.Require().Equal("utia", address[0].Balance.Currency)
+	s.Require().Len(address[0].Balances, 2)
+	s.Require().Equal("utia", address[0].Balances[0].Currency)
+	s.Require().NotNil(address[0].Balances[0].Metadata)
+	s.Require().Equal("TIA", address[0].Balances[0].Metadata.Symbol)
+	s.Require().Equal("5", address[0].Balances[1].Value)
+	s.Require().Nil(address[0].Balances[1].Metadata)
 }
 
 func (s *AddressTestSuite) TestListHeight() {
@@ -248,3 +279,332 @@ func (s *AddressTestSuite) TestCount() {
 	s.Require().NoError(err)
 	s.Require().EqualValues(123123, count)
 }
+
+func (s *AddressTestSuite) TestGetAtHeight() {
+	q := make(url.Values)
+	q.Set("at_height", "500")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testHashAddress).
+		Return(storage.Address{
+			Id:         1,
+			Hash:       testHashAddress,
+			Address:    testAddress,
+			Height:     100,
+			LastHeight: 1000,
+			Balances: []storage.Balance{
+				{
+					Id:       1,
+					Currency: "utia",
+					Total:    decimal.RequireFromString("123"),
+				},
+			},
+		}, nil)
+
+	s.balanceUpdates.EXPECT().
+		BalancesAt(gomock.Any(), uint64(1), pkgTypes.Level(500)).
+		Return([]storage.Balance{
+			{
+				Id:       1,
+				Currency: "utia",
+				Total:    decimal.RequireFromString("100"),
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var address responses.Address
+	err := json.NewDecoder(rec.Body).Decode(&address)
+	s.Require().NoError(err)
+	s.Require().Equal("100", address.Balance.Value)
+	s.Require().Len(address.Balances, 1)
+	s.Require().Equal("100", address.Balances[0].Value)
+}
+
+func (s *AddressTestSuite) TestBalanceHistory() {
+	q := make(url.Values)
+	q.Set("limit", "2")
+	q.Set("currency", "utia")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/balance/history")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testHashAddress).
+		Return(storage.Address{
+			Id:      1,
+			Hash:    testHashAddress,
+			Address: testAddress,
+		}, nil)
+
+	txId := uint64(10)
+	s.balanceUpdates.EXPECT().
+		ByAddress(gomock.Any(), uint64(1), storage.BalanceUpdateFilter{
+			Limit:    2,
+			Offset:   0,
+			Sort:     pgSort("desc"),
+			Currency: "utia",
+		}).
+		Return([]storage.BalanceUpdate{
+			{
+				Id:            2,
+				Height:        1000,
+				Time:          testTime,
+				AddressId:     1,
+				Currency:      "utia",
+				Delta:         decimal.RequireFromString("-23"),
+				TxId:          &txId,
+				EventPosition: 3,
+				EventType:     types.EventTypeCoinSpent,
+			}, {
+				Id:            1,
+				Height:        100,
+				Time:          testTime,
+				AddressId:     1,
+				Currency:      "utia",
+				Delta:         decimal.RequireFromString("123"),
+				EventPosition: 0,
+				EventType:     types.EventTypeCoinReceived,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.BalanceHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var updates []responses.BalanceUpdate
+	err := json.NewDecoder(rec.Body).Decode(&updates)
+	s.Require().NoError(err)
+	s.Require().Len(updates, 2)
+	s.Require().EqualValues(1000, updates[0].Height)
+	s.Require().Equal("-23", updates[0].Delta)
+	s.Require().EqualValues(10, updates[0].TxId)
+	s.Require().Equal(types.EventTypeCoinSpent, updates[0].EventType)
+	s.Require().EqualValues(0, updates[1].TxId)
+	s.Require().Equal("123", updates[1].Delta)
+}
+
+func (s *AddressTestSuite) TestDelegations() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/delegations")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testHashAddress).
+		Return(storage.Address{
+			Id:      1,
+			Hash:    testHashAddress,
+			Address: testAddress,
+		}, nil)
+
+	s.delegations.EXPECT().
+		ByAddress(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.Delegation{
+			{
+				AddressId: 1,
+				Validator: testValidatorAddress,
+				Amount:    decimal.RequireFromString("1000"),
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Delegations(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var delegations []responses.Delegation
+	err := json.NewDecoder(rec.Body).Decode(&delegations)
+	s.Require().NoError(err)
+	s.Require().Len(delegations, 1)
+	s.Require().Equal(testValidatorAddress, delegations[0].Validator)
+	s.Require().Equal("1000", delegations[0].Amount)
+	s.Require().Empty(delegations[0].Delegator)
+}
+
+func (s *AddressTestSuite) TestUnbondings() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/unbondings")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testHashAddress).
+		Return(storage.Address{
+			Id:      1,
+			Hash:    testHashAddress,
+			Address: testAddress,
+		}, nil)
+
+	s.unbondings.EXPECT().
+		ByAddress(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.Unbonding{
+			{
+				Id:             1,
+				Height:         100,
+				Time:           testTime,
+				AddressId:      1,
+				Validator:      testValidatorAddress,
+				Amount:         decimal.RequireFromString("100"),
+				CompletionTime: testTime.Add(time.Hour),
+				TxId:           2,
+				MsgId:          3,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Unbondings(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var unbondings []responses.Unbonding
+	err := json.NewDecoder(rec.Body).Decode(&unbondings)
+	s.Require().NoError(err)
+	s.Require().Len(unbondings, 1)
+	s.Require().EqualValues(100, unbondings[0].Height)
+	s.Require().Equal(testValidatorAddress, unbondings[0].Validator)
+	s.Require().Equal("100", unbondings[0].Amount)
+	s.Require().True(testTime.Add(time.Hour).Equal(unbondings[0].CompletionTime))
+	s.Require().EqualValues(2, unbondings[0].TxId)
+}
+
+func (s *AddressTestSuite) TestRedelegations() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/redelegations")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testHashAddress).
+		Return(storage.Address{
+			Id:      1,
+			Hash:    testHashAddress,
+			Address: testAddress,
+		}, nil)
+
+	s.redelegations.EXPECT().
+		ByAddress(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.Redelegation{
+			{
+				Id:             1,
+				Height:         100,
+				Time:           testTime,
+				AddressId:      1,
+				Source:         testValidatorAddress,
+				Destination:    "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y",
+				Amount:         decimal.RequireFromString("100"),
+				CompletionTime: testTime.Add(time.Hour),
+				TxId:           2,
+				MsgId:          3,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Redelegations(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var redelegations []responses.Redelegation
+	err := json.NewDecoder(rec.Body).Decode(&redelegations)
+	s.Require().NoError(err)
+	s.Require().Len(redelegations, 1)
+	s.Require().Equal(testValidatorAddress, redelegations[0].Source)
+	s.Require().Equal("celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y", redelegations[0].Destination)
+	s.Require().Equal("100", redelegations[0].Amount)
+}
+
+func (s *AddressTestSuite) TestGrants() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/grants")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testHashAddress).
+		Return(storage.Address{
+			Id:      1,
+			Hash:    testHashAddress,
+			Address: testAddress,
+		}, nil)
+
+	s.grants.EXPECT().
+		ByAddress(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.Grant{
+			{
+				Height:        100,
+				Authorization: types.AuthorizationTypeSend,
+				MsgType:       "/cosmos.bank.v1beta1.MsgSend",
+				SpendLimit:    decimal.RequireFromString("1000"),
+				Granter:       &storage.Address{Address: testAddress},
+				Grantee:       &storage.Address{Address: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"},
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Grants(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var grants []responses.Grant
+	err := json.NewDecoder(rec.Body).Decode(&grants)
+	s.Require().NoError(err)
+	s.Require().Len(grants, 1)
+	s.Require().Equal(testAddress, grants[0].Granter)
+	s.Require().Equal(types.AuthorizationTypeSend, grants[0].Authorization)
+	s.Require().Equal("1000", grants[0].SpendLimit)
+	s.Require().Nil(grants[0].Expiration)
+}
+
+func (s *AddressTestSuite) TestFeeAllowances() {
+	req := httptest.NewRequest(http.MethodGet, "/?limit=5&offset=5", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/address/:hash/fee_allowances")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testHashAddress).
+		Return(storage.Address{
+			Id:      1,
+			Hash:    testHashAddress,
+			Address: testAddress,
+		}, nil)
+
+	s.feeAllowances.EXPECT().
+		ByAddress(gomock.Any(), uint64(1), 5, 5).
+		Return([]storage.FeeAllowance{
+			{
+				Height:           101,
+				Type:             types.FeeAllowanceTypeBasic,
+				SpendLimit:       decimal.RequireFromString("800"),
+				Spent:            decimal.RequireFromString("200"),
+				PeriodSpendLimit: decimal.Zero,
+				Granter:          &storage.Address{Address: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"},
+				Grantee:          &storage.Address{Address: testAddress},
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.FeeAllowances(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var allowances []responses.FeeAllowance
+	err := json.NewDecoder(rec.Body).Decode(&allowances)
+	s.Require().NoError(err)
+	s.Require().Len(allowances, 1)
+	s.Require().Equal(testAddress, allowances[0].Grantee)
+	s.Require().Equal(types.FeeAllowanceTypeBasic, allowances[0].Type)
+	s.Require().Equal("800", allowances[0].SpendLimit)
+	s.Require().Equal("200", allowances[0].Spent)
+}
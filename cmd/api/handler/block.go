@@ -16,6 +16,7 @@ type BlockHandler struct {
 	block       storage.IBlock
 	blockStats  storage.IBlockStats
 	events      storage.IEvent
+	evidence    storage.IEvidence
 	namespace   storage.INamespace
 	state       storage.IState
 	indexerName string
@@ -25,6 +26,7 @@ func NewBlockHandler(
 	block storage.IBlock,
 	blockStats storage.IBlockStats,
 	events storage.IEvent,
+	evidence storage.IEvidence,
 	namespace storage.INamespace,
 	state storage.IState,
 	indexerName string,
@@ -33,6 +35,7 @@ func NewBlockHandler(
 		block:       block,
 		blockStats:  blockStats,
 		events:      events,
+		evidence:    evidence,
 		namespace:   namespace,
 		state:       state,
 		indexerName: indexerName,
@@ -80,6 +83,11 @@ func (handler *BlockHandler) Get(c echo.Context) error {
 		return err
 	}
 
+	block.Evidence, err = handler.evidence.ByHeight(c.Request().Context(), block.Height)
+	if err := handleError(c, err, handler.evidence); err != nil {
+		return err
+	}
+
 	return c.JSON(http.StatusOK, responses.NewBlock(block, req.Stats))
 }
 
@@ -59,6 +59,7 @@ type BlockTestSuite struct {
 	blocks     *mock.MockIBlock
 	blockStats *mock.MockIBlockStats
 	events     *mock.MockIEvent
+	evidence   *mock.MockIEvidence
 	namespace  *mock.MockINamespace
 	state      *mock.MockIState
 	echo       *echo.Echo
@@ -74,9 +75,10 @@ func (s *BlockTestSuite) SetupSuite() {
 	s.blocks = mock.NewMockIBlock(s.ctrl)
 	s.blockStats = mock.NewMockIBlockStats(s.ctrl)
 	s.events = mock.NewMockIEvent(s.ctrl)
+	s.evidence = mock.NewMockIEvidence(s.ctrl)
 	s.namespace = mock.NewMockINamespace(s.ctrl)
 	s.state = mock.NewMockIState(s.ctrl)
-	s.handler = NewBlockHandler(s.blocks, s.blockStats, s.events, s.namespace, s.state, testIndexerName)
+	s.handler = NewBlockHandler(s.blocks, s.blockStats, s.events, s.evidence, s.namespace, s.state, testIndexerName)
 }
 
 // TearDownSuite -
@@ -101,6 +103,26 @@ func (s *BlockTestSuite) TestGet() {
 		ByHeight(gomock.Any(), pkgTypes.Level(100)).
 		Return(testBlock, nil)
 
+	s.evidence.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return([]storage.Evidence{
+			{
+				Id:               1,
+				Height:           100,
+				Time:             testTime,
+				Type:             types.EvidenceTypeDuplicateVote,
+				ValidatorId:      1,
+				ConsAddress:      pkgTypes.Hex{0x01},
+				InfractionHeight: 99,
+				ValidatorPower:   1000,
+				TotalVotingPower: 100000,
+				Validator: &storage.Validator{
+					Address: "celestiavaloper17vmk8m246t648hpmde2q7kp4ft9uwrayy09dmw",
+					Moniker: "moniker",
+				},
+			},
+		}, nil)
+
 	s.Require().NoError(s.handler.Get(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -115,6 +137,11 @@ func (s *BlockTestSuite) TestGet() {
 	s.Require().Equal(testTime, block.Time)
 	s.Require().Equal([]types.MsgType{types.MsgSend}, block.MessageTypes)
 	s.Require().Nil(block.Stats)
+	s.Require().Len(block.Evidence, 1)
+	s.Require().Equal(types.EvidenceTypeDuplicateVote, block.Evidence[0].Type)
+	s.Require().Equal("celestiavaloper17vmk8m246t648hpmde2q7kp4ft9uwrayy09dmw", block.Evidence[0].Validator)
+	s.Require().Equal("moniker", block.Evidence[0].Moniker)
+	s.Require().EqualValues(99, block.Evidence[0].InfractionHeight)
 }
 
 func (s *BlockTestSuite) TestGetWithoutStats() {
@@ -132,6 +159,10 @@ func (s *BlockTestSuite) TestGetWithoutStats() {
 		ByHeight(gomock.Any(), pkgTypes.Level(100)).
 		Return(testBlock, nil)
 
+	s.evidence.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return([]storage.Evidence{}, nil)
+
 	s.Require().NoError(s.handler.Get(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -146,6 +177,7 @@ func (s *BlockTestSuite) TestGetWithoutStats() {
 	s.Require().Equal(testTime, block.Time)
 	s.Require().Equal([]types.MsgType{types.MsgSend}, block.MessageTypes)
 	s.Require().Nil(block.Stats)
+	s.Require().Empty(block.Evidence)
 }
 
 func (s *BlockTestSuite) TestGetWithStats() {
@@ -163,6 +195,10 @@ func (s *BlockTestSuite) TestGetWithStats() {
 		ByHeightWithStats(gomock.Any(), pkgTypes.Level(100)).
 		Return(testBlockWithStats, nil)
 
+	s.evidence.EXPECT().
+		ByHeight(gomock.Any(), pkgTypes.Level(100)).
+		Return([]storage.Evidence{}, nil)
+
 	s.Require().NoError(s.handler.Get(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
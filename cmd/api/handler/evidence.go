@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"github.com/dipdup-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/labstack/echo/v4"
+)
+
+type EvidenceHandler struct {
+	evidence storage.IEvidence
+}
+
+func NewEvidenceHandler(evidence storage.IEvidence) *EvidenceHandler {
+	return &EvidenceHandler{
+		evidence: evidence,
+	}
+}
+
+// List godoc
+//
+//	@Summary		List evidence of validators misbehavior
+//	@Description	List evidence of validators misbehavior: duplicate votes and light client attacks
+//	@Tags			evidence
+//	@ID				list-evidence
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Produce		json
+//	@Success		200	{array}		responses.Evidence
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/evidence [get]
+func (handler *EvidenceHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[limitOffsetPagination](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	evidence, err := handler.evidence.ListWithValidators(c.Request().Context(), req.Limit, req.Offset, pgSort(req.Sort))
+	if err := handleError(c, err, handler.evidence); err != nil {
+		return err
+	}
+
+	response := make([]responses.Evidence, len(evidence))
+	for i := range evidence {
+		response[i] = responses.NewEvidence(evidence[i])
+	}
+	return returnArray(c, response)
+}
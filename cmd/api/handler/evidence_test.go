@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/mock"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// EvidenceTestSuite -
+type EvidenceTestSuite struct {
+	suite.Suite
+	evidence *mock.MockIEvidence
+	echo     *echo.Echo
+	handler  *EvidenceHandler
+	ctrl     *gomock.Controller
+}
+
+// SetupSuite -
+func (s *EvidenceTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.evidence = mock.NewMockIEvidence(s.ctrl)
+	s.handler = NewEvidenceHandler(s.evidence)
+}
+
+// TearDownSuite -
+func (s *EvidenceTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteEvidence_Run(t *testing.T) {
+	suite.Run(t, new(EvidenceTestSuite))
+}
+
+func (s *EvidenceTestSuite) TestList() {
+	q := make(url.Values)
+	q.Set("limit", "5")
+	q.Set("sort", "desc")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/evidence")
+
+	s.evidence.EXPECT().
+		ListWithValidators(gomock.Any(), uint64(5), uint64(0), sdk.SortOrderDesc).
+		Return([]storage.Evidence{
+			{
+				Id:               2,
+				Height:           100,
+				Time:             testTime,
+				Type:             types.EvidenceTypeLightClientAttack,
+				ConsAddress:      pkgTypes.Hex{0x02},
+				InfractionHeight: 90,
+				ValidatorPower:   50,
+				TotalVotingPower: 1000,
+			}, {
+				Id:               1,
+				Height:           100,
+				Time:             testTime,
+				Type:             types.EvidenceTypeDuplicateVote,
+				ValidatorId:      1,
+				ConsAddress:      pkgTypes.Hex{0x01},
+				InfractionHeight: 99,
+				ValidatorPower:   100,
+				TotalVotingPower: 1000,
+				Validator: &storage.Validator{
+					Address: "celestiavaloper17vmk8m246t648hpmde2q7kp4ft9uwrayy09dmw",
+					Moniker: "moniker",
+				},
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var evidence []responses.Evidence
+	err := json.NewDecoder(rec.Body).Decode(&evidence)
+	s.Require().NoError(err)
+	s.Require().Len(evidence, 2)
+
+	s.Require().EqualValues(2, evidence[0].Id)
+	s.Require().Equal(types.EvidenceTypeLightClientAttack, evidence[0].Type)
+	s.Require().Empty(evidence[0].Validator)
+	s.Require().Equal("02", evidence[0].ConsAddress.String())
+	s.Require().EqualValues(90, evidence[0].InfractionHeight)
+
+	s.Require().EqualValues(1, evidence[1].Id)
+	s.Require().Equal(types.EvidenceTypeDuplicateVote, evidence[1].Type)
+	s.Require().Equal("celestiavaloper17vmk8m246t648hpmde2q7kp4ft9uwrayy09dmw", evidence[1].Validator)
+	s.Require().Equal("moniker", evidence[1].Moniker)
+	s.Require().EqualValues(100, evidence[1].ValidatorPower)
+	s.Require().EqualValues(1000, evidence[1].TotalVotingPower)
+}
+
+func (s *EvidenceTestSuite) TestListInvalidLimit() {
+	q := make(url.Values)
+	q.Set("limit", "1000")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/evidence")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
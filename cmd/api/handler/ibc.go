@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"github.com/dipdup-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/labstack/echo/v4"
+)
+
+type IbcHandler struct {
+	transfers storage.IIbcTransfer
+}
+
+func NewIbcHandler(transfers storage.IIbcTransfer) *IbcHandler {
+	return &IbcHandler{
+		transfers: transfers,
+	}
+}
+
+// Transfers godoc
+//
+//	@Summary		List IBC transfers
+//	@Description	List ICS-20 fungible token transfers sent from celestia and received by it. Outgoing transfer is pending until it's acknowledged or timed out.
+//	@Tags			ibc
+//	@ID				list-ibc-transfers
+//	@Param			limit	query	integer							false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer							false	"Offset"						mininum(1)
+//	@Param			sort	query	string							false	"Sort order"					Enums(asc, desc)
+//	@Param			address	query	string							false	"Address of sender or receiver on celestia or counterparty chain"
+//	@Param			channel	query	string							false	"Channel identifier on celestia side"
+//	@Param			status	query	storageTypes.IbcTransferStatus	false	"Comma-separated transfer status list"
+//	@Produce		json
+//	@Success		200	{array}		responses.IbcTransfer
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/ibc/transfers [get]
+func (handler *IbcHandler) Transfers(c echo.Context) error {
+	req, err := bindAndValidate[ibcTransferListRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	fltrs := storage.IbcTransferFilter{
+		Limit:   int(req.Limit),
+		Offset:  int(req.Offset),
+		Sort:    pgSort(req.Sort),
+		Address: req.Address,
+		Channel: req.Channel,
+		Status:  make([]storageTypes.IbcTransferStatus, len(req.Status)),
+	}
+	for i := range req.Status {
+		fltrs.Status[i] = storageTypes.IbcTransferStatus(req.Status[i])
+	}
+
+	transfers, err := handler.transfers.Filter(c.Request().Context(), fltrs)
+	if err := handleError(c, err, handler.transfers); err != nil {
+		return err
+	}
+
+	response := make([]responses.IbcTransfer, len(transfers))
+	for i := range transfers {
+		response[i] = responses.NewIbcTransfer(transfers[i])
+	}
+	return returnArray(c, response)
+}
@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/mock"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var testIbcTransfer = storage.IbcTransfer{
+	Id:                 1,
+	Height:             100,
+	Time:               testTime,
+	Status:             types.IbcTransferStatusSuccess,
+	SourcePort:         "transfer",
+	SourceChannel:      "channel-2",
+	DestinationPort:    "transfer",
+	DestinationChannel: "channel-6994",
+	Sequence:           10,
+	Sender:             testAddress,
+	Receiver:           "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkm8xnha",
+	Amount:             decimal.RequireFromString("1000"),
+	Denom:              "utia",
+	TxId:               2,
+	MsgId:              3,
+	ResolvedHeight:     110,
+	ResolvedTime:       testTime,
+	ResolvedTxId:       4,
+}
+
+// IbcTestSuite -
+type IbcTestSuite struct {
+	suite.Suite
+	transfers *mock.MockIIbcTransfer
+	echo      *echo.Echo
+	handler   *IbcHandler
+	ctrl      *gomock.Controller
+}
+
+// SetupSuite -
+func (s *IbcTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.transfers = mock.NewMockIIbcTransfer(s.ctrl)
+	s.handler = NewIbcHandler(s.transfers)
+}
+
+// TearDownSuite -
+func (s *IbcTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteIbc_Run(t *testing.T) {
+	suite.Run(t, new(IbcTestSuite))
+}
+
+func (s *IbcTestSuite) TestTransfers() {
+	q := make(url.Values)
+	q.Set("limit", "5")
+	q.Set("address", testAddress)
+	q.Set("channel", "channel-2")
+	q.Set("status", "success,timeout")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/transfers")
+
+	s.transfers.EXPECT().
+		Filter(gomock.Any(), storage.IbcTransferFilter{
+			Limit:   5,
+			Sort:    sdk.SortOrderDesc,
+			Address: testAddress,
+			Channel: "channel-2",
+			Status:  []types.IbcTransferStatus{types.IbcTransferStatusSuccess, types.IbcTransferStatusTimeout},
+		}).
+		Return([]storage.IbcTransfer{testIbcTransfer}, nil)
+
+	s.Require().NoError(s.handler.Transfers(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var transfers []responses.IbcTransfer
+	err := json.NewDecoder(rec.Body).Decode(&transfers)
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+	s.Require().EqualValues(1, transfers[0].Id)
+	s.Require().False(transfers[0].Incoming)
+	s.Require().Equal(types.IbcTransferStatusSuccess, transfers[0].Status)
+	s.Require().Equal("channel-2", transfers[0].SourceChannel)
+	s.Require().EqualValues(10, transfers[0].Sequence)
+	s.Require().Equal(testAddress, transfers[0].Sender)
+	s.Require().Equal("osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkm8xnha", transfers[0].Receiver)
+	s.Require().Equal("1000", transfers[0].Amount)
+	s.Require().Equal("utia", transfers[0].Denom)
+	s.Require().EqualValues(110, transfers[0].ResolvedHeight)
+	s.Require().NotNil(transfers[0].ResolvedTime)
+	s.Require().EqualValues(4, transfers[0].ResolvedTxId)
+}
+
+func (s *IbcTestSuite) TestTransfersInvalidStatus() {
+	q := make(url.Values)
+	q.Set("status", "invalid")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/ibc/transfers")
+
+	s.Require().NoError(s.handler.Transfers(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
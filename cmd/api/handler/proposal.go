@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"net/http"
+
+	"github.com/dipdup-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+type ProposalHandler struct {
+	proposals storage.IProposal
+	votes     storage.IVote
+	deposits  storage.IDeposit
+	address   storage.IAddress
+}
+
+func NewProposalHandler(
+	proposals storage.IProposal,
+	votes storage.IVote,
+	deposits storage.IDeposit,
+	address storage.IAddress,
+) *ProposalHandler {
+	return &ProposalHandler{
+		proposals: proposals,
+		votes:     votes,
+		deposits:  deposits,
+		address:   address,
+	}
+}
+
+// List godoc
+//
+//	@Summary		List governance proposals
+//	@Description	List governance proposals
+//	@Tags			proposal
+//	@ID				list-proposal
+//	@Param			limit	query	integer						false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer						false	"Offset"						mininum(1)
+//	@Param			sort	query	string						false	"Sort order"					Enums(asc, desc)
+//	@Param			status	query	storageTypes.ProposalStatus	false	"Comma-separated proposal status list"
+//	@Produce		json
+//	@Success		200	{array}		responses.Proposal
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/proposals [get]
+func (handler *ProposalHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[proposalListRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	fltrs := storage.ProposalFilter{
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+		Sort:   pgSort(req.Sort),
+		Status: make([]storageTypes.ProposalStatus, len(req.Status)),
+	}
+	for i := range req.Status {
+		fltrs.Status[i] = storageTypes.ProposalStatus(req.Status[i])
+	}
+
+	proposals, err := handler.proposals.Filter(c.Request().Context(), fltrs)
+	if err := handleError(c, err, handler.proposals); err != nil {
+		return err
+	}
+
+	response := make([]responses.Proposal, len(proposals))
+	for i := range proposals {
+		response[i] = responses.NewProposal(proposals[i])
+	}
+	return returnArray(c, response)
+}
+
+type getProposalRequest struct {
+	Id uint64 `param:"id" validate:"required,min=1"`
+}
+
+// Get godoc
+//
+//	@Summary		Get governance proposal
+//	@Description	Get governance proposal with count of the last votes of voters per option
+//	@Tags			proposal
+//	@ID				get-proposal
+//	@Param			id	path	integer	true	"Proposal id"	mininum(1)
+//	@Produce		json
+//	@Success		200	{object}	responses.Proposal
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/proposals/{id} [get]
+func (handler *ProposalHandler) Get(c echo.Context) error {
+	req, err := bindAndValidate[getProposalRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	proposal, err := handler.proposals.ById(c.Request().Context(), req.Id)
+	if err := handleError(c, err, handler.proposals); err != nil {
+		return err
+	}
+
+	counts, err := handler.votes.VoteCounts(c.Request().Context(), req.Id)
+	if err := handleError(c, err, handler.votes); err != nil {
+		return err
+	}
+
+	response := responses.NewProposal(proposal)
+	voteCounts := responses.NewVoteCounts(counts)
+	response.VoteCounts = &voteCounts
+	return c.JSON(http.StatusOK, response)
+}
+
+// Votes godoc
+//
+//	@Summary		Get proposal votes
+//	@Description	Get votes cast for the proposal. Weighted vote is returned as one entry per option.
+//	@Tags			proposal
+//	@ID				proposal-votes
+//	@Param			id		path	integer	true	"Proposal id"					mininum(1)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Vote
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/proposals/{id}/votes [get]
+func (handler *ProposalHandler) Votes(c echo.Context) error {
+	req, err := bindAndValidate[proposalPageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	votes, err := handler.votes.ByProposal(c.Request().Context(), req.Id, int(req.Limit), int(req.Offset))
+	if err := handleError(c, err, handler.votes); err != nil {
+		return err
+	}
+
+	response := make([]responses.Vote, len(votes))
+	for i := range votes {
+		response[i] = responses.NewVote(votes[i])
+	}
+	return returnArray(c, response)
+}
+
+// Deposits godoc
+//
+//	@Summary		Get proposal deposits
+//	@Description	Get deposits made to the proposal including initial deposit of proposer
+//	@Tags			proposal
+//	@ID				proposal-deposits
+//	@Param			id		path	integer	true	"Proposal id"					mininum(1)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Deposit
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/proposals/{id}/deposits [get]
+func (handler *ProposalHandler) Deposits(c echo.Context) error {
+	req, err := bindAndValidate[proposalPageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	deposits, err := handler.deposits.ByProposal(c.Request().Context(), req.Id, int(req.Limit), int(req.Offset))
+	if err := handleError(c, err, handler.deposits); err != nil {
+		return err
+	}
+
+	response := make([]responses.Deposit, len(deposits))
+	for i := range deposits {
+		response[i] = responses.NewDeposit(deposits[i])
+	}
+	return returnArray(c, response)
+}
+
+// VoterHistory godoc
+//
+//	@Summary		Get address votes
+//	@Description	Get governance votes cast by the address
+//	@Tags			proposal
+//	@ID				voter-votes
+//	@Param			hash	path	string	true	"Hash"							minlength(48)	maxlength(48)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Vote
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/proposals/voter/{hash} [get]
+func (handler *ProposalHandler) VoterHistory(c echo.Context) error {
+	req, err := bindAndValidate[addressPageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	_, hash, err := types.Address(req.Hash).Decode()
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	address, err := handler.address.ByHash(c.Request().Context(), hash)
+	if err := handleError(c, err, handler.address); err != nil {
+		return err
+	}
+
+	votes, err := handler.votes.ByVoter(c.Request().Context(), address.Id, int(req.Limit), int(req.Offset))
+	if err := handleError(c, err, handler.votes); err != nil {
+		return err
+	}
+
+	response := make([]responses.Vote, len(votes))
+	for i := range votes {
+		response[i] = responses.NewVote(votes[i])
+	}
+	return returnArray(c, response)
+}
@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/mock"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+var testProposal = storage.Proposal{
+	Id:                1,
+	Height:            100,
+	Time:              testTime,
+	ProposerId:        1,
+	Title:             "title",
+	Description:       "description",
+	Type:              types.ProposalTypeText,
+	Status:            types.ProposalStatusVotingPeriod,
+	Deposit:           decimal.RequireFromString("1000"),
+	VotingStartHeight: 101,
+	VotingStartTime:   testTime,
+	TxId:              2,
+	MsgId:             3,
+	Proposer: &storage.Address{
+		Address: testAddress,
+	},
+}
+
+// ProposalTestSuite -
+type ProposalTestSuite struct {
+	suite.Suite
+	proposals *mock.MockIProposal
+	votes     *mock.MockIVote
+	deposits  *mock.MockIDeposit
+	address   *mock.MockIAddress
+	echo      *echo.Echo
+	handler   *ProposalHandler
+	ctrl      *gomock.Controller
+}
+
+// SetupSuite -
+func (s *ProposalTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.proposals = mock.NewMockIProposal(s.ctrl)
+	s.votes = mock.NewMockIVote(s.ctrl)
+	s.deposits = mock.NewMockIDeposit(s.ctrl)
+	s.address = mock.NewMockIAddress(s.ctrl)
+	s.handler = NewProposalHandler(s.proposals, s.votes, s.deposits, s.address)
+}
+
+// TearDownSuite -
+func (s *ProposalTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteProposal_Run(t *testing.T) {
+	suite.Run(t, new(ProposalTestSuite))
+}
+
+func (s *ProposalTestSuite) TestList() {
+	q := make(url.Values)
+	q.Set("limit", "5")
+	q.Set("status", "voting_period,passed")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposals")
+
+	s.proposals.EXPECT().
+		Filter(gomock.Any(), storage.ProposalFilter{
+			Limit:  5,
+			Sort:   sdk.SortOrderDesc,
+			Status: []types.ProposalStatus{types.ProposalStatusVotingPeriod, types.ProposalStatusPassed},
+		}).
+		Return([]storage.Proposal{testProposal}, nil)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var proposals []responses.Proposal
+	err := json.NewDecoder(rec.Body).Decode(&proposals)
+	s.Require().NoError(err)
+	s.Require().Len(proposals, 1)
+	s.Require().EqualValues(1, proposals[0].Id)
+	s.Require().Equal(testAddress, proposals[0].Proposer)
+	s.Require().Equal("title", proposals[0].Title)
+	s.Require().Equal(types.ProposalTypeText, proposals[0].Type)
+	s.Require().Equal(types.ProposalStatusVotingPeriod, proposals[0].Status)
+	s.Require().Equal("1000", proposals[0].Deposit)
+	s.Require().EqualValues(101, proposals[0].VotingStartHeight)
+	s.Require().NotNil(proposals[0].VotingStartTime)
+	s.Require().Nil(proposals[0].EndTime)
+	s.Require().Nil(proposals[0].VoteCounts)
+}
+
+func (s *ProposalTestSuite) TestListInvalidStatus() {
+	q := make(url.Values)
+	q.Set("status", "invalid")
+
+	req := httptest.NewRequest(http.MethodGet, "/?"+q.Encode(), nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposals")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ProposalTestSuite) TestGet() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposals/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.proposals.EXPECT().
+		ById(gomock.Any(), uint64(1)).
+		Return(testProposal, nil)
+
+	s.votes.EXPECT().
+		VoteCounts(gomock.Any(), uint64(1)).
+		Return([]storage.VoteCount{
+			{
+				Option: types.VoteOptionYes,
+				Weight: decimal.RequireFromString("2.5"),
+				Count:  3,
+			}, {
+				Option: types.VoteOptionNoWithVeto,
+				Weight: decimal.RequireFromString("0.5"),
+				Count:  1,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var proposal responses.Proposal
+	err := json.NewDecoder(rec.Body).Decode(&proposal)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, proposal.Id)
+	s.Require().NotNil(proposal.VoteCounts)
+	s.Require().Equal("2.5", proposal.VoteCounts.Yes.Weight)
+	s.Require().EqualValues(3, proposal.VoteCounts.Yes.Count)
+	s.Require().Equal("0", proposal.VoteCounts.No.Weight)
+	s.Require().EqualValues(0, proposal.VoteCounts.No.Count)
+	s.Require().Equal("0", proposal.VoteCounts.Abstain.Weight)
+	s.Require().Equal("0.5", proposal.VoteCounts.NoWithVeto.Weight)
+	s.Require().EqualValues(1, proposal.VoteCounts.NoWithVeto.Count)
+}
+
+func (s *ProposalTestSuite) TestVotes() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposals/:id/votes")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.votes.EXPECT().
+		ByProposal(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.Vote{
+			{
+				Id:         1,
+				Height:     102,
+				Time:       testTime,
+				ProposalId: 1,
+				VoterId:    1,
+				Option:     types.VoteOptionYes,
+				Weight:     decimal.RequireFromString("1"),
+				TxId:       4,
+				MsgId:      5,
+				Voter: &storage.Address{
+					Address: testAddress,
+				},
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Votes(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var votes []responses.Vote
+	err := json.NewDecoder(rec.Body).Decode(&votes)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+	s.Require().EqualValues(102, votes[0].Height)
+	s.Require().EqualValues(1, votes[0].ProposalId)
+	s.Require().Equal(testAddress, votes[0].Voter)
+	s.Require().Equal(types.VoteOptionYes, votes[0].Option)
+	s.Require().Equal("1", votes[0].Weight)
+	s.Require().EqualValues(4, votes[0].TxId)
+}
+
+func (s *ProposalTestSuite) TestDeposits() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposals/:id/deposits")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	s.deposits.EXPECT().
+		ByProposal(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.Deposit{
+			{
+				Id:          1,
+				Height:      100,
+				Time:        testTime,
+				ProposalId:  1,
+				DepositorId: 1,
+				Amount:      decimal.RequireFromString("1000"),
+				TxId:        2,
+				MsgId:       3,
+				Depositor: &storage.Address{
+					Address: testAddress,
+				},
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Deposits(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var deposits []responses.Deposit
+	err := json.NewDecoder(rec.Body).Decode(&deposits)
+	s.Require().NoError(err)
+	s.Require().Len(deposits, 1)
+	s.Require().EqualValues(100, deposits[0].Height)
+	s.Require().Equal(testAddress, deposits[0].Depositor)
+	s.Require().Equal("1000", deposits[0].Amount)
+}
+
+func (s *ProposalTestSuite) TestVoterHistory() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/proposals/voter/:hash")
+	c.SetParamNames("hash")
+	c.SetParamValues(testAddress)
+
+	s.address.EXPECT().
+		ByHash(gomock.Any(), testHashAddress).
+		Return(storage.Address{
+			Id:      1,
+			Hash:    testHashAddress,
+			Address: testAddress,
+		}, nil)
+
+	s.votes.EXPECT().
+		ByVoter(gomock.Any(), uint64(1), 10, 0).
+		Return([]storage.Vote{
+			{
+				Id:         2,
+				Height:     103,
+				Time:       testTime,
+				ProposalId: 2,
+				VoterId:    1,
+				Option:     types.VoteOptionNo,
+				Weight:     decimal.RequireFromString("1"),
+				TxId:       6,
+				MsgId:      7,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.VoterHistory(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var votes []responses.Vote
+	err := json.NewDecoder(rec.Body).Decode(&votes)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 1)
+	s.Require().EqualValues(2, votes[0].ProposalId)
+	s.Require().Equal(types.VoteOptionNo, votes[0].Option)
+	s.Require().Empty(votes[0].Voter)
+}
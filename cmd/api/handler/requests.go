@@ -127,6 +127,23 @@ func (p *addressTxRequest) SetDefault() {
 	}
 }
 
+type addressBalanceHistoryRequest struct {
+	Hash     string `param:"hash"     validate:"required,address"`
+	Limit    uint64 `query:"limit"    validate:"omitempty,min=1,max=100"`
+	Offset   uint64 `query:"offset"   validate:"omitempty,min=0"`
+	Sort     string `query:"sort"     validate:"omitempty,oneof=asc desc"`
+	Currency string `query:"currency" validate:"omitempty"`
+}
+
+func (p *addressBalanceHistoryRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
 type namespacesByHeightRequest struct {
 	Limit  uint64         `query:"limit"  validate:"omitempty,min=1,max=100"`
 	Offset uint64         `query:"offset" validate:"omitempty,min=0"`
@@ -138,3 +155,100 @@ func (p *namespacesByHeightRequest) SetDefault() {
 		p.Limit = 10
 	}
 }
+
+type addressPageRequest struct {
+	Hash   string `param:"hash"   validate:"required,address"`
+	Limit  uint64 `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset uint64 `query:"offset" validate:"omitempty,min=0"`
+}
+
+func (p *addressPageRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+}
+
+type validatorPageRequest struct {
+	Address string `param:"address" validate:"required,validator_address"`
+	Limit   uint64 `query:"limit"   validate:"omitempty,min=1,max=100"`
+	Offset  uint64 `query:"offset"  validate:"omitempty,min=0"`
+}
+
+func (p *validatorPageRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+}
+
+type validatorUptimeRequest struct {
+	Address string `param:"address" validate:"required,validator_address"`
+	Limit   uint64 `query:"limit"   validate:"omitempty,min=1,max=100"`
+}
+
+func (p *validatorUptimeRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 100
+	}
+}
+
+type validatorListRequest struct {
+	Limit  uint64 `query:"limit"   validate:"omitempty,min=1,max=100"`
+	Offset uint64 `query:"offset"  validate:"omitempty,min=0"`
+	Sort   string `query:"sort"    validate:"omitempty,oneof=asc desc"`
+	SortBy string `query:"sort_by" validate:"omitempty,oneof=id height rate max_rate min_self_delegation power"`
+}
+
+func (p *validatorListRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = asc
+	}
+}
+
+type proposalListRequest struct {
+	Limit  uint64      `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset uint64      `query:"offset" validate:"omitempty,min=0"`
+	Sort   string      `query:"sort"   validate:"omitempty,oneof=asc desc"`
+	Status StringArray `query:"status" validate:"omitempty,dive,proposal_status"`
+}
+
+func (p *proposalListRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+type ibcTransferListRequest struct {
+	Limit   uint64      `query:"limit"   validate:"omitempty,min=1,max=100"`
+	Offset  uint64      `query:"offset"  validate:"omitempty,min=0"`
+	Sort    string      `query:"sort"    validate:"omitempty,oneof=asc desc"`
+	Address string      `query:"address" validate:"omitempty,max=128"`
+	Channel string      `query:"channel" validate:"omitempty,max=64"`
+	Status  StringArray `query:"status"  validate:"omitempty,dive,ibc_transfer_status"`
+}
+
+func (p *ibcTransferListRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+	if p.Sort == "" {
+		p.Sort = desc
+	}
+}
+
+type proposalPageRequest struct {
+	Id     uint64 `param:"id"     validate:"required,min=1"`
+	Limit  uint64 `query:"limit"  validate:"omitempty,min=1,max=100"`
+	Offset uint64 `query:"offset" validate:"omitempty,min=0"`
+}
+
+func (p *proposalPageRequest) SetDefault() {
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+}
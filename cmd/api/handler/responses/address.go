@@ -4,6 +4,7 @@
 package responses
 
 import (
+	"github.com/dipdup-io/celestia-indexer/internal/consts"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
 )
@@ -17,19 +18,22 @@ type Address struct {
 	LastHeight pkgTypes.Level `example:"100"                                             json:"last_height"  swaggertype:"integer"`
 	Hash       string         `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60" json:"hash"         swaggertype:"string"`
 	Balance    Balance        `json:"balance"`
+	Balances   []Balance      `json:"balances"`
 }
 
 func NewAddress(addr storage.Address) Address {
-	return Address{
+	address := Address{
 		Id:         addr.Id,
 		Height:     addr.Height,
 		LastHeight: addr.LastHeight,
 		Hash:       addr.Address,
-		Balance: Balance{
-			Currency: addr.Balance.Currency,
-			Value:    addr.Balance.Total.String(),
-		},
+		Balance:    NewBalance(addr.Balance(consts.DefaultCurrency)),
+		Balances:   make([]Balance, len(addr.Balances)),
 	}
+	for i := range addr.Balances {
+		address.Balances[i] = NewBalance(addr.Balances[i])
+	}
+	return address
 }
 
 func (Address) SearchType() string {
@@ -42,4 +46,18 @@ func (Address) SearchType() string {
 type Balance struct {
 	Currency string `example:"utia"        json:"currency" swaggertype:"string"`
 	Value    string `example:"10000000000" json:"value"    swaggertype:"string"`
+
+	Metadata *DenomMetadata `json:"metadata,omitempty"`
+}
+
+func NewBalance(balance storage.Balance) Balance {
+	b := Balance{
+		Currency: balance.Currency,
+		Value:    balance.Total.String(),
+	}
+	if balance.Metadata != nil && balance.Metadata.Base != "" {
+		metadata := NewDenomMetadata(*balance.Metadata)
+		b.Metadata = &metadata
+	}
+	return b
 }
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+// BalanceUpdate model info
+//
+//	@Description	Change of address balance
+type BalanceUpdate struct {
+	Id            uint64         `example:"321"                       format:"int64"     json:"id"              swaggertype:"integer"`
+	Height        pkgTypes.Level `example:"100"                       format:"int64"     json:"height"          swaggertype:"integer"`
+	Time          time.Time      `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"            swaggertype:"string"`
+	Currency      string         `example:"utia"                                         json:"currency"        swaggertype:"string"`
+	Delta         string         `example:"-10000"                                       json:"delta"           swaggertype:"string"`
+	TxId          uint64         `example:"11"                        format:"int64"     json:"tx_id,omitempty" swaggertype:"integer"`
+	EventPosition int64          `example:"1"                         format:"int64"     json:"event_position"  swaggertype:"integer"`
+
+	EventType types.EventType `example:"coin_spent" json:"event_type"`
+}
+
+func NewBalanceUpdate(update storage.BalanceUpdate) BalanceUpdate {
+	result := BalanceUpdate{
+		Id:            update.Id,
+		Height:        update.Height,
+		Time:          update.Time,
+		Currency:      update.Currency,
+		Delta:         update.Delta.String(),
+		EventPosition: update.EventPosition,
+		EventType:     update.EventType,
+	}
+
+	if update.TxId != nil {
+		result.TxId = *update.TxId
+	}
+
+	return result
+}
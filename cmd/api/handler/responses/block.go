@@ -32,7 +32,8 @@ type Block struct {
 
 	MessageTypes []types.MsgType `example:"MsgSend,MsgUnjail" json:"message_types" swaggertype:"array,string"`
 
-	Stats *BlockStats `json:"stats,omitempty"`
+	Stats    *BlockStats `json:"stats,omitempty"`
+	Evidence []Evidence  `json:"evidence,omitempty"`
 }
 
 func NewBlock(block storage.Block, withStats bool) Block {
@@ -56,6 +57,13 @@ func NewBlock(block storage.Block, withStats bool) Block {
 		MessageTypes:       block.MessageTypes.Names(),
 	}
 
+	if len(block.Evidence) > 0 {
+		result.Evidence = make([]Evidence, len(block.Evidence))
+		for i := range block.Evidence {
+			result.Evidence[i] = NewEvidence(block.Evidence[i])
+		}
+	}
+
 	if withStats {
 		result.Stats = NewBlockStats(block.Stats)
 	}
@@ -26,6 +26,18 @@ type DenomMetadata struct {
 	Units json.RawMessage `json:"units"`
 }
 
+func NewDenomMetadata(metadata storage.DenomMetadata) DenomMetadata {
+	return DenomMetadata{
+		Base:        metadata.Base,
+		Symbol:      metadata.Symbol,
+		Name:        metadata.Name,
+		Description: metadata.Description,
+		Display:     metadata.Display,
+		Uri:         metadata.Uri,
+		Units:       metadata.Units,
+	}
+}
+
 func NewConstants(consts []storage.Constant, denomMetadata []storage.DenomMetadata) Constants {
 	response := Constants{
 		Module:        make(map[string]Params),
@@ -43,13 +55,7 @@ func NewConstants(consts []storage.Constant, denomMetadata []storage.DenomMetada
 	}
 
 	for i := range denomMetadata {
-		response.DenomMetadata[i].Base = denomMetadata[i].Base
-		response.DenomMetadata[i].Symbol = denomMetadata[i].Symbol
-		response.DenomMetadata[i].Name = denomMetadata[i].Name
-		response.DenomMetadata[i].Description = denomMetadata[i].Description
-		response.DenomMetadata[i].Display = denomMetadata[i].Display
-		response.DenomMetadata[i].Uri = denomMetadata[i].Uri
-		response.DenomMetadata[i].Units = denomMetadata[i].Units
+		response.DenomMetadata[i] = NewDenomMetadata(denomMetadata[i])
 	}
 
 	return response
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+// Delegation model info
+//
+//	@Description	Current delegation
+type Delegation struct {
+	Delegator string `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"        json:"delegator,omitempty" swaggertype:"string"`
+	Validator string `example:"celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x" json:"validator"           swaggertype:"string"`
+	Amount    string `example:"10000"                                                  json:"amount"              swaggertype:"string"`
+}
+
+func NewDelegation(delegation storage.Delegation) Delegation {
+	result := Delegation{
+		Validator: delegation.Validator,
+		Amount:    delegation.Amount.String(),
+	}
+
+	if delegation.Address != nil {
+		result.Delegator = delegation.Address.Address
+	}
+
+	return result
+}
+
+// Unbonding model info
+//
+//	@Description	Unbonding delegation entry
+type Unbonding struct {
+	Id             uint64         `example:"321"                                                    format:"int64"     json:"id"              swaggertype:"integer"`
+	Height         pkgTypes.Level `example:"100"                                                    format:"int64"     json:"height"          swaggertype:"integer"`
+	Time           time.Time      `example:"2023-07-04T03:10:57+00:00"                              format:"date-time" json:"time"            swaggertype:"string"`
+	Validator      string         `example:"celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x"                    json:"validator"       swaggertype:"string"`
+	Amount         string         `example:"10000"                                                                     json:"amount"          swaggertype:"string"`
+	CompletionTime time.Time      `example:"2023-07-25T03:10:57+00:00"                              format:"date-time" json:"completion_time" swaggertype:"string"`
+	TxId           uint64         `example:"11"                                                     format:"int64"     json:"tx_id"           swaggertype:"integer"`
+}
+
+func NewUnbonding(unbonding storage.Unbonding) Unbonding {
+	return Unbonding{
+		Id:             unbonding.Id,
+		Height:         unbonding.Height,
+		Time:           unbonding.Time,
+		Validator:      unbonding.Validator,
+		Amount:         unbonding.Amount.String(),
+		CompletionTime: unbonding.CompletionTime,
+		TxId:           unbonding.TxId,
+	}
+}
+
+// Redelegation model info
+//
+//	@Description	Redelegation entry
+type Redelegation struct {
+	Id             uint64         `example:"321"                                                    format:"int64"     json:"id"              swaggertype:"integer"`
+	Height         pkgTypes.Level `example:"100"                                                    format:"int64"     json:"height"          swaggertype:"integer"`
+	Time           time.Time      `example:"2023-07-04T03:10:57+00:00"                              format:"date-time" json:"time"            swaggertype:"string"`
+	Source         string         `example:"celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x"                    json:"source"          swaggertype:"string"`
+	Destination    string         `example:"celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y"                    json:"destination"     swaggertype:"string"`
+	Amount         string         `example:"10000"                                                                     json:"amount"          swaggertype:"string"`
+	CompletionTime time.Time      `example:"2023-07-25T03:10:57+00:00"                              format:"date-time" json:"completion_time" swaggertype:"string"`
+	TxId           uint64         `example:"11"                                                     format:"int64"     json:"tx_id"           swaggertype:"integer"`
+}
+
+func NewRedelegation(redelegation storage.Redelegation) Redelegation {
+	return Redelegation{
+		Id:             redelegation.Id,
+		Height:         redelegation.Height,
+		Time:           redelegation.Time,
+		Source:         redelegation.Source,
+		Destination:    redelegation.Destination,
+		Amount:         redelegation.Amount.String(),
+		CompletionTime: redelegation.CompletionTime,
+		TxId:           redelegation.TxId,
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+// Evidence model info
+//
+//	@Description	Evidence of validator misbehavior included into the block
+type Evidence struct {
+	Id     uint64         `example:"321"                       format:"int64"     json:"id"     swaggertype:"integer"`
+	Height pkgTypes.Level `example:"100"                       format:"int64"     json:"height" swaggertype:"integer"`
+	Time   time.Time      `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"   swaggertype:"string"`
+
+	Type        types.EvidenceType `example:"duplicate_vote"                                         json:"type"`
+	Validator   string             `example:"celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x" json:"validator,omitempty" swaggertype:"string"`
+	Moniker     string             `example:"Easy 2 Stake"                                           json:"moniker,omitempty"   swaggertype:"string"`
+	ConsAddress pkgTypes.Hex       `example:"1AF8F0F4B0C4C2B1F3C6D3E0A4B5C6D7E8F9A0B1"               json:"cons_address"        swaggertype:"string"`
+
+	InfractionHeight pkgTypes.Level `example:"99"                        format:"int64"     json:"infraction_height"  swaggertype:"integer"`
+	InfractionTime   time.Time      `example:"2023-07-04T03:10:50+00:00" format:"date-time" json:"infraction_time"    swaggertype:"string"`
+	ValidatorPower   int64          `example:"1000"                      format:"int64"     json:"validator_power"    swaggertype:"integer"`
+	TotalVotingPower int64          `example:"100000"                    format:"int64"     json:"total_voting_power" swaggertype:"integer"`
+}
+
+func NewEvidence(evidence storage.Evidence) Evidence {
+	result := Evidence{
+		Id:               evidence.Id,
+		Height:           evidence.Height,
+		Time:             evidence.Time,
+		Type:             evidence.Type,
+		ConsAddress:      evidence.ConsAddress,
+		InfractionHeight: evidence.InfractionHeight,
+		InfractionTime:   evidence.InfractionTime,
+		ValidatorPower:   evidence.ValidatorPower,
+		TotalVotingPower: evidence.TotalVotingPower,
+	}
+	if evidence.Validator != nil {
+		result.Validator = evidence.Validator.Address
+		result.Moniker = evidence.Validator.Moniker
+	}
+	return result
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+// Grant model info
+//
+//	@Description	Active authz grant. Spend limit is the value at grant time: it isn't decreased by messages executed with the grant.
+type Grant struct {
+	Height        pkgTypes.Level          `example:"100"                                             format:"int64"     json:"height"               swaggertype:"integer"`
+	Time          time.Time               `example:"2023-07-04T03:10:57+00:00"                       format:"date-time" json:"time"                 swaggertype:"string"`
+	Granter       string                  `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                    json:"granter,omitempty"    swaggertype:"string"`
+	Grantee       string                  `example:"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"                    json:"grantee,omitempty"    swaggertype:"string"`
+	Authorization types.AuthorizationType `example:"send"                                                               json:"authorization"`
+	MsgType       string                  `example:"/cosmos.bank.v1beta1.MsgSend"                                       json:"msg_type"             swaggertype:"string"`
+	SpendLimit    string                  `example:"1000"                                                               json:"spend_limit"          swaggertype:"string"`
+	Expiration    *time.Time              `example:"2024-07-04T03:10:57+00:00"                       format:"date-time" json:"expiration,omitempty" swaggertype:"string"`
+	TxId          uint64                  `example:"11"                                              format:"int64"     json:"tx_id"                swaggertype:"integer"`
+}
+
+func NewGrant(grant storage.Grant) Grant {
+	result := Grant{
+		Height:        grant.Height,
+		Time:          grant.Time,
+		Authorization: grant.Authorization,
+		MsgType:       grant.MsgType,
+		SpendLimit:    grant.SpendLimit.String(),
+		TxId:          grant.TxId,
+	}
+	if grant.Granter != nil {
+		result.Granter = grant.Granter.Address
+	}
+	if grant.Grantee != nil {
+		result.Grantee = grant.Grantee.Address
+	}
+	if !grant.Expiration.IsZero() {
+		result.Expiration = &grant.Expiration
+	}
+	return result
+}
+
+// FeeAllowance model info
+//
+//	@Description	Active feegrant allowance
+type FeeAllowance struct {
+	Height           pkgTypes.Level         `example:"100"                                             format:"int64"     json:"height"                     swaggertype:"integer"`
+	Time             time.Time              `example:"2023-07-04T03:10:57+00:00"                       format:"date-time" json:"time"                       swaggertype:"string"`
+	Granter          string                 `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                    json:"granter,omitempty"          swaggertype:"string"`
+	Grantee          string                 `example:"celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8"                    json:"grantee,omitempty"          swaggertype:"string"`
+	Type             types.FeeAllowanceType `example:"basic"                                                              json:"type"`
+	SpendLimit       string                 `example:"1000"                                                               json:"spend_limit"                swaggertype:"string"`
+	Spent            string                 `example:"200"                                                                json:"spent"                      swaggertype:"string"`
+	PeriodSpendLimit string                 `example:"100"                                                                json:"period_spend_limit"         swaggertype:"string"`
+	Period           int64                  `example:"3600"                                            format:"int64"     json:"period,omitempty"           swaggertype:"integer"`
+	AllowedMessages  []string               `example:"/cosmos.bank.v1beta1.MsgSend"                                       json:"allowed_messages,omitempty"`
+	Expiration       *time.Time             `example:"2024-07-04T03:10:57+00:00"                       format:"date-time" json:"expiration,omitempty"       swaggertype:"string"`
+	TxId             uint64                 `example:"11"                                              format:"int64"     json:"tx_id"                      swaggertype:"integer"`
+}
+
+func NewFeeAllowance(allowance storage.FeeAllowance) FeeAllowance {
+	result := FeeAllowance{
+		Height:           allowance.Height,
+		Time:             allowance.Time,
+		Type:             allowance.Type,
+		SpendLimit:       allowance.SpendLimit.String(),
+		Spent:            allowance.Spent.String(),
+		PeriodSpendLimit: allowance.PeriodSpendLimit.String(),
+		Period:           allowance.Period,
+		AllowedMessages:  allowance.AllowedMessages,
+		TxId:             allowance.TxId,
+	}
+	if allowance.Granter != nil {
+		result.Granter = allowance.Granter.Address
+	}
+	if allowance.Grantee != nil {
+		result.Grantee = allowance.Grantee.Address
+	}
+	if !allowance.Expiration.IsZero() {
+		result.Expiration = &allowance.Expiration
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+// IbcTransfer model info
+//
+//	@Description	IBC fungible token transfer
+type IbcTransfer struct {
+	Id                 uint64                  `example:"321"                                             format:"int64"     json:"id"                  swaggertype:"integer"`
+	Height             pkgTypes.Level          `example:"100"                                             format:"int64"     json:"height"              swaggertype:"integer"`
+	Time               time.Time               `example:"2023-07-04T03:10:57+00:00"                       format:"date-time" json:"time"                swaggertype:"string"`
+	Incoming           bool                    `example:"false"                                                              json:"incoming"`
+	Status             types.IbcTransferStatus `example:"success"                                                            json:"status"`
+	SourcePort         string                  `example:"transfer"                                                           json:"source_port"         swaggertype:"string"`
+	SourceChannel      string                  `example:"channel-2"                                                          json:"source_channel"      swaggertype:"string"`
+	DestinationPort    string                  `example:"transfer"                                                           json:"destination_port"    swaggertype:"string"`
+	DestinationChannel string                  `example:"channel-6994"                                                       json:"destination_channel" swaggertype:"string"`
+	Sequence           uint64                  `example:"1024"                                            format:"int64"     json:"sequence"            swaggertype:"integer"`
+	Sender             string                  `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                    json:"sender"              swaggertype:"string"`
+	Receiver           string                  `example:"osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkm8xnha"                        json:"receiver"            swaggertype:"string"`
+	Amount             string                  `example:"1000000"                                                            json:"amount"              swaggertype:"string"`
+	Denom              string                  `example:"utia"                                                               json:"denom"               swaggertype:"string"`
+	Memo               string                  `example:"memo"                                                               json:"memo,omitempty"      swaggertype:"string"`
+	TxId               uint64                  `example:"11"                                              format:"int64"     json:"tx_id"               swaggertype:"integer"`
+
+	ResolvedHeight pkgTypes.Level `example:"110"                       format:"int64"     json:"resolved_height,omitempty" swaggertype:"integer"`
+	ResolvedTime   *time.Time     `example:"2023-07-04T03:11:57+00:00" format:"date-time" json:"resolved_time,omitempty"   swaggertype:"string"`
+	ResolvedTxId   uint64         `example:"12"                        format:"int64"     json:"resolved_tx_id,omitempty"  swaggertype:"integer"`
+}
+
+func NewIbcTransfer(transfer storage.IbcTransfer) IbcTransfer {
+	result := IbcTransfer{
+		Id:                 transfer.Id,
+		Height:             transfer.Height,
+		Time:               transfer.Time,
+		Incoming:           transfer.Incoming,
+		Status:             transfer.Status,
+		SourcePort:         transfer.SourcePort,
+		SourceChannel:      transfer.SourceChannel,
+		DestinationPort:    transfer.DestinationPort,
+		DestinationChannel: transfer.DestinationChannel,
+		Sequence:           transfer.Sequence,
+		Sender:             transfer.Sender,
+		Receiver:           transfer.Receiver,
+		Amount:             transfer.Amount.String(),
+		Denom:              transfer.Denom,
+		Memo:               transfer.Memo,
+		TxId:               transfer.TxId,
+		ResolvedHeight:     transfer.ResolvedHeight,
+		ResolvedTxId:       transfer.ResolvedTxId,
+	}
+	if !transfer.ResolvedTime.IsZero() {
+		result.ResolvedTime = &transfer.ResolvedTime
+	}
+	return result
+}
@@ -12,11 +12,12 @@ import (
 )
 
 type Message struct {
-	Id       uint64         `example:"321"                       format:"int64"     json:"id"              swaggertype:"integer"`
-	Height   pkgTypes.Level `example:"100"                       format:"int64"     json:"height"          swaggertype:"integer"`
-	Time     time.Time      `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"            swaggertype:"string"`
-	Position int64          `example:"2"                         format:"int64"     json:"position"        swaggertype:"integer"`
-	TxId     uint64         `example:"11"                        format:"int64"     json:"tx_id,omitempty" swaggertype:"integer"`
+	Id       uint64         `example:"321"                       format:"int64"     json:"id"                  swaggertype:"integer"`
+	Height   pkgTypes.Level `example:"100"                       format:"int64"     json:"height"              swaggertype:"integer"`
+	Time     time.Time      `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"                swaggertype:"string"`
+	Position int64          `example:"2"                         format:"int64"     json:"position"            swaggertype:"integer"`
+	TxId     uint64         `example:"11"                        format:"int64"     json:"tx_id,omitempty"     swaggertype:"integer"`
+	ParentId *uint64        `example:"320"                       format:"int64"     json:"parent_id,omitempty" swaggertype:"integer"`
 
 	Type types.MsgType `example:"MsgCreatePeriodicVestingAccount" json:"type"`
 
@@ -31,6 +32,7 @@ func NewMessage(msg storage.Message) Message {
 		Position: msg.Position,
 		Type:     msg.Type,
 		TxId:     msg.TxId,
+		ParentId: msg.ParentId,
 		Data:     msg.Data,
 	}
 }
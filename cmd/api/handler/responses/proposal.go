@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+// Proposal model info
+//
+//	@Description	Governance proposal
+type Proposal struct {
+	Id          uint64               `example:"1"                                               format:"int64"     json:"id"                 swaggertype:"integer"`
+	Height      pkgTypes.Level       `example:"100"                                             format:"int64"     json:"height"             swaggertype:"integer"`
+	Time        time.Time            `example:"2023-07-04T03:10:57+00:00"                       format:"date-time" json:"time"               swaggertype:"string"`
+	Proposer    string               `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                    json:"proposer,omitempty" swaggertype:"string"`
+	Title       string               `example:"Increase max validators"                                            json:"title"              swaggertype:"string"`
+	Description string               `example:"Proposal description"                                               json:"description"        swaggertype:"string"`
+	Type        types.ProposalType   `example:"param_changed"                                                      json:"type"`
+	Status      types.ProposalStatus `example:"voting_period"                                                      json:"status"`
+	Deposit     string               `example:"10000000000"                                                        json:"deposit"            swaggertype:"string"`
+	TxId        uint64               `example:"11"                                              format:"int64"     json:"tx_id"              swaggertype:"integer"`
+
+	VotingStartHeight pkgTypes.Level `example:"101"                       format:"int64"     json:"voting_start_height,omitempty" swaggertype:"integer"`
+	VotingStartTime   *time.Time     `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"voting_start_time,omitempty"   swaggertype:"string"`
+	EndHeight         pkgTypes.Level `example:"200"                       format:"int64"     json:"end_height,omitempty"          swaggertype:"integer"`
+	EndTime           *time.Time     `example:"2023-07-11T03:10:57+00:00" format:"date-time" json:"end_time,omitempty"            swaggertype:"string"`
+
+	VoteCounts *VoteCounts `json:"vote_counts,omitempty"`
+}
+
+func NewProposal(proposal storage.Proposal) Proposal {
+	result := Proposal{
+		Id:                proposal.Id,
+		Height:            proposal.Height,
+		Time:              proposal.Time,
+		Title:             proposal.Title,
+		Description:       proposal.Description,
+		Type:              proposal.Type,
+		Status:            proposal.Status,
+		Deposit:           proposal.Deposit.String(),
+		TxId:              proposal.TxId,
+		VotingStartHeight: proposal.VotingStartHeight,
+		EndHeight:         proposal.EndHeight,
+	}
+	if proposal.Proposer != nil {
+		result.Proposer = proposal.Proposer.Address
+	}
+	if !proposal.VotingStartTime.IsZero() {
+		result.VotingStartTime = &proposal.VotingStartTime
+	}
+	if !proposal.EndTime.IsZero() {
+		result.EndTime = &proposal.EndTime
+	}
+	return result
+}
+
+// VoteCountOption model info
+//
+//	@Description	Count of voters and summary weight of the option in their votes. Weight isn't weighted by stake.
+type VoteCountOption struct {
+	Weight string `example:"12.5" json:"weight" swaggertype:"string"`
+	Count  int64  `example:"15"   json:"count"  swaggertype:"integer"`
+}
+
+// VoteCounts model info
+//
+//	@Description	Per-option counts of the last votes of each voter. Votes are not weighted by stake, so it isn't an on-chain tally.
+type VoteCounts struct {
+	Yes        VoteCountOption `json:"yes"`
+	Abstain    VoteCountOption `json:"abstain"`
+	No         VoteCountOption `json:"no"`
+	NoWithVeto VoteCountOption `json:"no_with_veto"`
+}
+
+func NewVoteCounts(counts []storage.VoteCount) VoteCounts {
+	result := VoteCounts{
+		Yes:        VoteCountOption{Weight: "0"},
+		Abstain:    VoteCountOption{Weight: "0"},
+		No:         VoteCountOption{Weight: "0"},
+		NoWithVeto: VoteCountOption{Weight: "0"},
+	}
+	for i := range counts {
+		option := VoteCountOption{
+			Weight: counts[i].Weight.String(),
+			Count:  counts[i].Count,
+		}
+		switch counts[i].Option {
+		case types.VoteOptionYes:
+			result.Yes = option
+		case types.VoteOptionAbstain:
+			result.Abstain = option
+		case types.VoteOptionNo:
+			result.No = option
+		case types.VoteOptionNoWithVeto:
+			result.NoWithVeto = option
+		}
+	}
+	return result
+}
+
+// Vote model info
+//
+//	@Description	Governance vote
+type Vote struct {
+	Id         uint64           `example:"321"                                             format:"int64"     json:"id"              swaggertype:"integer"`
+	Height     pkgTypes.Level   `example:"100"                                             format:"int64"     json:"height"          swaggertype:"integer"`
+	Time       time.Time        `example:"2023-07-04T03:10:57+00:00"                       format:"date-time" json:"time"            swaggertype:"string"`
+	ProposalId uint64           `example:"1"                                               format:"int64"     json:"proposal_id"     swaggertype:"integer"`
+	Voter      string           `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                    json:"voter,omitempty" swaggertype:"string"`
+	Option     types.VoteOption `example:"yes"                                                                json:"option"`
+	Weight     string           `example:"1"                                                                  json:"weight"          swaggertype:"string"`
+	TxId       uint64           `example:"11"                                              format:"int64"     json:"tx_id"           swaggertype:"integer"`
+}
+
+func NewVote(vote storage.Vote) Vote {
+	result := Vote{
+		Id:         vote.Id,
+		Height:     vote.Height,
+		Time:       vote.Time,
+		ProposalId: vote.ProposalId,
+		Option:     vote.Option,
+		Weight:     vote.Weight.String(),
+		TxId:       vote.TxId,
+	}
+	if vote.Voter != nil {
+		result.Voter = vote.Voter.Address
+	}
+	return result
+}
+
+// Deposit model info
+//
+//	@Description	Deposit to governance proposal
+type Deposit struct {
+	Id         uint64         `example:"321"                                             format:"int64"     json:"id"                  swaggertype:"integer"`
+	Height     pkgTypes.Level `example:"100"                                             format:"int64"     json:"height"              swaggertype:"integer"`
+	Time       time.Time      `example:"2023-07-04T03:10:57+00:00"                       format:"date-time" json:"time"                swaggertype:"string"`
+	ProposalId uint64         `example:"1"                                               format:"int64"     json:"proposal_id"         swaggertype:"integer"`
+	Depositor  string         `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"                    json:"depositor,omitempty" swaggertype:"string"`
+	Amount     string         `example:"10000"                                                              json:"amount"              swaggertype:"string"`
+	TxId       uint64         `example:"11"                                              format:"int64"     json:"tx_id"               swaggertype:"integer"`
+}
+
+func NewDeposit(deposit storage.Deposit) Deposit {
+	result := Deposit{
+		Id:         deposit.Id,
+		Height:     deposit.Height,
+		Time:       deposit.Time,
+		ProposalId: deposit.ProposalId,
+		Amount:     deposit.Amount.String(),
+		TxId:       deposit.TxId,
+	}
+	if deposit.Depositor != nil {
+		result.Depositor = deposit.Depositor.Address
+	}
+	return result
+}
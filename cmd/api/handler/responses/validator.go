@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package responses
+
+import (
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+)
+
+// Validator model info
+//
+//	@Description	Validator info
+type Validator struct {
+	Id     uint64         `example:"321" format:"int64" json:"id"     swaggertype:"integer"`
+	Height pkgTypes.Level `example:"100" format:"int64" json:"height" swaggertype:"integer"`
+
+	Delegator   string       `example:"celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"        json:"delegator"    swaggertype:"string"`
+	Address     string       `example:"celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x" json:"address"      swaggertype:"string"`
+	ConsAddress pkgTypes.Hex `example:"1AF8F0F4B0C4C2B1F3C6D3E0A4B5C6D7E8F9A0B1"               json:"cons_address" swaggertype:"string"`
+
+	Status types.ValidatorStatus `example:"bonded"                json:"status"`
+	Power  int64                 `example:"1000"   format:"int64" json:"power"  swaggertype:"integer"`
+
+	Moniker  string `example:"Easy 2 Stake"                      json:"moniker"  swaggertype:"string"`
+	Website  string `example:"https://www.easy2stake.com/"       json:"website"  swaggertype:"string"`
+	Identity string `example:"2C877AC873132C91"                  json:"identity" swaggertype:"string"`
+	Contacts string `example:"security@0xfury.com"               json:"contacts" swaggertype:"string"`
+	Details  string `example:"Enterprise-grade staking provider" json:"details"  swaggertype:"string"`
+
+	Rate              string `example:"0.03" json:"rate"                swaggertype:"string"`
+	MaxRate           string `example:"0.1"  json:"max_rate"            swaggertype:"string"`
+	MaxChangeRate     string `example:"0.01" json:"max_change_rate"     swaggertype:"string"`
+	MinSelfDelegation string `example:"1"    json:"min_self_delegation" swaggertype:"string"`
+}
+
+func NewValidator(val storage.Validator) Validator {
+	return Validator{
+		Id:                val.Id,
+		Height:            val.Height,
+		Delegator:         val.Delegator,
+		Address:           val.Address,
+		ConsAddress:       val.ConsAddress,
+		Status:            val.Status,
+		Power:             val.Power,
+		Moniker:           val.Moniker,
+		Website:           val.Website,
+		Identity:          val.Identity,
+		Contacts:          val.Contacts,
+		Details:           val.Details,
+		Rate:              val.Rate.String(),
+		MaxRate:           val.MaxRate.String(),
+		MaxChangeRate:     val.MaxChangeRate.String(),
+		MinSelfDelegation: val.MinSelfDelegation.String(),
+	}
+}
+
+// ValidatorHistory model info
+//
+//	@Description	Change of validator voting power or status
+type ValidatorHistory struct {
+	Id     uint64         `example:"321"                       format:"int64"     json:"id"     swaggertype:"integer"`
+	Height pkgTypes.Level `example:"100"                       format:"int64"     json:"height" swaggertype:"integer"`
+	Time   time.Time      `example:"2023-07-04T03:10:57+00:00" format:"date-time" json:"time"   swaggertype:"string"`
+
+	Type       types.ValidatorHistoryType `example:"slash"                 json:"type"`
+	Power      int64                      `example:"990"    format:"int64" json:"power"       swaggertype:"integer"`
+	PrevPower  int64                      `example:"1000"   format:"int64" json:"prev_power"  swaggertype:"integer"`
+	Status     types.ValidatorStatus      `example:"jailed"                json:"status"`
+	PrevStatus types.ValidatorStatus      `example:"bonded"                json:"prev_status"`
+
+	Reason      string `example:"double_sign"                json:"reason,omitempty"       swaggertype:"string"`
+	SlashPower  int64  `example:"1000"        format:"int64" json:"slash_power,omitempty"  swaggertype:"integer"`
+	BurnedCoins string `example:"10000"                      json:"burned_coins,omitempty" swaggertype:"string"`
+}
+
+func NewValidatorHistory(h storage.ValidatorHistory) ValidatorHistory {
+	history := ValidatorHistory{
+		Id:         h.Id,
+		Height:     h.Height,
+		Time:       h.Time,
+		Type:       h.Type,
+		Power:      h.Power,
+		PrevPower:  h.PrevPower,
+		Status:     h.Status,
+		PrevStatus: h.PrevStatus,
+		Reason:     h.Reason,
+		SlashPower: h.SlashPower,
+	}
+	if h.Type == types.ValidatorHistoryTypeSlash {
+		history.BurnedCoins = h.BurnedCoins.String()
+	}
+	return history
+}
+
+// ValidatorUptime model info
+//
+//	@Description	Validator uptime over the last blocks
+type ValidatorUptime struct {
+	Uptime         string `example:"0.97" json:"uptime"          swaggertype:"string"`
+	Blocks         int64  `example:"100"  json:"blocks"          swaggertype:"integer"`
+	SignedBlocks   int64  `example:"97"   json:"signed_blocks"   swaggertype:"integer"`
+	MissedBlocks   int64  `example:"3"    json:"missed_blocks"   swaggertype:"integer"`
+	ProposedBlocks int64  `example:"1024" json:"proposed_blocks" swaggertype:"integer"`
+}
+
+func NewValidatorUptime(blocks, signed, proposed int64) ValidatorUptime {
+	uptime := decimal.Zero
+	if blocks > 0 {
+		uptime = decimal.NewFromInt(signed).Div(decimal.NewFromInt(blocks))
+	}
+	return ValidatorUptime{
+		Uptime:         uptime.StringFixed(4),
+		Blocks:         blocks,
+		SignedBlocks:   signed,
+		MissedBlocks:   blocks - signed,
+		ProposedBlocks: proposed,
+	}
+}
@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dipdup-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/labstack/echo/v4"
+)
+
+type ValidatorHandler struct {
+	validators  storage.IValidator
+	delegations storage.IDelegation
+	blocks      storage.IBlock
+	signatures  storage.IBlockSignature
+}
+
+func NewValidatorHandler(
+	validators storage.IValidator,
+	delegations storage.IDelegation,
+	blocks storage.IBlock,
+	signatures storage.IBlockSignature,
+) *ValidatorHandler {
+	return &ValidatorHandler{
+		validators:  validators,
+		delegations: delegations,
+		blocks:      blocks,
+		signatures:  signatures,
+	}
+}
+
+type getValidatorRequest struct {
+	Address string `param:"address" validate:"required,validator_address"`
+}
+
+// Get godoc
+//
+//	@Summary		Get validator info
+//	@Description	Get validator info
+//	@Tags			validator
+//	@ID				get-validator
+//	@Param			address	path	string	true	"Validator operator address"	minlength(54)	maxlength(54)
+//	@Produce		json
+//	@Success		200	{object}	responses.Validator
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/validators/{address} [get]
+func (handler *ValidatorHandler) Get(c echo.Context) error {
+	req, err := bindAndValidate[getValidatorRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+
+	validator, err := handler.validators.ByAddress(c.Request().Context(), req.Address)
+	if err := handleError(c, err, handler.validators); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, responses.NewValidator(validator))
+}
+
+// List godoc
+//
+//	@Summary		List validators
+//	@Description	List validators
+//	@Tags			validator
+//	@ID				list-validator
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Param			sort	query	string	false	"Sort order"					Enums(asc, desc)
+//	@Param			sort_by	query	string	false	"Sort field"					Enums(id, height, rate, max_rate, min_self_delegation, power)
+//	@Produce		json
+//	@Success		200	{array}		responses.Validator
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/validators [get]
+func (handler *ValidatorHandler) List(c echo.Context) error {
+	req, err := bindAndValidate[validatorListRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	validators, err := handler.validators.Filter(c.Request().Context(), storage.ValidatorFilter{
+		Limit:     int(req.Limit),
+		Offset:    int(req.Offset),
+		Sort:      pgSort(req.Sort),
+		SortField: req.SortBy,
+	})
+	if err := handleError(c, err, handler.validators); err != nil {
+		return err
+	}
+
+	response := make([]responses.Validator, len(validators))
+	for i := range validators {
+		response[i] = responses.NewValidator(validators[i])
+	}
+	return returnArray(c, response)
+}
+
+// Messages godoc
+//
+//	@Summary		Get validator messages
+//	@Description	Get messages related to the validator
+//	@Tags			validator
+//	@ID				validator-messages
+//	@Param			address	path	string	true	"Validator operator address"	minlength(54)	maxlength(54)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Message
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/validators/{address}/messages [get]
+func (handler *ValidatorHandler) Messages(c echo.Context) error {
+	req, err := bindAndValidate[validatorPageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	msgs, err := handler.validators.Messages(c.Request().Context(), req.Address, int(req.Limit), int(req.Offset))
+	if err := handleError(c, err, handler.validators); err != nil {
+		return err
+	}
+
+	response := make([]responses.Message, 0, len(msgs))
+	for i := range msgs {
+		if msgs[i].Msg == nil {
+			continue
+		}
+		response = append(response, responses.NewMessage(*msgs[i].Msg))
+	}
+	return returnArray(c, response)
+}
+
+// History godoc
+//
+//	@Summary		Get validator history
+//	@Description	Get changes of validator voting power and status: power updates, slashes and jails
+//	@Tags			validator
+//	@ID				validator-history
+//	@Param			address	path	string	true	"Validator operator address"	minlength(54)	maxlength(54)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.ValidatorHistory
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/validators/{address}/history [get]
+func (handler *ValidatorHandler) History(c echo.Context) error {
+	req, err := bindAndValidate[validatorPageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	validator, err := handler.validators.ByAddress(c.Request().Context(), req.Address)
+	if err := handleError(c, err, handler.validators); err != nil {
+		return err
+	}
+
+	history, err := handler.validators.History(c.Request().Context(), validator.Id, int(req.Limit), int(req.Offset))
+	if err := handleError(c, err, handler.validators); err != nil {
+		return err
+	}
+
+	response := make([]responses.ValidatorHistory, len(history))
+	for i := range history {
+		response[i] = responses.NewValidatorHistory(history[i])
+	}
+	return returnArray(c, response)
+}
+
+// Uptime godoc
+//
+//	@Summary		Get validator uptime
+//	@Description	Get count of signed and missed blocks among the last blocks and total count of blocks proposed by the validator
+//	@Tags			validator
+//	@ID				validator-uptime
+//	@Param			address	path	string	true	"Validator operator address"	minlength(54)	maxlength(54)
+//	@Param			limit	query	integer	false	"Count of the last blocks"		mininum(1)	maximum(100)
+//	@Produce		json
+//	@Success		200	{object}	responses.ValidatorUptime
+//	@Success		204
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/validators/{address}/uptime [get]
+func (handler *ValidatorHandler) Uptime(c echo.Context) error {
+	req, err := bindAndValidate[validatorUptimeRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	validator, err := handler.validators.ByAddress(c.Request().Context(), req.Address)
+	if err := handleError(c, err, handler.validators); err != nil {
+		return err
+	}
+
+	from, to, signed, err := handler.signedBlocks(c.Request().Context(), validator, req.Limit)
+	if err := handleError(c, err, handler.blocks); err != nil {
+		return err
+	}
+
+	proposed, err := handler.blocks.ProposedCount(c.Request().Context(), validator.ConsAddress)
+	if err := handleError(c, err, handler.blocks); err != nil {
+		return err
+	}
+
+	var blocks int64
+	if to >= from {
+		blocks = int64(to - from + 1)
+	}
+	return c.JSON(http.StatusOK, responses.NewValidatorUptime(blocks, int64(len(signed)), proposed))
+}
+
+// MissedBlocks godoc
+//
+//	@Summary		Get validator missed blocks
+//	@Description	Get heights of blocks which were not signed by the validator among the last blocks
+//	@Tags			validator
+//	@ID				validator-missed-blocks
+//	@Param			address	path	string	true	"Validator operator address"	minlength(54)	maxlength(54)
+//	@Param			limit	query	integer	false	"Count of the last blocks"		mininum(1)	maximum(100)
+//	@Produce		json
+//	@Success		200	{array}		integer
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/validators/{address}/missed_blocks [get]
+func (handler *ValidatorHandler) MissedBlocks(c echo.Context) error {
+	req, err := bindAndValidate[validatorUptimeRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	validator, err := handler.validators.ByAddress(c.Request().Context(), req.Address)
+	if err := handleError(c, err, handler.validators); err != nil {
+		return err
+	}
+
+	from, to, signed, err := handler.signedBlocks(c.Request().Context(), validator, req.Limit)
+	if err := handleError(c, err, handler.blocks); err != nil {
+		return err
+	}
+
+	missed := make([]pkgTypes.Level, 0)
+	for height := to; height >= from; height-- {
+		if _, ok := signed[height]; !ok {
+			missed = append(missed, height)
+		}
+	}
+	return returnArray(c, missed)
+}
+
+// signedBlocks - returns the range of the last `limit` blocks which signatures are already received and heights signed by the validator in the range.
+// Signatures of the block are received with the next block, so the last indexed block is not included. Blocks before the validator creation are skipped.
+func (handler *ValidatorHandler) signedBlocks(ctx context.Context, validator storage.Validator, limit uint64) (from, to pkgTypes.Level, signed map[pkgTypes.Level]struct{}, err error) {
+	last, err := handler.blocks.Last(ctx)
+	if err != nil {
+		return
+	}
+	if last.Height <= 1 {
+		return 1, 0, nil, nil
+	}
+
+	to = last.Height - 1
+	from = 1
+	if to > pkgTypes.Level(limit) {
+		from = to - pkgTypes.Level(limit) + 1
+	}
+	if from <= validator.Height {
+		from = validator.Height + 1
+	}
+
+	levels, err := handler.signatures.LevelsByValidator(ctx, validator.Id, from)
+	if err != nil {
+		return
+	}
+
+	signed = make(map[pkgTypes.Level]struct{}, len(levels))
+	for i := range levels {
+		if levels[i] <= to {
+			signed[levels[i]] = struct{}{}
+		}
+	}
+	return
+}
+
+// Delegators godoc
+//
+//	@Summary		Get validator delegators
+//	@Description	Get current delegations to the validator
+//	@Tags			validator
+//	@ID				validator-delegators
+//	@Param			address	path	string	true	"Validator operator address"	minlength(54)	maxlength(54)
+//	@Param			limit	query	integer	false	"Count of requested entities"	mininum(1)	maximum(100)
+//	@Param			offset	query	integer	false	"Offset"						mininum(1)
+//	@Produce		json
+//	@Success		200	{array}		responses.Delegation
+//	@Failure		400	{object}	Error
+//	@Failure		500	{object}	Error
+//	@Router			/v1/validators/{address}/delegators [get]
+func (handler *ValidatorHandler) Delegators(c echo.Context) error {
+	req, err := bindAndValidate[validatorPageRequest](c)
+	if err != nil {
+		return badRequestError(c, err)
+	}
+	req.SetDefault()
+
+	delegations, err := handler.delegations.ByValidator(c.Request().Context(), req.Address, int(req.Limit), int(req.Offset))
+	if err := handleError(c, err, handler.delegations); err != nil {
+		return err
+	}
+
+	response := make([]responses.Delegation, len(delegations))
+	for i := range delegations {
+		response[i] = responses.NewDelegation(delegations[i])
+	}
+	return returnArray(c, response)
+}
@@ -0,0 +1,405 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/cmd/api/handler/responses"
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/mock"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// ValidatorTestSuite -
+type ValidatorTestSuite struct {
+	suite.Suite
+	validators  *mock.MockIValidator
+	delegations *mock.MockIDelegation
+	blocks      *mock.MockIBlock
+	signatures  *mock.MockIBlockSignature
+	echo        *echo.Echo
+	handler     *ValidatorHandler
+	ctrl        *gomock.Controller
+}
+
+// SetupSuite -
+func (s *ValidatorTestSuite) SetupSuite() {
+	s.echo = echo.New()
+	s.echo.Validator = NewCelestiaApiValidator()
+	s.ctrl = gomock.NewController(s.T())
+	s.validators = mock.NewMockIValidator(s.ctrl)
+	s.delegations = mock.NewMockIDelegation(s.ctrl)
+	s.blocks = mock.NewMockIBlock(s.ctrl)
+	s.signatures = mock.NewMockIBlockSignature(s.ctrl)
+	s.handler = NewValidatorHandler(s.validators, s.delegations, s.blocks, s.signatures)
+}
+
+// TearDownSuite -
+func (s *ValidatorTestSuite) TearDownSuite() {
+	s.ctrl.Finish()
+	s.Require().NoError(s.echo.Shutdown(context.Background()))
+}
+
+func TestSuiteValidator_Run(t *testing.T) {
+	suite.Run(t, new(ValidatorTestSuite))
+}
+
+func (s *ValidatorTestSuite) TestDelegators() {
+	req := httptest.NewRequest(http.MethodGet, "/?limit=5", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:address/delegators")
+	c.SetParamNames("address")
+	c.SetParamValues(testValidatorAddress)
+
+	s.delegations.EXPECT().
+		ByValidator(gomock.Any(), testValidatorAddress, 5, 0).
+		Return([]storage.Delegation{
+			{
+				AddressId: 1,
+				Validator: testValidatorAddress,
+				Amount:    decimal.RequireFromString("1000"),
+				Address: &storage.Address{
+					Id:      1,
+					Address: testAddress,
+				},
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Delegators(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var delegations []responses.Delegation
+	err := json.NewDecoder(rec.Body).Decode(&delegations)
+	s.Require().NoError(err)
+	s.Require().Len(delegations, 1)
+	s.Require().Equal(testAddress, delegations[0].Delegator)
+	s.Require().Equal(testValidatorAddress, delegations[0].Validator)
+	s.Require().Equal("1000", delegations[0].Amount)
+}
+
+func (s *ValidatorTestSuite) TestDelegatorsInvalidAddress() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:address/delegators")
+	c.SetParamNames("address")
+	c.SetParamValues(testAddress)
+
+	s.Require().NoError(s.handler.Delegators(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+var testValidator = storage.Validator{
+	Id:                1,
+	Delegator:         testAddress,
+	Address:           testValidatorAddress,
+	ConsAddress:       pkgTypes.Hex{0x01, 0x02, 0x03},
+	Status:            types.ValidatorStatusBonded,
+	Power:             1000,
+	Moniker:           "moniker",
+	Website:           "https://example.com",
+	Identity:          "identity",
+	Contacts:          "contacts",
+	Details:           "details",
+	Rate:              decimal.RequireFromString("0.1"),
+	MaxRate:           decimal.RequireFromString("0.2"),
+	MaxChangeRate:     decimal.RequireFromString("0.01"),
+	MinSelfDelegation: decimal.RequireFromString("1"),
+	MsgId:             2,
+	Height:            100,
+}
+
+func (s *ValidatorTestSuite) TestGet() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:address")
+	c.SetParamNames("address")
+	c.SetParamValues(testValidatorAddress)
+
+	s.validators.EXPECT().
+		ByAddress(gomock.Any(), testValidatorAddress).
+		Return(testValidator, nil)
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var validator responses.Validator
+	err := json.NewDecoder(rec.Body).Decode(&validator)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, validator.Id)
+	s.Require().EqualValues(100, validator.Height)
+	s.Require().Equal(testAddress, validator.Delegator)
+	s.Require().Equal(testValidatorAddress, validator.Address)
+	s.Require().Equal("010203", validator.ConsAddress.String())
+	s.Require().Equal(types.ValidatorStatusBonded, validator.Status)
+	s.Require().EqualValues(1000, validator.Power)
+	s.Require().Equal("moniker", validator.Moniker)
+	s.Require().Equal("https://example.com", validator.Website)
+	s.Require().Equal("identity", validator.Identity)
+	s.Require().Equal("contacts", validator.Contacts)
+	s.Require().Equal("details", validator.Details)
+	s.Require().Equal("0.1", validator.Rate)
+	s.Require().Equal("0.2", validator.MaxRate)
+	s.Require().Equal("0.01", validator.MaxChangeRate)
+	s.Require().Equal("1", validator.MinSelfDelegation)
+}
+
+func (s *ValidatorTestSuite) TestGetInvalidAddress() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:address")
+	c.SetParamNames("address")
+	c.SetParamValues("invalid")
+
+	s.Require().NoError(s.handler.Get(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ValidatorTestSuite) TestList() {
+	req := httptest.NewRequest(http.MethodGet, "/?limit=10&offset=0&sort=desc&sort_by=rate", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators")
+
+	s.validators.EXPECT().
+		Filter(gomock.Any(), storage.ValidatorFilter{
+			Limit:     10,
+			Offset:    0,
+			Sort:      sdk.SortOrderDesc,
+			SortField: "rate",
+		}).
+		Return([]storage.Validator{testValidator}, nil)
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var validators []responses.Validator
+	err := json.NewDecoder(rec.Body).Decode(&validators)
+	s.Require().NoError(err)
+	s.Require().Len(validators, 1)
+	s.Require().Equal(testValidatorAddress, validators[0].Address)
+	s.Require().Equal("0.1", validators[0].Rate)
+}
+
+func (s *ValidatorTestSuite) TestListInvalidSortField() {
+	req := httptest.NewRequest(http.MethodGet, "/?sort_by=unknown", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators")
+
+	s.Require().NoError(s.handler.List(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ValidatorTestSuite) TestMessages() {
+	req := httptest.NewRequest(http.MethodGet, "/?limit=5", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:address/messages")
+	c.SetParamNames("address")
+	c.SetParamValues(testValidatorAddress)
+
+	s.validators.EXPECT().
+		Messages(gomock.Any(), testValidatorAddress, 5, 0).
+		Return([]storage.ValidatorMessage{
+			{
+				Validator: testValidatorAddress,
+				MsgId:     2,
+				Type:      types.MsgAddressTypeValidator,
+				Height:    100,
+				Time:      testTime,
+				Msg: &storage.Message{
+					Id:       2,
+					Height:   100,
+					Time:     testTime,
+					Position: 0,
+					Type:     types.MsgCreateValidator,
+					TxId:     1,
+				},
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.Messages(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var msgs []responses.Message
+	err := json.NewDecoder(rec.Body).Decode(&msgs)
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 1)
+	s.Require().EqualValues(2, msgs[0].Id)
+	s.Require().EqualValues(100, msgs[0].Height)
+	s.Require().EqualValues(1, msgs[0].TxId)
+	s.Require().Equal(types.MsgCreateValidator, msgs[0].Type)
+	s.Require().Equal(testTime, msgs[0].Time)
+}
+
+func (s *ValidatorTestSuite) TestHistory() {
+	req := httptest.NewRequest(http.MethodGet, "/?limit=5", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:address/history")
+	c.SetParamNames("address")
+	c.SetParamValues(testValidatorAddress)
+
+	s.validators.EXPECT().
+		ByAddress(gomock.Any(), testValidatorAddress).
+		Return(testValidator, nil)
+
+	s.validators.EXPECT().
+		History(gomock.Any(), testValidator.Id, 5, 0).
+		Return([]storage.ValidatorHistory{
+			{
+				Id:          2,
+				Height:      101,
+				Time:        testTime,
+				ValidatorId: testValidator.Id,
+				Type:        types.ValidatorHistoryTypeSlash,
+				Power:       990,
+				PrevPower:   1000,
+				Status:      types.ValidatorStatusBonded,
+				PrevStatus:  types.ValidatorStatusBonded,
+				Reason:      "missing_signature",
+				SlashPower:  1000,
+				BurnedCoins: decimal.RequireFromString("10000"),
+			}, {
+				Id:          1,
+				Height:      100,
+				Time:        testTime,
+				ValidatorId: testValidator.Id,
+				Type:        types.ValidatorHistoryTypePowerChange,
+				Power:       1000,
+				PrevPower:   0,
+				Status:      types.ValidatorStatusBonded,
+				PrevStatus:  types.ValidatorStatusUnbonded,
+			},
+		}, nil)
+
+	s.Require().NoError(s.handler.History(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var history []responses.ValidatorHistory
+	err := json.NewDecoder(rec.Body).Decode(&history)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+
+	s.Require().EqualValues(2, history[0].Id)
+	s.Require().EqualValues(101, history[0].Height)
+	s.Require().Equal(types.ValidatorHistoryTypeSlash, history[0].Type)
+	s.Require().EqualValues(990, history[0].Power)
+	s.Require().EqualValues(1000, history[0].PrevPower)
+	s.Require().Equal("missing_signature", history[0].Reason)
+	s.Require().EqualValues(1000, history[0].SlashPower)
+	s.Require().Equal("10000", history[0].BurnedCoins)
+
+	s.Require().EqualValues(1, history[1].Id)
+	s.Require().Equal(types.ValidatorHistoryTypePowerChange, history[1].Type)
+	s.Require().Equal(types.ValidatorStatusBonded, history[1].Status)
+	s.Require().Equal(types.ValidatorStatusUnbonded, history[1].PrevStatus)
+	s.Require().Empty(history[1].BurnedCoins)
+}
+
+func (s *ValidatorTestSuite) TestHistoryInvalidAddress() {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:address/history")
+	c.SetParamNames("address")
+	c.SetParamValues("invalid")
+
+	s.Require().NoError(s.handler.History(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ValidatorTestSuite) TestUptime() {
+	req := httptest.NewRequest(http.MethodGet, "/?limit=10", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:address/uptime")
+	c.SetParamNames("address")
+	c.SetParamValues(testValidatorAddress)
+
+	s.validators.EXPECT().
+		ByAddress(gomock.Any(), testValidatorAddress).
+		Return(testValidator, nil)
+
+	s.blocks.EXPECT().
+		Last(gomock.Any()).
+		Return(storage.Block{Height: 1001}, nil)
+
+	s.signatures.EXPECT().
+		LevelsByValidator(gomock.Any(), testValidator.Id, pkgTypes.Level(991)).
+		Return([]pkgTypes.Level{1000, 999, 997, 996, 995, 994, 993, 992}, nil)
+
+	s.blocks.EXPECT().
+		ProposedCount(gomock.Any(), []byte(testValidator.ConsAddress)).
+		Return(int64(12), nil)
+
+	s.Require().NoError(s.handler.Uptime(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var uptime responses.ValidatorUptime
+	err := json.NewDecoder(rec.Body).Decode(&uptime)
+	s.Require().NoError(err)
+	s.Require().Equal("0.8000", uptime.Uptime)
+	s.Require().EqualValues(10, uptime.Blocks)
+	s.Require().EqualValues(8, uptime.SignedBlocks)
+	s.Require().EqualValues(2, uptime.MissedBlocks)
+	s.Require().EqualValues(12, uptime.ProposedBlocks)
+}
+
+func (s *ValidatorTestSuite) TestMissedBlocks() {
+	req := httptest.NewRequest(http.MethodGet, "/?limit=10", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:address/missed_blocks")
+	c.SetParamNames("address")
+	c.SetParamValues(testValidatorAddress)
+
+	s.validators.EXPECT().
+		ByAddress(gomock.Any(), testValidatorAddress).
+		Return(testValidator, nil)
+
+	s.blocks.EXPECT().
+		Last(gomock.Any()).
+		Return(storage.Block{Height: 105}, nil)
+
+	// validator was created at height 100, so blocks before 101 are skipped
+	s.signatures.EXPECT().
+		LevelsByValidator(gomock.Any(), testValidator.Id, pkgTypes.Level(101)).
+		Return([]pkgTypes.Level{104, 102}, nil)
+
+	s.Require().NoError(s.handler.MissedBlocks(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var missed []pkgTypes.Level
+	err := json.NewDecoder(rec.Body).Decode(&missed)
+	s.Require().NoError(err)
+	s.Require().Equal([]pkgTypes.Level{103, 101}, missed)
+}
+
+func (s *ValidatorTestSuite) TestUptimeInvalidLimit() {
+	req := httptest.NewRequest(http.MethodGet, "/?limit=1000", nil)
+	rec := httptest.NewRecorder()
+	c := s.echo.NewContext(req, rec)
+	c.SetPath("/validators/:address/uptime")
+	c.SetParamNames("address")
+	c.SetParamValues(testValidatorAddress)
+
+	s.Require().NoError(s.handler.Uptime(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
@@ -22,12 +22,21 @@ func NewCelestiaApiValidator() *CelestiaApiValidator {
 	if err := v.RegisterValidation("address", addressValidator()); err != nil {
 		panic(err)
 	}
+	if err := v.RegisterValidation("validator_address", validatorAddressValidator()); err != nil {
+		panic(err)
+	}
 	if err := v.RegisterValidation("status", statusValidator()); err != nil {
 		panic(err)
 	}
 	if err := v.RegisterValidation("msg_type", msgTypeValidator()); err != nil {
 		panic(err)
 	}
+	if err := v.RegisterValidation("proposal_status", proposalStatusValidator()); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("ibc_transfer_status", ibcTransferStatusValidator()); err != nil {
+		panic(err)
+	}
 	return &CelestiaApiValidator{validator: v}
 }
 
@@ -64,6 +73,16 @@ func addressValidator() validator.Func {
 	}
 }
 
+func isValidatorAddress(address string) bool {
+	return len(address) == 54 && validateAddress(address, pkgTypes.AddressPrefixValoper)
+}
+
+func validatorAddressValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		return isValidatorAddress(fl.Field().String())
+	}
+}
+
 func statusValidator() validator.Func {
 	return func(fl validator.FieldLevel) bool {
 		_, err := types.ParseStatus(fl.Field().String())
@@ -77,3 +96,17 @@ func msgTypeValidator() validator.Func {
 		return err == nil
 	}
 }
+
+func proposalStatusValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseProposalStatus(fl.Field().String())
+		return err == nil
+	}
+}
+
+func ibcTransferStatusValidator() validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		_, err := types.ParseIbcTransferStatus(fl.Field().String())
+		return err == nil
+	}
+}
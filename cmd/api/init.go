@@ -212,16 +212,34 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 	searchHandler := handler.NewSearchHandler(db.Address, db.Blocks, db.Namespace, db.Tx)
 	v1.GET("/search", searchHandler.Search)
 
-	addressHandlers := handler.NewAddressHandler(db.Address, db.Tx, db.State, cfg.Indexer.Name)
+	addressHandlers := handler.NewAddressHandler(db.Address, db.Tx, db.State, db.BalanceUpdate, db.Delegation, db.Unbonding, db.Redelegation, db.Grant, db.FeeAllowance, cfg.Indexer.Name)
 	addressGroup := v1.Group("/address")
 	{
 		addressGroup.GET("", addressHandlers.List)
 		addressGroup.GET("/count", addressHandlers.Count)
 		addressGroup.GET("/:hash", addressHandlers.Get)
 		addressGroup.GET("/:hash/txs", addressHandlers.Transactions)
+		addressGroup.GET("/:hash/balance/history", addressHandlers.BalanceHistory)
+		addressGroup.GET("/:hash/delegations", addressHandlers.Delegations)
+		addressGroup.GET("/:hash/unbondings", addressHandlers.Unbondings)
+		addressGroup.GET("/:hash/redelegations", addressHandlers.Redelegations)
+		addressGroup.GET("/:hash/grants", addressHandlers.Grants)
+		addressGroup.GET("/:hash/fee_allowances", addressHandlers.FeeAllowances)
 	}
 
-	blockHandlers := handler.NewBlockHandler(db.Blocks, db.BlockStats, db.Event, db.Namespace, db.State, cfg.Indexer.Name)
+	validatorHandlers := handler.NewValidatorHandler(db.Validator, db.Delegation, db.Blocks, db.Signatures)
+	validatorGroup := v1.Group("/validators")
+	{
+		validatorGroup.GET("", validatorHandlers.List)
+		validatorGroup.GET("/:address", validatorHandlers.Get)
+		validatorGroup.GET("/:address/delegators", validatorHandlers.Delegators)
+		validatorGroup.GET("/:address/messages", validatorHandlers.Messages)
+		validatorGroup.GET("/:address/history", validatorHandlers.History)
+		validatorGroup.GET("/:address/uptime", validatorHandlers.Uptime)
+		validatorGroup.GET("/:address/missed_blocks", validatorHandlers.MissedBlocks)
+	}
+
+	blockHandlers := handler.NewBlockHandler(db.Blocks, db.BlockStats, db.Event, db.Evidence, db.Namespace, db.State, cfg.Indexer.Name)
 	blockGroup := v1.Group("/block")
 	{
 		blockGroup.GET("", blockHandlers.List)
@@ -236,6 +254,25 @@ func initHandlers(ctx context.Context, e *echo.Echo, cfg Config, db postgres.Sto
 		}
 	}
 
+	evidenceHandlers := handler.NewEvidenceHandler(db.Evidence)
+	v1.GET("/evidence", evidenceHandlers.List)
+
+	proposalHandlers := handler.NewProposalHandler(db.Proposal, db.Vote, db.Deposit, db.Address)
+	proposalGroup := v1.Group("/proposals")
+	{
+		proposalGroup.GET("", proposalHandlers.List)
+		proposalGroup.GET("/voter/:hash", proposalHandlers.VoterHistory)
+		proposalGroup.GET("/:id", proposalHandlers.Get)
+		proposalGroup.GET("/:id/votes", proposalHandlers.Votes)
+		proposalGroup.GET("/:id/deposits", proposalHandlers.Deposits)
+	}
+
+	ibcHandlers := handler.NewIbcHandler(db.IbcTransfer)
+	ibcGroup := v1.Group("/ibc")
+	{
+		ibcGroup.GET("/transfers", ibcHandlers.Transfers)
+	}
+
 	txHandlers := handler.NewTxHandler(db.Tx, db.Event, db.Message, db.State, cfg.Indexer.Name)
 	txGroup := v1.Group("/tx")
 	{
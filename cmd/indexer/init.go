@@ -22,20 +22,9 @@ func init() {
 	})
 }
 
-func initConfig() (*config.Config, error) {
-	configPath := rootCmd.PersistentFlags().StringP("config", "c", "dipdup.yml", "path to YAML config file")
-	if err := rootCmd.Execute(); err != nil {
-		log.Panic().Err(err).Msg("command line execute")
-		return nil, err
-	}
-
-	if err := rootCmd.MarkFlagRequired("config"); err != nil {
-		log.Panic().Err(err).Msg("config command line arg is required")
-		return nil, err
-	}
-
+func initConfig(configPath string) (*config.Config, error) {
 	var cfg config.Config
-	if err := goLibConfig.Parse(*configPath, &cfg); err != nil {
+	if err := goLibConfig.Parse(configPath, &cfg); err != nil {
 		log.Panic().Err(err).Msg("parsing config file")
 		return nil, err
 	}
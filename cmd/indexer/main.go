@@ -17,22 +17,36 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var configPath string
+
 var rootCmd = &cobra.Command{
 	Use:   "indexer",
 	Short: "DipDup Verticals | Celestia Indexer",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return run()
+	},
 }
 
 func main() {
-	cfg, err := initConfig()
+	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "c", "dipdup.yml", "path to YAML config file")
+	rootCmd.AddCommand(reindexCmd, verifyCmd)
+
+	if err := rootCmd.Execute(); err != nil {
+		log.Panic().Err(err).Msg("command line execute")
+	}
+}
+
+func run() error {
+	cfg, err := initConfig(configPath)
 	if err != nil {
-		return
+		return err
 	}
 
 	if err = initLogger(cfg.LogLevel); err != nil {
-		return
+		return err
 	}
 	if err = initProflier(cfg.Profiler); err != nil {
-		return
+		return err
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -44,7 +58,7 @@ func main() {
 	indexerModule, err := indexer.New(ctx, *cfg, &stopperModule)
 	if err != nil {
 		log.Panic().Err(err).Msg("error during indexer module creation")
-		return
+		return err
 	}
 
 	stopperModule.Start(ctx)
@@ -64,4 +78,5 @@ func main() {
 	}
 
 	log.Info().Msg("stopped")
+	return nil
 }
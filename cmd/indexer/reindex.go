@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var reindexFrom uint64
+
+var reindexCmd = &cobra.Command{
+	Use:   "reindex",
+	Short: "Reindex blocks from the height up to the last indexed block",
+	Long: `Removes all stored blocks from the last indexed block down to --from height and indexes them again.
+Rollback can revert stored state from the tail only, so every block from --from to the head is deleted,
+fetched from the node and parsed with the current decoder: data and state totals of all these blocks may change.
+Duration depends on the distance between --from and the head. API shows the truncated chain until reindexing is finished.
+Indexer must be stopped during reindexing.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := initConfig(configPath)
+		if err != nil {
+			return err
+		}
+		if err := initLogger(cfg.LogLevel); err != nil {
+			return err
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+		defer cancel()
+
+		if err := indexer.Reindex(ctx, *cfg, types.Level(reindexFrom)); err != nil {
+			log.Err(err).Msg("reindexing")
+			return err
+		}
+		return nil
+	},
+}
+
+func init() {
+	reindexCmd.Flags().Uint64Var(&reindexFrom, "from", 0, "first height to reindex")
+	if err := reindexCmd.MarkFlagRequired("from"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+var (
+	verifyFrom uint64
+	verifyTo   uint64
+)
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Compare stored data with the node",
+	Long: `Walks the height range and compares stored block hash, parent hash, transactions, events and block stats with data received from the node.
+Also recomputes state totals from the tables. Prints the report of mismatches and exits with error if any were found.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := initConfig(configPath)
+		if err != nil {
+			return err
+		}
+		if err := initLogger(cfg.LogLevel); err != nil {
+			return err
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
+		defer cancel()
+
+		report, err := indexer.Verify(ctx, *cfg, types.Level(verifyFrom), types.Level(verifyTo))
+		if err != nil {
+			log.Err(err).Msg("verification")
+			return err
+		}
+
+		for i := range report.Mismatches {
+			fmt.Fprintln(cmd.OutOrStdout(), report.Mismatches[i].String())
+		}
+		if !report.Ok() {
+			return errors.Errorf("found %d mismatches in heights %d-%d", len(report.Mismatches), report.From, report.To)
+		}
+		log.Info().Uint64("from", uint64(report.From)).Uint64("to", uint64(report.To)).Msg("no mismatches found")
+		return nil
+	},
+}
+
+func init() {
+	verifyCmd.Flags().Uint64Var(&verifyFrom, "from", 0, "first height to verify (default start level)")
+	verifyCmd.Flags().Uint64Var(&verifyTo, "to", 0, "last height to verify (default last indexed height)")
+}
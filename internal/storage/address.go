@@ -9,6 +9,7 @@ import (
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
 
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
 )
 
@@ -36,7 +37,7 @@ type Address struct {
 	Hash       []byte      `bun:"hash"                        comment:"Address hash."`
 	Address    string      `bun:"address,unique:address_idx"  comment:"Human-readable address."`
 
-	Balance Balance `bun:"rel:has-one,join:id=id"`
+	Balances []Balance `bun:"rel:has-many,join:id=id"`
 }
 
 // TableName -
@@ -47,3 +48,39 @@ func (Address) TableName() string {
 func (address Address) String() string {
 	return address.Address
 }
+
+// AddBalance - adds amount to the balance of currency. Balance is created if address has no balance of currency yet.
+func (address *Address) AddBalance(currency string, amount decimal.Decimal) {
+	for i := range address.Balances {
+		if address.Balances[i].Currency == currency {
+			address.Balances[i].Total = address.Balances[i].Total.Add(amount)
+			return
+		}
+	}
+	address.Balances = append(address.Balances, Balance{
+		Id:       address.Id,
+		Currency: currency,
+		Total:    amount,
+	})
+}
+
+// MergeBalances - adds all balances of other address to the address balances
+func (address *Address) MergeBalances(other Address) {
+	for i := range other.Balances {
+		address.AddBalance(other.Balances[i].Currency, other.Balances[i].Total)
+	}
+}
+
+// Balance - returns balance of currency. Zero balance is returned if address has no balance of currency.
+func (address Address) Balance(currency string) Balance {
+	for i := range address.Balances {
+		if address.Balances[i].Currency == currency {
+			return address.Balances[i]
+		}
+	}
+	return Balance{
+		Id:       address.Id,
+		Currency: currency,
+		Total:    decimal.Zero,
+	}
+}
@@ -20,6 +20,8 @@ type Balance struct {
 	Id       uint64          `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
 	Currency string          `bun:"currency,pk,notnull"         comment:"Balance currency"`
 	Total    decimal.Decimal `bun:"total,type:numeric"          comment:"Total account balance"`
+
+	Metadata *DenomMetadata `bun:"rel:belongs-to,join:currency=base"`
 }
 
 func (Balance) TableName() string {
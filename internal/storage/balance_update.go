@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type BalanceUpdateFilter struct {
+	Limit    int
+	Offset   int
+	Sort     storage.SortOrder
+	Currency string
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IBalanceUpdate interface {
+	storage.Table[*BalanceUpdate]
+
+	ByAddress(ctx context.Context, addressId uint64, fltrs BalanceUpdateFilter) ([]BalanceUpdate, error)
+	BalancesAt(ctx context.Context, addressId uint64, height pkgTypes.Level) ([]Balance, error)
+}
+
+// BalanceUpdate - change of address balance caused by `coin_spent` or `coin_received` event
+type BalanceUpdate struct {
+	bun.BaseModel `bun:"balance_update" comment:"Table with changes of account balances."`
+
+	Id            uint64          `bun:"id,pk,notnull,autoincrement"       comment:"Unique internal identity"`
+	Height        pkgTypes.Level  `bun:"height,notnull"                    comment:"The number (height) of block where balance was changed"`
+	Time          time.Time       `bun:"time,pk,notnull"                   comment:"The time of block"`
+	AddressId     uint64          `bun:"address_id,notnull"                comment:"Address internal identity"`
+	Currency      string          `bun:"currency,notnull"                  comment:"Balance currency"`
+	Delta         decimal.Decimal `bun:"delta,type:numeric"                comment:"Change of balance"`
+	TxId          *uint64         `bun:"tx_id"                             comment:"Transaction id. Null if balance was changed by block event."`
+	EventPosition int64           `bun:"event_position"                    comment:"Position of event in transaction or block"`
+	EventType     types.EventType `bun:"event_type,type:event_type"        comment:"Type of event caused balance change"`
+
+	Address *Address `bun:"rel:belongs-to,join:address_id=id"`
+}
+
+// TableName -
+func (BalanceUpdate) TableName() string {
+	return "balance_update"
+}
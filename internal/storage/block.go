@@ -19,10 +19,12 @@ type IBlock interface {
 	storage.Table[*Block]
 
 	Last(ctx context.Context) (Block, error)
+	First(ctx context.Context) (Block, error)
 	ByIdWithRelations(ctx context.Context, id uint64) (Block, error)
 	ByHeight(ctx context.Context, height pkgTypes.Level) (Block, error)
 	ByHeightWithStats(ctx context.Context, height pkgTypes.Level) (Block, error)
 	ByHash(ctx context.Context, hash []byte) (Block, error)
+	ProposedCount(ctx context.Context, proposerAddress []byte) (int64, error)
 	ListWithStats(ctx context.Context, limit, offset uint64, order storage.SortOrder) ([]*Block, error)
 }
 
@@ -50,8 +52,13 @@ type Block struct {
 	EvidenceHash       pkgTypes.Hex `bun:"evidence_hash"        comment:"Evidence hash"`
 	ProposerAddress    pkgTypes.Hex `bun:"proposer_address"     comment:"Proposer address"`
 
-	ChainId   string    `bun:"-"` // internal field for filling state
-	Addresses []Address `bun:"-"` // internal field for balance passing
+	ChainId          string             `bun:"-"` // internal field for filling state
+	Addresses        []Address          `bun:"-"` // internal field for balance passing
+	BalanceUpdates   []BalanceUpdate    `bun:"-"` // internal field for passing balance updates caused by block events
+	ValidatorHistory []ValidatorHistory `bun:"-"` // internal field for passing validator power and status changes
+	Signatures       []BlockSignature   `bun:"-"` // internal field for passing signatures of the previous block from the last commit
+	Evidence         []Evidence         `bun:"-"` // internal field for passing evidence of validators misbehavior
+	ProposalUpdates  []Proposal         `bun:"-"` // internal field for passing proposal status changes caused by events
 
 	Txs    []Tx       `bun:"rel:has-many"`
 	Events []Event    `bun:"rel:has-many"`
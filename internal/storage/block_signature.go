@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IBlockSignature interface {
+	storage.Table[*BlockSignature]
+
+	LevelsByValidator(ctx context.Context, validatorId uint64, startHeight pkgTypes.Level) ([]pkgTypes.Level, error)
+}
+
+// BlockSignature - validator's vote for the block received from the last commit of the next block
+type BlockSignature struct {
+	bun.BaseModel `bun:"block_signature" comment:"Table with block signatures"`
+
+	Id          uint64         `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height      pkgTypes.Level `bun:"height,notnull"              comment:"The number (height) of signed block"`
+	Time        time.Time      `bun:"time,pk,notnull"             comment:"The time of block which includes the signature"`
+	ValidatorId uint64         `bun:"validator_id,notnull"        comment:"Validator internal id"`
+
+	ConsAddress pkgTypes.Hex `bun:"-"` // internal field for matching with validator
+
+	Validator *Validator `bun:"rel:belongs-to,join:validator_id=id"`
+}
+
+// TableName -
+func (BlockSignature) TableName() string {
+	return "block_signature"
+}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IDelegation interface {
+	storage.Table[*Delegation]
+
+	ByAddress(ctx context.Context, addressId uint64, limit, offset int) ([]Delegation, error)
+	ByValidator(ctx context.Context, validator string, limit, offset int) ([]Delegation, error)
+}
+
+// Delegation - current amount of tokens delegated by address to validator
+type Delegation struct {
+	bun.BaseModel `bun:"delegation" comment:"Table with current delegations."`
+
+	AddressId uint64          `bun:"address_id,pk,notnull"  comment:"Delegator internal identity"`
+	Validator string          `bun:"validator,pk,type:text" comment:"Validator operator address"`
+	Amount    decimal.Decimal `bun:"amount,type:numeric"    comment:"Delegated amount"`
+
+	Address *Address `bun:"rel:belongs-to,join:address_id=id"`
+}
+
+// TableName -
+func (Delegation) TableName() string {
+	return "delegation"
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"time"
+
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+// DelegationLog - change of delegation caused by staking message. It's used to revert delegations on rollback.
+type DelegationLog struct {
+	bun.BaseModel `bun:"delegation_log" comment:"Table with changes of delegations."`
+
+	Id          uint64          `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height      pkgTypes.Level  `bun:"height,notnull"              comment:"The number (height) of block where delegation was changed"`
+	Time        time.Time       `bun:"time,pk,notnull"             comment:"The time of block"`
+	AddressId   uint64          `bun:"address_id,notnull"          comment:"Delegator internal identity"`
+	Validator   string          `bun:"validator,type:text"         comment:"Validator operator address"`
+	Amount      decimal.Decimal `bun:"amount,type:numeric"         comment:"Change of delegated amount"`
+	MsgId       uint64          `bun:"msg_id"                      comment:"Message id"`
+	UnbondingId *uint64         `bun:"unbonding_id"                comment:"Unbonding entry which was cancelled. Null if the change is not a cancellation of unbonding."`
+
+	CreationHeight pkgTypes.Level `bun:"-"` // creation height of cancelled unbonding entry
+	Address        *Address       `bun:"rel:belongs-to,join:address_id=id"`
+}
+
+// TableName -
+func (DelegationLog) TableName() string {
+	return "delegation_log"
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IDeposit interface {
+	storage.Table[*Deposit]
+
+	ByProposal(ctx context.Context, proposalId uint64, limit, offset int) ([]Deposit, error)
+}
+
+// Deposit - deposit to governance proposal. Initial deposit of submitted proposal is stored as deposit of proposer.
+type Deposit struct {
+	bun.BaseModel `bun:"deposit" comment:"Table with governance proposal deposits."`
+
+	Id          uint64          `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height      pkgTypes.Level  `bun:"height,notnull"              comment:"The number (height) of block where deposit was made"`
+	Time        time.Time       `bun:"time,pk,notnull"             comment:"The time of block"`
+	ProposalId  uint64          `bun:"proposal_id"                 comment:"Proposal id"`
+	DepositorId uint64          `bun:"depositor_id"                comment:"Depositor internal identity"`
+	Amount      decimal.Decimal `bun:"amount,type:numeric"         comment:"Deposited amount"`
+	TxId        uint64          `bun:"tx_id"                       comment:"Transaction id"`
+	MsgId       uint64          `bun:"msg_id"                      comment:"Message id"`
+
+	Depositor *Address `bun:"rel:belongs-to,join:depositor_id=id"`
+}
+
+// TableName -
+func (Deposit) TableName() string {
+	return "deposit"
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IEvidence interface {
+	storage.Table[*Evidence]
+
+	ByHeight(ctx context.Context, height pkgTypes.Level) ([]Evidence, error)
+	ListWithValidators(ctx context.Context, limit, offset uint64, order storage.SortOrder) ([]Evidence, error)
+}
+
+// Evidence - evidence of validator misbehavior included into the block. Light client attack evidence is stored once per byzantine validator.
+type Evidence struct {
+	bun.BaseModel `bun:"evidence" comment:"Table with evidence of validators misbehavior"`
+
+	Id               uint64             `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height           pkgTypes.Level     `bun:"height,notnull"              comment:"The number (height) of block which includes the evidence"`
+	Time             time.Time          `bun:"time,pk,notnull"             comment:"The time of block which includes the evidence"`
+	Type             types.EvidenceType `bun:"type,type:evidence_type"     comment:"Evidence type"`
+	ValidatorId      uint64             `bun:"validator_id"                comment:"Accused validator internal id. Zero if validator is unknown"`
+	ConsAddress      pkgTypes.Hex       `bun:"cons_address"                comment:"Consensus address of accused validator"`
+	InfractionHeight pkgTypes.Level     `bun:"infraction_height"           comment:"Height of infraction: height of duplicate votes or common height of light client attack"`
+	InfractionTime   time.Time          `bun:"infraction_time"             comment:"Time of infraction"`
+	ValidatorPower   int64              `bun:"validator_power"             comment:"Voting power of accused validator"`
+	TotalVotingPower int64              `bun:"total_voting_power"          comment:"Total voting power of validator set"`
+
+	Validator *Validator `bun:"rel:belongs-to,join:validator_id=id"`
+}
+
+// TableName -
+func (Evidence) TableName() string {
+	return "evidence"
+}
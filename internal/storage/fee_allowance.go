@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IFeeAllowance interface {
+	storage.Table[*FeeAllowance]
+
+	ByAddress(ctx context.Context, addressId uint64, limit, offset int) ([]FeeAllowance, error)
+}
+
+// FeeAllowance - feegrant allowance given by granter to grantee. Every row is a state of the allowance which is actual
+// from `Height` until `EndHeight`. Usage of the allowance closes the current state and creates the new one with decreased spend limit.
+// Revocation closes the current state. Zero end height means the allowance is active.
+//
+// Allowance passed by parser is revocation if end height is non-zero and usage if its type is not set.
+type FeeAllowance struct {
+	bun.BaseModel `bun:"fee_allowance" comment:"Table with feegrant allowances."`
+
+	Id               uint64                 `bun:"id,pk,notnull,autoincrement"     comment:"Unique internal identity"`
+	Height           pkgTypes.Level         `bun:"height,notnull"                  comment:"The number (height) of block where allowance state was created"`
+	Time             time.Time              `bun:"time,notnull"                    comment:"The time of block where allowance state was created"`
+	EndHeight        pkgTypes.Level         `bun:"end_height"                      comment:"The number (height) of block where allowance was used or revoked. Zero if the state is actual."`
+	GranterId        uint64                 `bun:"granter_id"                      comment:"Granter internal identity"`
+	GranteeId        uint64                 `bun:"grantee_id"                      comment:"Grantee internal identity"`
+	Type             types.FeeAllowanceType `bun:"type,type:fee_allowance_type"    comment:"Allowance type"`
+	SpendLimit       decimal.Decimal        `bun:"spend_limit,type:numeric"        comment:"Remaining spend limit. Zero if there is no limit."`
+	Spent            decimal.Decimal        `bun:"spent,type:numeric"              comment:"Total fee paid by allowance"`
+	PeriodSpendLimit decimal.Decimal        `bun:"period_spend_limit,type:numeric" comment:"Spend limit per period of periodic allowance"`
+	Period           int64                  `bun:"period"                          comment:"Period duration of periodic allowance in seconds"`
+	AllowedMessages  []string               `bun:"allowed_messages,array"          comment:"Message types which fee can be paid by allowance. Empty if any message is allowed."`
+	Expiration       time.Time              `bun:"expiration,nullzero"             comment:"Expiration time of allowance"`
+	TxId             uint64                 `bun:"tx_id"                           comment:"Transaction id"`
+	MsgId            uint64                 `bun:"msg_id"                          comment:"Message id. Zero if state was created by allowance usage."`
+
+	Granter *Address `bun:"rel:belongs-to,join:granter_id=id"`
+	Grantee *Address `bun:"rel:belongs-to,join:grantee_id=id"`
+}
+
+// TableName -
+func (FeeAllowance) TableName() string {
+	return "fee_allowance"
+}
+
+// IsRevocation - returns true if allowance is passed to storage as revocation of the active allowance
+func (fa FeeAllowance) IsRevocation() bool {
+	return fa.EndHeight > 0
+}
+
+// IsUsage - returns true if allowance is passed to storage as payment of transaction fee by the active allowance
+func (fa FeeAllowance) IsUsage() bool {
+	return fa.EndHeight == 0 && fa.Type == ""
+}
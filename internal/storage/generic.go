@@ -10,6 +10,7 @@ import (
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
 )
 
 var Models = []any{
@@ -17,9 +18,12 @@ var Models = []any{
 	&Constant{},
 	&DenomMetadata{},
 	&Balance{},
+	&BalanceUpdate{},
 	&Address{},
 	&Block{},
 	&BlockStats{},
+	&BlockSignature{},
+	&Evidence{},
 	&Tx{},
 	&Message{},
 	&Event{},
@@ -28,6 +32,18 @@ var Models = []any{
 	&Signer{},
 	&MsgAddress{},
 	&Validator{},
+	&ValidatorMessage{},
+	&ValidatorHistory{},
+	&Delegation{},
+	&DelegationLog{},
+	&Unbonding{},
+	&Redelegation{},
+	&Proposal{},
+	&Vote{},
+	&Deposit{},
+	&Grant{},
+	&FeeAllowance{},
+	&IbcTransfer{},
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
@@ -57,12 +73,35 @@ type Transaction interface {
 	SaveNamespaces(ctx context.Context, namespaces ...*Namespace) (int64, error)
 	SaveAddresses(ctx context.Context, addresses ...*Address) (int64, error)
 	SaveBalances(ctx context.Context, balances ...Balance) error
+	SaveBalanceUpdates(ctx context.Context, updates ...BalanceUpdate) error
 	SaveMessages(ctx context.Context, msgs ...*Message) error
 	SaveSigners(ctx context.Context, addresses ...Signer) error
 	SaveMsgAddresses(ctx context.Context, addresses ...MsgAddress) error
 	SaveNamespaceMessage(ctx context.Context, nsMsgs ...NamespaceMessage) error
 	SaveValidators(ctx context.Context, validators ...*Validator) error
+	SaveValidatorMessages(ctx context.Context, msgs ...ValidatorMessage) error
+	SaveValidatorHistory(ctx context.Context, history ...ValidatorHistory) error
+	UpdateValidators(ctx context.Context, validators ...*Validator) error
+	SaveBlockSignatures(ctx context.Context, signatures ...BlockSignature) error
+	SaveEvidence(ctx context.Context, evidence ...Evidence) error
 	SaveEvents(ctx context.Context, events ...Event) error
+	SaveDelegations(ctx context.Context, delegations ...Delegation) error
+	SaveDelegationLogs(ctx context.Context, logs ...DelegationLog) error
+	SaveUnbondings(ctx context.Context, unbondings ...*Unbonding) error
+	SaveRedelegations(ctx context.Context, redelegations ...*Redelegation) error
+	CancelUnbonding(ctx context.Context, addressId uint64, validator string, creationHeight types.Level, amount decimal.Decimal) (uint64, error)
+	RestoreUnbonding(ctx context.Context, id uint64, amount decimal.Decimal) error
+	SaveProposals(ctx context.Context, proposals ...*Proposal) error
+	UpdateProposals(ctx context.Context, proposals ...*Proposal) error
+	SaveVotes(ctx context.Context, votes ...Vote) error
+	SaveDeposits(ctx context.Context, deposits ...Deposit) error
+	SaveGrants(ctx context.Context, grants ...*Grant) error
+	RevokeGrant(ctx context.Context, grant Grant) error
+	SaveFeeAllowances(ctx context.Context, allowances ...*FeeAllowance) error
+	RevokeFeeAllowance(ctx context.Context, allowance FeeAllowance) error
+	UseFeeAllowance(ctx context.Context, usage FeeAllowance) error
+	SaveIbcTransfers(ctx context.Context, transfers ...*IbcTransfer) error
+	ResolveIbcTransfer(ctx context.Context, resolution IbcTransfer) error
 	LastBlock(ctx context.Context) (block Block, err error)
 	State(ctx context.Context, name string) (state State, err error)
 	Namespace(ctx context.Context, id uint64) (ns Namespace, err error)
@@ -78,8 +117,23 @@ type Transaction interface {
 	RollbackValidators(ctx context.Context, height types.Level) (err error)
 	RollbackSigners(ctx context.Context, txIds []uint64) (err error)
 	RollbackMessageAddresses(ctx context.Context, msgIds []uint64) (err error)
+	RollbackValidatorMessages(ctx context.Context, msgIds []uint64) (err error)
+	RollbackValidatorHistory(ctx context.Context, height types.Level) (history []ValidatorHistory, err error)
+	RollbackBlockSignatures(ctx context.Context, height types.Level) (err error)
+	RollbackEvidence(ctx context.Context, height types.Level) (err error)
+	RollbackBalanceUpdates(ctx context.Context, height types.Level) (updates []BalanceUpdate, err error)
+	RollbackDelegationLogs(ctx context.Context, height types.Level) (logs []DelegationLog, err error)
+	RollbackUnbondings(ctx context.Context, height types.Level) error
+	RollbackRedelegations(ctx context.Context, height types.Level) error
+	RollbackProposals(ctx context.Context, height types.Level) error
+	RollbackVotes(ctx context.Context, height types.Level) error
+	RollbackDeposits(ctx context.Context, height types.Level) (deposits []Deposit, err error)
+	RollbackGrants(ctx context.Context, height types.Level) error
+	RollbackFeeAllowances(ctx context.Context, height types.Level) error
+	RollbackIbcTransfers(ctx context.Context, height types.Level) error
 	DeleteBalances(ctx context.Context, ids []uint64) error
 	LastAddressAction(ctx context.Context, address []byte) (uint64, error)
+	ValidatorsByConsAddress(ctx context.Context, addresses ...[]byte) ([]Validator, error)
 }
 
 const (
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IGrant interface {
+	storage.Table[*Grant]
+
+	ByAddress(ctx context.Context, addressId uint64, limit, offset int) ([]Grant, error)
+}
+
+// Grant - authz authorization given by granter to grantee for the message type. Every row is a state of the grant which is actual
+// from `Height` until `EndHeight`. Overwriting or revocation of the grant closes the current state. Zero end height means the grant is active.
+//
+// Grant with non-zero end height passed by parser is revocation of the active grant.
+type Grant struct {
+	bun.BaseModel `bun:"authz_grant" comment:"Table with authz grants."`
+
+	Id            uint64                  `bun:"id,pk,notnull,autoincrement"           comment:"Unique internal identity"`
+	Height        pkgTypes.Level          `bun:"height,notnull"                        comment:"The number (height) of block where grant was given"`
+	Time          time.Time               `bun:"time,notnull"                          comment:"The time of block where grant was given"`
+	EndHeight     pkgTypes.Level          `bun:"end_height"                            comment:"The number (height) of block where grant was revoked or overwritten. Zero if grant is active."`
+	GranterId     uint64                  `bun:"granter_id"                            comment:"Granter internal identity"`
+	GranteeId     uint64                  `bun:"grantee_id"                            comment:"Grantee internal identity"`
+	Authorization types.AuthorizationType `bun:"authorization,type:authorization_type" comment:"Authorization type"`
+	MsgType       string                  `bun:"msg_type"                              comment:"Type url of authorized message"`
+	SpendLimit    decimal.Decimal         `bun:"spend_limit,type:numeric"              comment:"Spend limit of send authorization or max tokens of stake authorization at grant time. It is not decreased by executed messages. Zero if there is no limit."`
+	Expiration    time.Time               `bun:"expiration,nullzero"                   comment:"Expiration time of grant"`
+	TxId          uint64                  `bun:"tx_id"                                 comment:"Transaction id"`
+	MsgId         uint64                  `bun:"msg_id"                                comment:"Message id"`
+
+	Granter *Address `bun:"rel:belongs-to,join:granter_id=id"`
+	Grantee *Address `bun:"rel:belongs-to,join:grantee_id=id"`
+}
+
+// TableName -
+func (Grant) TableName() string {
+	return "authz_grant"
+}
+
+// IsRevocation - returns true if grant is passed to storage as revocation of the active grant
+func (g Grant) IsRevocation() bool {
+	return g.EndHeight > 0
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type IbcTransferFilter struct {
+	Limit   int
+	Offset  int
+	Sort    storage.SortOrder
+	Address string
+	Channel string
+	Status  []types.IbcTransferStatus
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IIbcTransfer interface {
+	storage.Table[*IbcTransfer]
+
+	Filter(ctx context.Context, fltrs IbcTransferFilter) ([]IbcTransfer, error)
+}
+
+// IbcTransfer - ICS-20 fungible token transfer between celestia and counterparty chain. Outgoing transfer is created by `MsgTransfer`
+// with pending status and is resolved by `MsgAcknowledgement` or `MsgTimeout` which are linked with it by source port, channel and packet sequence.
+// Incoming transfer is created by `MsgRecvPacket` and it's resolved at once by the written acknowledgement.
+//
+// Outgoing transfer with non-pending status passed by parser is resolution of the pending one.
+type IbcTransfer struct {
+	bun.BaseModel `bun:"ibc_transfer" comment:"Table with IBC fungible token transfers."`
+
+	Id                 uint64                  `bun:"id,pk,notnull,autoincrement"     comment:"Unique internal identity"`
+	Height             pkgTypes.Level          `bun:"height,notnull"                  comment:"The number (height) of block where transfer was sent or received"`
+	Time               time.Time               `bun:"time,notnull"                    comment:"The time of block where transfer was sent or received"`
+	Incoming           bool                    `bun:"incoming"                        comment:"Transfer is received from counterparty chain"`
+	Status             types.IbcTransferStatus `bun:"status,type:ibc_transfer_status" comment:"Transfer status"`
+	SourcePort         string                  `bun:"source_port"                     comment:"Port of the sending chain"`
+	SourceChannel      string                  `bun:"source_channel"                  comment:"Channel of the sending chain"`
+	DestinationPort    string                  `bun:"destination_port"                comment:"Port of the receiving chain"`
+	DestinationChannel string                  `bun:"destination_channel"             comment:"Channel of the receiving chain"`
+	Sequence           uint64                  `bun:"sequence"                        comment:"Packet sequence"`
+	Sender             string                  `bun:"sender"                          comment:"Sender address"`
+	Receiver           string                  `bun:"receiver"                        comment:"Receiver address"`
+	Amount             decimal.Decimal         `bun:"amount,type:numeric"             comment:"Transferred amount"`
+	Denom              string                  `bun:"denom"                           comment:"Denom trace of transferred tokens on celestia"`
+	Memo               string                  `bun:"memo"                            comment:"Memo"`
+	TxId               uint64                  `bun:"tx_id"                           comment:"Transaction id"`
+	MsgId              uint64                  `bun:"msg_id"                          comment:"Message id"`
+	ResolvedHeight     pkgTypes.Level          `bun:"resolved_height"                 comment:"The number (height) of block where outgoing transfer was acknowledged or timed out"`
+	ResolvedTime       time.Time               `bun:"resolved_time,nullzero"          comment:"The time of block where outgoing transfer was acknowledged or timed out"`
+	ResolvedTxId       uint64                  `bun:"resolved_tx_id"                  comment:"Transaction id of acknowledgement or timeout"`
+}
+
+// TableName -
+func (IbcTransfer) TableName() string {
+	return "ibc_transfer"
+}
+
+// IsResolution - returns true if transfer is passed to storage as acknowledgement or timeout of the pending outgoing transfer
+func (t IbcTransfer) IsResolution() bool {
+	return !t.Incoming && t.Status != types.IbcTransferStatusPending
+}
@@ -31,11 +31,22 @@ type Message struct {
 	Position int64          `bun:"position"                    comment:"Position in transaction"`
 	Type     types.MsgType  `bun:",type:msg_type"              comment:"Message type"                      stats:"filterable"`
 	TxId     uint64         `bun:"tx_id"                       comment:"Parent transaction id"`
+	ParentId *uint64        `bun:"parent_id"                   comment:"Parent MsgExec id"`
 	Data     map[string]any `bun:"data,type:jsonb"             comment:"Message data"`
 
-	Namespace []Namespace       `bun:"m2m:namespace_message,join:Message=Namespace"`
-	Validator *Validator        `bun:"rel:belongs-to"`
-	Addresses []AddressWithType `bun:"-"`
+	Namespace    []Namespace       `bun:"m2m:namespace_message,join:Message=Namespace"`
+	Validator    *Validator        `bun:"rel:belongs-to"`
+	Addresses    []AddressWithType `bun:"-"`
+	Delegations  []DelegationLog   `bun:"-"`
+	Unbonding    *Unbonding        `bun:"-"`
+	Redelegation *Redelegation     `bun:"-"`
+	Proposal     *Proposal         `bun:"-"`
+	Votes        []Vote            `bun:"-"`
+	Deposit      *Deposit          `bun:"-"`
+	Grant        *Grant            `bun:"-"`
+	FeeAllowance *FeeAllowance     `bun:"-"`
+	IbcTransfer  *IbcTransfer      `bun:"-"`
+	InternalMsgs []Message         `bun:"-"` // messages executed on behalf of granter by authz MsgExec
 }
 
 // TableName -
@@ -236,6 +236,45 @@ func (c *IBlockCursorListCall) DoAndReturn(f func(context.Context, uint64, uint6
 	return c
 }
 
+// First mocks base method.
+func (m *MockIBlock) First(ctx context.Context) (storage.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "First", ctx)
+	ret0, _ := ret[0].(storage.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// First indicates an expected call of First.
+func (mr *MockIBlockMockRecorder) First(ctx any) *IBlockFirstCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "First", reflect.TypeOf((*MockIBlock)(nil).First), ctx)
+	return &IBlockFirstCall{Call: call}
+}
+
+// IBlockFirstCall wrap *gomock.Call
+type IBlockFirstCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockFirstCall) Return(arg0 storage.Block, arg1 error) *IBlockFirstCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockFirstCall) Do(f func(context.Context) (storage.Block, error)) *IBlockFirstCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockFirstCall) DoAndReturn(f func(context.Context) (storage.Block, error)) *IBlockFirstCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetByID mocks base method.
 func (m *MockIBlock) GetByID(ctx context.Context, id uint64) (*storage.Block, error) {
 	m.ctrl.T.Helper()
@@ -469,6 +508,45 @@ func (c *IBlockListWithStatsCall) DoAndReturn(f func(context.Context, uint64, ui
 	return c
 }
 
+// ProposedCount mocks base method.
+func (m *MockIBlock) ProposedCount(ctx context.Context, proposerAddress []byte) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposedCount", ctx, proposerAddress)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposedCount indicates an expected call of ProposedCount.
+func (mr *MockIBlockMockRecorder) ProposedCount(ctx, proposerAddress any) *IBlockProposedCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposedCount", reflect.TypeOf((*MockIBlock)(nil).ProposedCount), ctx, proposerAddress)
+	return &IBlockProposedCountCall{Call: call}
+}
+
+// IBlockProposedCountCall wrap *gomock.Call
+type IBlockProposedCountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IBlockProposedCountCall) Return(arg0 int64, arg1 error) *IBlockProposedCountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IBlockProposedCountCall) Do(f func(context.Context, []byte) (int64, error)) *IBlockProposedCountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IBlockProposedCountCall) DoAndReturn(f func(context.Context, []byte) (int64, error)) *IBlockProposedCountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m_2 *MockIBlock) Save(ctx context.Context, m *storage.Block) error {
 	m_2.ctrl.T.Helper()
@@ -0,0 +1,390 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: evidence.go
+//
+// Generated by this command:
+//
+//	mockgen -source=evidence.go -destination=mock/evidence.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/dipdup-io/celestia-indexer/internal/storage"
+	types "github.com/dipdup-io/celestia-indexer/pkg/types"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIEvidence is a mock of IEvidence interface.
+type MockIEvidence struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEvidenceMockRecorder
+}
+
+// MockIEvidenceMockRecorder is the mock recorder for MockIEvidence.
+type MockIEvidenceMockRecorder struct {
+	mock *MockIEvidence
+}
+
+// NewMockIEvidence creates a new mock instance.
+func NewMockIEvidence(ctrl *gomock.Controller) *MockIEvidence {
+	mock := &MockIEvidence{ctrl: ctrl}
+	mock.recorder = &MockIEvidenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEvidence) EXPECT() *MockIEvidenceMockRecorder {
+	return m.recorder
+}
+
+// ByHeight mocks base method.
+func (m *MockIEvidence) ByHeight(ctx context.Context, height types.Level) ([]storage.Evidence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByHeight", ctx, height)
+	ret0, _ := ret[0].([]storage.Evidence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByHeight indicates an expected call of ByHeight.
+func (mr *MockIEvidenceMockRecorder) ByHeight(ctx, height any) *IEvidenceByHeightCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByHeight", reflect.TypeOf((*MockIEvidence)(nil).ByHeight), ctx, height)
+	return &IEvidenceByHeightCall{Call: call}
+}
+
+// IEvidenceByHeightCall wrap *gomock.Call
+type IEvidenceByHeightCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IEvidenceByHeightCall) Return(arg0 []storage.Evidence, arg1 error) *IEvidenceByHeightCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IEvidenceByHeightCall) Do(f func(context.Context, types.Level) ([]storage.Evidence, error)) *IEvidenceByHeightCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IEvidenceByHeightCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Evidence, error)) *IEvidenceByHeightCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIEvidence) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Evidence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Evidence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIEvidenceMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IEvidenceCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIEvidence)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IEvidenceCursorListCall{Call: call}
+}
+
+// IEvidenceCursorListCall wrap *gomock.Call
+type IEvidenceCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IEvidenceCursorListCall) Return(arg0 []*storage.Evidence, arg1 error) *IEvidenceCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IEvidenceCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Evidence, error)) *IEvidenceCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IEvidenceCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Evidence, error)) *IEvidenceCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIEvidence) GetByID(ctx context.Context, id uint64) (*storage.Evidence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Evidence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIEvidenceMockRecorder) GetByID(ctx, id any) *IEvidenceGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIEvidence)(nil).GetByID), ctx, id)
+	return &IEvidenceGetByIDCall{Call: call}
+}
+
+// IEvidenceGetByIDCall wrap *gomock.Call
+type IEvidenceGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IEvidenceGetByIDCall) Return(arg0 *storage.Evidence, arg1 error) *IEvidenceGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IEvidenceGetByIDCall) Do(f func(context.Context, uint64) (*storage.Evidence, error)) *IEvidenceGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IEvidenceGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Evidence, error)) *IEvidenceGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIEvidence) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIEvidenceMockRecorder) IsNoRows(err any) *IEvidenceIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIEvidence)(nil).IsNoRows), err)
+	return &IEvidenceIsNoRowsCall{Call: call}
+}
+
+// IEvidenceIsNoRowsCall wrap *gomock.Call
+type IEvidenceIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IEvidenceIsNoRowsCall) Return(arg0 bool) *IEvidenceIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IEvidenceIsNoRowsCall) Do(f func(error) bool) *IEvidenceIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IEvidenceIsNoRowsCall) DoAndReturn(f func(error) bool) *IEvidenceIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIEvidence) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIEvidenceMockRecorder) LastID(ctx any) *IEvidenceLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIEvidence)(nil).LastID), ctx)
+	return &IEvidenceLastIDCall{Call: call}
+}
+
+// IEvidenceLastIDCall wrap *gomock.Call
+type IEvidenceLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IEvidenceLastIDCall) Return(arg0 uint64, arg1 error) *IEvidenceLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IEvidenceLastIDCall) Do(f func(context.Context) (uint64, error)) *IEvidenceLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IEvidenceLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IEvidenceLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIEvidence) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Evidence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Evidence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIEvidenceMockRecorder) List(ctx, limit, offset, order any) *IEvidenceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIEvidence)(nil).List), ctx, limit, offset, order)
+	return &IEvidenceListCall{Call: call}
+}
+
+// IEvidenceListCall wrap *gomock.Call
+type IEvidenceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IEvidenceListCall) Return(arg0 []*storage.Evidence, arg1 error) *IEvidenceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IEvidenceListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Evidence, error)) *IEvidenceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IEvidenceListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Evidence, error)) *IEvidenceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListWithValidators mocks base method.
+func (m *MockIEvidence) ListWithValidators(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]storage.Evidence, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithValidators", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]storage.Evidence)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithValidators indicates an expected call of ListWithValidators.
+func (mr *MockIEvidenceMockRecorder) ListWithValidators(ctx, limit, offset, order any) *IEvidenceListWithValidatorsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithValidators", reflect.TypeOf((*MockIEvidence)(nil).ListWithValidators), ctx, limit, offset, order)
+	return &IEvidenceListWithValidatorsCall{Call: call}
+}
+
+// IEvidenceListWithValidatorsCall wrap *gomock.Call
+type IEvidenceListWithValidatorsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IEvidenceListWithValidatorsCall) Return(arg0 []storage.Evidence, arg1 error) *IEvidenceListWithValidatorsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IEvidenceListWithValidatorsCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]storage.Evidence, error)) *IEvidenceListWithValidatorsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IEvidenceListWithValidatorsCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]storage.Evidence, error)) *IEvidenceListWithValidatorsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIEvidence) Save(ctx context.Context, m *storage.Evidence) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIEvidenceMockRecorder) Save(ctx, m any) *IEvidenceSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIEvidence)(nil).Save), ctx, m)
+	return &IEvidenceSaveCall{Call: call}
+}
+
+// IEvidenceSaveCall wrap *gomock.Call
+type IEvidenceSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IEvidenceSaveCall) Return(arg0 error) *IEvidenceSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IEvidenceSaveCall) Do(f func(context.Context, *storage.Evidence) error) *IEvidenceSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IEvidenceSaveCall) DoAndReturn(f func(context.Context, *storage.Evidence) error) *IEvidenceSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIEvidence) Update(ctx context.Context, m *storage.Evidence) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIEvidenceMockRecorder) Update(ctx, m any) *IEvidenceUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIEvidence)(nil).Update), ctx, m)
+	return &IEvidenceUpdateCall{Call: call}
+}
+
+// IEvidenceUpdateCall wrap *gomock.Call
+type IEvidenceUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IEvidenceUpdateCall) Return(arg0 error) *IEvidenceUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IEvidenceUpdateCall) Do(f func(context.Context, *storage.Evidence) error) *IEvidenceUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IEvidenceUpdateCall) DoAndReturn(f func(context.Context, *storage.Evidence) error) *IEvidenceUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,350 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: fee_allowance.go
+//
+// Generated by this command:
+//
+//	mockgen -source=fee_allowance.go -destination=mock/fee_allowance.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/dipdup-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIFeeAllowance is a mock of IFeeAllowance interface.
+type MockIFeeAllowance struct {
+	ctrl     *gomock.Controller
+	recorder *MockIFeeAllowanceMockRecorder
+}
+
+// MockIFeeAllowanceMockRecorder is the mock recorder for MockIFeeAllowance.
+type MockIFeeAllowanceMockRecorder struct {
+	mock *MockIFeeAllowance
+}
+
+// NewMockIFeeAllowance creates a new mock instance.
+func NewMockIFeeAllowance(ctrl *gomock.Controller) *MockIFeeAllowance {
+	mock := &MockIFeeAllowance{ctrl: ctrl}
+	mock.recorder = &MockIFeeAllowanceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIFeeAllowance) EXPECT() *MockIFeeAllowanceMockRecorder {
+	return m.recorder
+}
+
+// ByAddress mocks base method.
+func (m *MockIFeeAllowance) ByAddress(ctx context.Context, addressId uint64, limit, offset int) ([]storage.FeeAllowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByAddress", ctx, addressId, limit, offset)
+	ret0, _ := ret[0].([]storage.FeeAllowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByAddress indicates an expected call of ByAddress.
+func (mr *MockIFeeAllowanceMockRecorder) ByAddress(ctx, addressId, limit, offset any) *IFeeAllowanceByAddressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByAddress", reflect.TypeOf((*MockIFeeAllowance)(nil).ByAddress), ctx, addressId, limit, offset)
+	return &IFeeAllowanceByAddressCall{Call: call}
+}
+
+// IFeeAllowanceByAddressCall wrap *gomock.Call
+type IFeeAllowanceByAddressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IFeeAllowanceByAddressCall) Return(arg0 []storage.FeeAllowance, arg1 error) *IFeeAllowanceByAddressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IFeeAllowanceByAddressCall) Do(f func(context.Context, uint64, int, int) ([]storage.FeeAllowance, error)) *IFeeAllowanceByAddressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IFeeAllowanceByAddressCall) DoAndReturn(f func(context.Context, uint64, int, int) ([]storage.FeeAllowance, error)) *IFeeAllowanceByAddressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIFeeAllowance) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.FeeAllowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.FeeAllowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIFeeAllowanceMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IFeeAllowanceCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIFeeAllowance)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IFeeAllowanceCursorListCall{Call: call}
+}
+
+// IFeeAllowanceCursorListCall wrap *gomock.Call
+type IFeeAllowanceCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IFeeAllowanceCursorListCall) Return(arg0 []*storage.FeeAllowance, arg1 error) *IFeeAllowanceCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IFeeAllowanceCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.FeeAllowance, error)) *IFeeAllowanceCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IFeeAllowanceCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.FeeAllowance, error)) *IFeeAllowanceCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIFeeAllowance) GetByID(ctx context.Context, id uint64) (*storage.FeeAllowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.FeeAllowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIFeeAllowanceMockRecorder) GetByID(ctx, id any) *IFeeAllowanceGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIFeeAllowance)(nil).GetByID), ctx, id)
+	return &IFeeAllowanceGetByIDCall{Call: call}
+}
+
+// IFeeAllowanceGetByIDCall wrap *gomock.Call
+type IFeeAllowanceGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IFeeAllowanceGetByIDCall) Return(arg0 *storage.FeeAllowance, arg1 error) *IFeeAllowanceGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IFeeAllowanceGetByIDCall) Do(f func(context.Context, uint64) (*storage.FeeAllowance, error)) *IFeeAllowanceGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IFeeAllowanceGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.FeeAllowance, error)) *IFeeAllowanceGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIFeeAllowance) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIFeeAllowanceMockRecorder) IsNoRows(err any) *IFeeAllowanceIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIFeeAllowance)(nil).IsNoRows), err)
+	return &IFeeAllowanceIsNoRowsCall{Call: call}
+}
+
+// IFeeAllowanceIsNoRowsCall wrap *gomock.Call
+type IFeeAllowanceIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IFeeAllowanceIsNoRowsCall) Return(arg0 bool) *IFeeAllowanceIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IFeeAllowanceIsNoRowsCall) Do(f func(error) bool) *IFeeAllowanceIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IFeeAllowanceIsNoRowsCall) DoAndReturn(f func(error) bool) *IFeeAllowanceIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIFeeAllowance) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIFeeAllowanceMockRecorder) LastID(ctx any) *IFeeAllowanceLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIFeeAllowance)(nil).LastID), ctx)
+	return &IFeeAllowanceLastIDCall{Call: call}
+}
+
+// IFeeAllowanceLastIDCall wrap *gomock.Call
+type IFeeAllowanceLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IFeeAllowanceLastIDCall) Return(arg0 uint64, arg1 error) *IFeeAllowanceLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IFeeAllowanceLastIDCall) Do(f func(context.Context) (uint64, error)) *IFeeAllowanceLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IFeeAllowanceLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IFeeAllowanceLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIFeeAllowance) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.FeeAllowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.FeeAllowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIFeeAllowanceMockRecorder) List(ctx, limit, offset, order any) *IFeeAllowanceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIFeeAllowance)(nil).List), ctx, limit, offset, order)
+	return &IFeeAllowanceListCall{Call: call}
+}
+
+// IFeeAllowanceListCall wrap *gomock.Call
+type IFeeAllowanceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IFeeAllowanceListCall) Return(arg0 []*storage.FeeAllowance, arg1 error) *IFeeAllowanceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IFeeAllowanceListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.FeeAllowance, error)) *IFeeAllowanceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IFeeAllowanceListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.FeeAllowance, error)) *IFeeAllowanceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIFeeAllowance) Save(ctx context.Context, m *storage.FeeAllowance) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIFeeAllowanceMockRecorder) Save(ctx, m any) *IFeeAllowanceSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIFeeAllowance)(nil).Save), ctx, m)
+	return &IFeeAllowanceSaveCall{Call: call}
+}
+
+// IFeeAllowanceSaveCall wrap *gomock.Call
+type IFeeAllowanceSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IFeeAllowanceSaveCall) Return(arg0 error) *IFeeAllowanceSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IFeeAllowanceSaveCall) Do(f func(context.Context, *storage.FeeAllowance) error) *IFeeAllowanceSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IFeeAllowanceSaveCall) DoAndReturn(f func(context.Context, *storage.FeeAllowance) error) *IFeeAllowanceSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIFeeAllowance) Update(ctx context.Context, m *storage.FeeAllowance) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIFeeAllowanceMockRecorder) Update(ctx, m any) *IFeeAllowanceUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIFeeAllowance)(nil).Update), ctx, m)
+	return &IFeeAllowanceUpdateCall{Call: call}
+}
+
+// IFeeAllowanceUpdateCall wrap *gomock.Call
+type IFeeAllowanceUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IFeeAllowanceUpdateCall) Return(arg0 error) *IFeeAllowanceUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IFeeAllowanceUpdateCall) Do(f func(context.Context, *storage.FeeAllowance) error) *IFeeAllowanceUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IFeeAllowanceUpdateCall) DoAndReturn(f func(context.Context, *storage.FeeAllowance) error) *IFeeAllowanceUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -16,6 +16,7 @@ import (
 	types "github.com/dipdup-io/celestia-indexer/pkg/types"
 	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
 	pq "github.com/lib/pq"
+	decimal "github.com/shopspring/decimal"
 	bun "github.com/uptrace/bun"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -383,6 +384,45 @@ func (c *TransactionBulkSaveCall) DoAndReturn(f func(context.Context, []any) err
 	return c
 }
 
+// CancelUnbonding mocks base method.
+func (m *MockTransaction) CancelUnbonding(ctx context.Context, addressId uint64, validator string, creationHeight types.Level, amount decimal.Decimal) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUnbonding", ctx, addressId, validator, creationHeight, amount)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelUnbonding indicates an expected call of CancelUnbonding.
+func (mr *MockTransactionMockRecorder) CancelUnbonding(ctx, addressId, validator, creationHeight, amount any) *TransactionCancelUnbondingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUnbonding", reflect.TypeOf((*MockTransaction)(nil).CancelUnbonding), ctx, addressId, validator, creationHeight, amount)
+	return &TransactionCancelUnbondingCall{Call: call}
+}
+
+// TransactionCancelUnbondingCall wrap *gomock.Call
+type TransactionCancelUnbondingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionCancelUnbondingCall) Return(arg0 uint64, arg1 error) *TransactionCancelUnbondingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionCancelUnbondingCall) Do(f func(context.Context, uint64, string, types.Level, decimal.Decimal) (uint64, error)) *TransactionCancelUnbondingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionCancelUnbondingCall) DoAndReturn(f func(context.Context, uint64, string, types.Level, decimal.Decimal) (uint64, error)) *TransactionCancelUnbondingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Close mocks base method.
 func (m *MockTransaction) Close(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -734,410 +774,945 @@ func (c *TransactionNamespaceCall) DoAndReturn(f func(context.Context, uint64) (
 	return c
 }
 
-// Rollback mocks base method.
-func (m *MockTransaction) Rollback(ctx context.Context) error {
+// ResolveIbcTransfer mocks base method.
+func (m *MockTransaction) ResolveIbcTransfer(ctx context.Context, resolution storage.IbcTransfer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Rollback", ctx)
+	ret := m.ctrl.Call(m, "ResolveIbcTransfer", ctx, resolution)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Rollback indicates an expected call of Rollback.
-func (mr *MockTransactionMockRecorder) Rollback(ctx any) *TransactionRollbackCall {
+// ResolveIbcTransfer indicates an expected call of ResolveIbcTransfer.
+func (mr *MockTransactionMockRecorder) ResolveIbcTransfer(ctx, resolution any) *TransactionResolveIbcTransferCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockTransaction)(nil).Rollback), ctx)
-	return &TransactionRollbackCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveIbcTransfer", reflect.TypeOf((*MockTransaction)(nil).ResolveIbcTransfer), ctx, resolution)
+	return &TransactionResolveIbcTransferCall{Call: call}
 }
 
-// TransactionRollbackCall wrap *gomock.Call
-type TransactionRollbackCall struct {
+// TransactionResolveIbcTransferCall wrap *gomock.Call
+type TransactionResolveIbcTransferCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackCall) Return(arg0 error) *TransactionRollbackCall {
+func (c *TransactionResolveIbcTransferCall) Return(arg0 error) *TransactionResolveIbcTransferCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackCall) Do(f func(context.Context) error) *TransactionRollbackCall {
+func (c *TransactionResolveIbcTransferCall) Do(f func(context.Context, storage.IbcTransfer) error) *TransactionResolveIbcTransferCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackCall) DoAndReturn(f func(context.Context) error) *TransactionRollbackCall {
+func (c *TransactionResolveIbcTransferCall) DoAndReturn(f func(context.Context, storage.IbcTransfer) error) *TransactionResolveIbcTransferCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackAddresses mocks base method.
-func (m *MockTransaction) RollbackAddresses(ctx context.Context, height types.Level) ([]storage.Address, error) {
+// RestoreUnbonding mocks base method.
+func (m *MockTransaction) RestoreUnbonding(ctx context.Context, id uint64, amount decimal.Decimal) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackAddresses", ctx, height)
-	ret0, _ := ret[0].([]storage.Address)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RestoreUnbonding", ctx, id, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackAddresses indicates an expected call of RollbackAddresses.
-func (mr *MockTransactionMockRecorder) RollbackAddresses(ctx, height any) *TransactionRollbackAddressesCall {
+// RestoreUnbonding indicates an expected call of RestoreUnbonding.
+func (mr *MockTransactionMockRecorder) RestoreUnbonding(ctx, id, amount any) *TransactionRestoreUnbondingCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackAddresses", reflect.TypeOf((*MockTransaction)(nil).RollbackAddresses), ctx, height)
-	return &TransactionRollbackAddressesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUnbonding", reflect.TypeOf((*MockTransaction)(nil).RestoreUnbonding), ctx, id, amount)
+	return &TransactionRestoreUnbondingCall{Call: call}
 }
 
-// TransactionRollbackAddressesCall wrap *gomock.Call
-type TransactionRollbackAddressesCall struct {
+// TransactionRestoreUnbondingCall wrap *gomock.Call
+type TransactionRestoreUnbondingCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackAddressesCall) Return(address []storage.Address, err error) *TransactionRollbackAddressesCall {
-	c.Call = c.Call.Return(address, err)
+func (c *TransactionRestoreUnbondingCall) Return(arg0 error) *TransactionRestoreUnbondingCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackAddressesCall) Do(f func(context.Context, types.Level) ([]storage.Address, error)) *TransactionRollbackAddressesCall {
+func (c *TransactionRestoreUnbondingCall) Do(f func(context.Context, uint64, decimal.Decimal) error) *TransactionRestoreUnbondingCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackAddressesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Address, error)) *TransactionRollbackAddressesCall {
+func (c *TransactionRestoreUnbondingCall) DoAndReturn(f func(context.Context, uint64, decimal.Decimal) error) *TransactionRestoreUnbondingCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackBlock mocks base method.
-func (m *MockTransaction) RollbackBlock(ctx context.Context, height types.Level) error {
+// RevokeFeeAllowance mocks base method.
+func (m *MockTransaction) RevokeFeeAllowance(ctx context.Context, allowance storage.FeeAllowance) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackBlock", ctx, height)
+	ret := m.ctrl.Call(m, "RevokeFeeAllowance", ctx, allowance)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackBlock indicates an expected call of RollbackBlock.
-func (mr *MockTransactionMockRecorder) RollbackBlock(ctx, height any) *TransactionRollbackBlockCall {
+// RevokeFeeAllowance indicates an expected call of RevokeFeeAllowance.
+func (mr *MockTransactionMockRecorder) RevokeFeeAllowance(ctx, allowance any) *TransactionRevokeFeeAllowanceCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlock", reflect.TypeOf((*MockTransaction)(nil).RollbackBlock), ctx, height)
-	return &TransactionRollbackBlockCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFeeAllowance", reflect.TypeOf((*MockTransaction)(nil).RevokeFeeAllowance), ctx, allowance)
+	return &TransactionRevokeFeeAllowanceCall{Call: call}
 }
 
-// TransactionRollbackBlockCall wrap *gomock.Call
-type TransactionRollbackBlockCall struct {
+// TransactionRevokeFeeAllowanceCall wrap *gomock.Call
+type TransactionRevokeFeeAllowanceCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackBlockCall) Return(arg0 error) *TransactionRollbackBlockCall {
+func (c *TransactionRevokeFeeAllowanceCall) Return(arg0 error) *TransactionRevokeFeeAllowanceCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackBlockCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackBlockCall {
+func (c *TransactionRevokeFeeAllowanceCall) Do(f func(context.Context, storage.FeeAllowance) error) *TransactionRevokeFeeAllowanceCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackBlockCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackBlockCall {
+func (c *TransactionRevokeFeeAllowanceCall) DoAndReturn(f func(context.Context, storage.FeeAllowance) error) *TransactionRevokeFeeAllowanceCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackBlockStats mocks base method.
-func (m *MockTransaction) RollbackBlockStats(ctx context.Context, height types.Level) (storage.BlockStats, error) {
+// RevokeGrant mocks base method.
+func (m *MockTransaction) RevokeGrant(ctx context.Context, grant storage.Grant) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackBlockStats", ctx, height)
-	ret0, _ := ret[0].(storage.BlockStats)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RevokeGrant", ctx, grant)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackBlockStats indicates an expected call of RollbackBlockStats.
-func (mr *MockTransactionMockRecorder) RollbackBlockStats(ctx, height any) *TransactionRollbackBlockStatsCall {
+// RevokeGrant indicates an expected call of RevokeGrant.
+func (mr *MockTransactionMockRecorder) RevokeGrant(ctx, grant any) *TransactionRevokeGrantCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlockStats", reflect.TypeOf((*MockTransaction)(nil).RollbackBlockStats), ctx, height)
-	return &TransactionRollbackBlockStatsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeGrant", reflect.TypeOf((*MockTransaction)(nil).RevokeGrant), ctx, grant)
+	return &TransactionRevokeGrantCall{Call: call}
 }
 
-// TransactionRollbackBlockStatsCall wrap *gomock.Call
-type TransactionRollbackBlockStatsCall struct {
+// TransactionRevokeGrantCall wrap *gomock.Call
+type TransactionRevokeGrantCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackBlockStatsCall) Return(stats storage.BlockStats, err error) *TransactionRollbackBlockStatsCall {
-	c.Call = c.Call.Return(stats, err)
+func (c *TransactionRevokeGrantCall) Return(arg0 error) *TransactionRevokeGrantCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackBlockStatsCall) Do(f func(context.Context, types.Level) (storage.BlockStats, error)) *TransactionRollbackBlockStatsCall {
+func (c *TransactionRevokeGrantCall) Do(f func(context.Context, storage.Grant) error) *TransactionRevokeGrantCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackBlockStatsCall) DoAndReturn(f func(context.Context, types.Level) (storage.BlockStats, error)) *TransactionRollbackBlockStatsCall {
+func (c *TransactionRevokeGrantCall) DoAndReturn(f func(context.Context, storage.Grant) error) *TransactionRevokeGrantCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackEvents mocks base method.
-func (m *MockTransaction) RollbackEvents(ctx context.Context, height types.Level) ([]storage.Event, error) {
+// Rollback mocks base method.
+func (m *MockTransaction) Rollback(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackEvents", ctx, height)
-	ret0, _ := ret[0].([]storage.Event)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Rollback", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackEvents indicates an expected call of RollbackEvents.
-func (mr *MockTransactionMockRecorder) RollbackEvents(ctx, height any) *TransactionRollbackEventsCall {
+// Rollback indicates an expected call of Rollback.
+func (mr *MockTransactionMockRecorder) Rollback(ctx any) *TransactionRollbackCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackEvents", reflect.TypeOf((*MockTransaction)(nil).RollbackEvents), ctx, height)
-	return &TransactionRollbackEventsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockTransaction)(nil).Rollback), ctx)
+	return &TransactionRollbackCall{Call: call}
 }
 
-// TransactionRollbackEventsCall wrap *gomock.Call
-type TransactionRollbackEventsCall struct {
+// TransactionRollbackCall wrap *gomock.Call
+type TransactionRollbackCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackEventsCall) Return(events []storage.Event, err error) *TransactionRollbackEventsCall {
-	c.Call = c.Call.Return(events, err)
+func (c *TransactionRollbackCall) Return(arg0 error) *TransactionRollbackCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackEventsCall) Do(f func(context.Context, types.Level) ([]storage.Event, error)) *TransactionRollbackEventsCall {
+func (c *TransactionRollbackCall) Do(f func(context.Context) error) *TransactionRollbackCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackEventsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Event, error)) *TransactionRollbackEventsCall {
+func (c *TransactionRollbackCall) DoAndReturn(f func(context.Context) error) *TransactionRollbackCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackMessageAddresses mocks base method.
-func (m *MockTransaction) RollbackMessageAddresses(ctx context.Context, msgIds []uint64) error {
+// RollbackAddresses mocks base method.
+func (m *MockTransaction) RollbackAddresses(ctx context.Context, height types.Level) ([]storage.Address, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackMessageAddresses", ctx, msgIds)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RollbackAddresses", ctx, height)
+	ret0, _ := ret[0].([]storage.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RollbackMessageAddresses indicates an expected call of RollbackMessageAddresses.
-func (mr *MockTransactionMockRecorder) RollbackMessageAddresses(ctx, msgIds any) *TransactionRollbackMessageAddressesCall {
+// RollbackAddresses indicates an expected call of RollbackAddresses.
+func (mr *MockTransactionMockRecorder) RollbackAddresses(ctx, height any) *TransactionRollbackAddressesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackMessageAddresses", reflect.TypeOf((*MockTransaction)(nil).RollbackMessageAddresses), ctx, msgIds)
-	return &TransactionRollbackMessageAddressesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackAddresses", reflect.TypeOf((*MockTransaction)(nil).RollbackAddresses), ctx, height)
+	return &TransactionRollbackAddressesCall{Call: call}
 }
 
-// TransactionRollbackMessageAddressesCall wrap *gomock.Call
-type TransactionRollbackMessageAddressesCall struct {
+// TransactionRollbackAddressesCall wrap *gomock.Call
+type TransactionRollbackAddressesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackMessageAddressesCall) Return(err error) *TransactionRollbackMessageAddressesCall {
-	c.Call = c.Call.Return(err)
+func (c *TransactionRollbackAddressesCall) Return(address []storage.Address, err error) *TransactionRollbackAddressesCall {
+	c.Call = c.Call.Return(address, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackMessageAddressesCall) Do(f func(context.Context, []uint64) error) *TransactionRollbackMessageAddressesCall {
+func (c *TransactionRollbackAddressesCall) Do(f func(context.Context, types.Level) ([]storage.Address, error)) *TransactionRollbackAddressesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackMessageAddressesCall) DoAndReturn(f func(context.Context, []uint64) error) *TransactionRollbackMessageAddressesCall {
+func (c *TransactionRollbackAddressesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Address, error)) *TransactionRollbackAddressesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackMessages mocks base method.
-func (m *MockTransaction) RollbackMessages(ctx context.Context, height types.Level) ([]storage.Message, error) {
+// RollbackBalanceUpdates mocks base method.
+func (m *MockTransaction) RollbackBalanceUpdates(ctx context.Context, height types.Level) ([]storage.BalanceUpdate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackMessages", ctx, height)
-	ret0, _ := ret[0].([]storage.Message)
+	ret := m.ctrl.Call(m, "RollbackBalanceUpdates", ctx, height)
+	ret0, _ := ret[0].([]storage.BalanceUpdate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RollbackMessages indicates an expected call of RollbackMessages.
-func (mr *MockTransactionMockRecorder) RollbackMessages(ctx, height any) *TransactionRollbackMessagesCall {
+// RollbackBalanceUpdates indicates an expected call of RollbackBalanceUpdates.
+func (mr *MockTransactionMockRecorder) RollbackBalanceUpdates(ctx, height any) *TransactionRollbackBalanceUpdatesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackMessages", reflect.TypeOf((*MockTransaction)(nil).RollbackMessages), ctx, height)
-	return &TransactionRollbackMessagesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBalanceUpdates", reflect.TypeOf((*MockTransaction)(nil).RollbackBalanceUpdates), ctx, height)
+	return &TransactionRollbackBalanceUpdatesCall{Call: call}
 }
 
-// TransactionRollbackMessagesCall wrap *gomock.Call
-type TransactionRollbackMessagesCall struct {
+// TransactionRollbackBalanceUpdatesCall wrap *gomock.Call
+type TransactionRollbackBalanceUpdatesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackMessagesCall) Return(msgs []storage.Message, err error) *TransactionRollbackMessagesCall {
-	c.Call = c.Call.Return(msgs, err)
+func (c *TransactionRollbackBalanceUpdatesCall) Return(updates []storage.BalanceUpdate, err error) *TransactionRollbackBalanceUpdatesCall {
+	c.Call = c.Call.Return(updates, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackMessagesCall) Do(f func(context.Context, types.Level) ([]storage.Message, error)) *TransactionRollbackMessagesCall {
+func (c *TransactionRollbackBalanceUpdatesCall) Do(f func(context.Context, types.Level) ([]storage.BalanceUpdate, error)) *TransactionRollbackBalanceUpdatesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackMessagesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Message, error)) *TransactionRollbackMessagesCall {
+func (c *TransactionRollbackBalanceUpdatesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.BalanceUpdate, error)) *TransactionRollbackBalanceUpdatesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackNamespaceMessages mocks base method.
-func (m *MockTransaction) RollbackNamespaceMessages(ctx context.Context, height types.Level) ([]storage.NamespaceMessage, error) {
+// RollbackBlock mocks base method.
+func (m *MockTransaction) RollbackBlock(ctx context.Context, height types.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackNamespaceMessages", ctx, height)
-	ret0, _ := ret[0].([]storage.NamespaceMessage)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RollbackBlock", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackNamespaceMessages indicates an expected call of RollbackNamespaceMessages.
-func (mr *MockTransactionMockRecorder) RollbackNamespaceMessages(ctx, height any) *TransactionRollbackNamespaceMessagesCall {
+// RollbackBlock indicates an expected call of RollbackBlock.
+func (mr *MockTransactionMockRecorder) RollbackBlock(ctx, height any) *TransactionRollbackBlockCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackNamespaceMessages", reflect.TypeOf((*MockTransaction)(nil).RollbackNamespaceMessages), ctx, height)
-	return &TransactionRollbackNamespaceMessagesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlock", reflect.TypeOf((*MockTransaction)(nil).RollbackBlock), ctx, height)
+	return &TransactionRollbackBlockCall{Call: call}
 }
 
-// TransactionRollbackNamespaceMessagesCall wrap *gomock.Call
-type TransactionRollbackNamespaceMessagesCall struct {
+// TransactionRollbackBlockCall wrap *gomock.Call
+type TransactionRollbackBlockCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackNamespaceMessagesCall) Return(msgs []storage.NamespaceMessage, err error) *TransactionRollbackNamespaceMessagesCall {
-	c.Call = c.Call.Return(msgs, err)
+func (c *TransactionRollbackBlockCall) Return(arg0 error) *TransactionRollbackBlockCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackNamespaceMessagesCall) Do(f func(context.Context, types.Level) ([]storage.NamespaceMessage, error)) *TransactionRollbackNamespaceMessagesCall {
+func (c *TransactionRollbackBlockCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackBlockCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackNamespaceMessagesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.NamespaceMessage, error)) *TransactionRollbackNamespaceMessagesCall {
+func (c *TransactionRollbackBlockCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackBlockCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackNamespaces mocks base method.
-func (m *MockTransaction) RollbackNamespaces(ctx context.Context, height types.Level) ([]storage.Namespace, error) {
+// RollbackBlockSignatures mocks base method.
+func (m *MockTransaction) RollbackBlockSignatures(ctx context.Context, height types.Level) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackNamespaces", ctx, height)
-	ret0, _ := ret[0].([]storage.Namespace)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RollbackBlockSignatures", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RollbackNamespaces indicates an expected call of RollbackNamespaces.
-func (mr *MockTransactionMockRecorder) RollbackNamespaces(ctx, height any) *TransactionRollbackNamespacesCall {
+// RollbackBlockSignatures indicates an expected call of RollbackBlockSignatures.
+func (mr *MockTransactionMockRecorder) RollbackBlockSignatures(ctx, height any) *TransactionRollbackBlockSignaturesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackNamespaces", reflect.TypeOf((*MockTransaction)(nil).RollbackNamespaces), ctx, height)
-	return &TransactionRollbackNamespacesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlockSignatures", reflect.TypeOf((*MockTransaction)(nil).RollbackBlockSignatures), ctx, height)
+	return &TransactionRollbackBlockSignaturesCall{Call: call}
 }
 
-// TransactionRollbackNamespacesCall wrap *gomock.Call
-type TransactionRollbackNamespacesCall struct {
+// TransactionRollbackBlockSignaturesCall wrap *gomock.Call
+type TransactionRollbackBlockSignaturesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackNamespacesCall) Return(ns []storage.Namespace, err error) *TransactionRollbackNamespacesCall {
-	c.Call = c.Call.Return(ns, err)
+func (c *TransactionRollbackBlockSignaturesCall) Return(err error) *TransactionRollbackBlockSignaturesCall {
+	c.Call = c.Call.Return(err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackNamespacesCall) Do(f func(context.Context, types.Level) ([]storage.Namespace, error)) *TransactionRollbackNamespacesCall {
+func (c *TransactionRollbackBlockSignaturesCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackBlockSignaturesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackNamespacesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Namespace, error)) *TransactionRollbackNamespacesCall {
+func (c *TransactionRollbackBlockSignaturesCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackBlockSignaturesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackSigners mocks base method.
-func (m *MockTransaction) RollbackSigners(ctx context.Context, txIds []uint64) error {
+// RollbackBlockStats mocks base method.
+func (m *MockTransaction) RollbackBlockStats(ctx context.Context, height types.Level) (storage.BlockStats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackSigners", ctx, txIds)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RollbackBlockStats", ctx, height)
+	ret0, _ := ret[0].(storage.BlockStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RollbackSigners indicates an expected call of RollbackSigners.
-func (mr *MockTransactionMockRecorder) RollbackSigners(ctx, txIds any) *TransactionRollbackSignersCall {
+// RollbackBlockStats indicates an expected call of RollbackBlockStats.
+func (mr *MockTransactionMockRecorder) RollbackBlockStats(ctx, height any) *TransactionRollbackBlockStatsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSigners", reflect.TypeOf((*MockTransaction)(nil).RollbackSigners), ctx, txIds)
-	return &TransactionRollbackSignersCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackBlockStats", reflect.TypeOf((*MockTransaction)(nil).RollbackBlockStats), ctx, height)
+	return &TransactionRollbackBlockStatsCall{Call: call}
 }
 
-// TransactionRollbackSignersCall wrap *gomock.Call
-type TransactionRollbackSignersCall struct {
+// TransactionRollbackBlockStatsCall wrap *gomock.Call
+type TransactionRollbackBlockStatsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackSignersCall) Return(err error) *TransactionRollbackSignersCall {
-	c.Call = c.Call.Return(err)
+func (c *TransactionRollbackBlockStatsCall) Return(stats storage.BlockStats, err error) *TransactionRollbackBlockStatsCall {
+	c.Call = c.Call.Return(stats, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackSignersCall) Do(f func(context.Context, []uint64) error) *TransactionRollbackSignersCall {
+func (c *TransactionRollbackBlockStatsCall) Do(f func(context.Context, types.Level) (storage.BlockStats, error)) *TransactionRollbackBlockStatsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackSignersCall) DoAndReturn(f func(context.Context, []uint64) error) *TransactionRollbackSignersCall {
+func (c *TransactionRollbackBlockStatsCall) DoAndReturn(f func(context.Context, types.Level) (storage.BlockStats, error)) *TransactionRollbackBlockStatsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackTxs mocks base method.
-func (m *MockTransaction) RollbackTxs(ctx context.Context, height types.Level) ([]storage.Tx, error) {
+// RollbackDelegationLogs mocks base method.
+func (m *MockTransaction) RollbackDelegationLogs(ctx context.Context, height types.Level) ([]storage.DelegationLog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackTxs", ctx, height)
-	ret0, _ := ret[0].([]storage.Tx)
+	ret := m.ctrl.Call(m, "RollbackDelegationLogs", ctx, height)
+	ret0, _ := ret[0].([]storage.DelegationLog)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RollbackTxs indicates an expected call of RollbackTxs.
-func (mr *MockTransactionMockRecorder) RollbackTxs(ctx, height any) *TransactionRollbackTxsCall {
+// RollbackDelegationLogs indicates an expected call of RollbackDelegationLogs.
+func (mr *MockTransactionMockRecorder) RollbackDelegationLogs(ctx, height any) *TransactionRollbackDelegationLogsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTxs", reflect.TypeOf((*MockTransaction)(nil).RollbackTxs), ctx, height)
-	return &TransactionRollbackTxsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDelegationLogs", reflect.TypeOf((*MockTransaction)(nil).RollbackDelegationLogs), ctx, height)
+	return &TransactionRollbackDelegationLogsCall{Call: call}
 }
 
-// TransactionRollbackTxsCall wrap *gomock.Call
-type TransactionRollbackTxsCall struct {
+// TransactionRollbackDelegationLogsCall wrap *gomock.Call
+type TransactionRollbackDelegationLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackDelegationLogsCall) Return(logs []storage.DelegationLog, err error) *TransactionRollbackDelegationLogsCall {
+	c.Call = c.Call.Return(logs, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackDelegationLogsCall) Do(f func(context.Context, types.Level) ([]storage.DelegationLog, error)) *TransactionRollbackDelegationLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackDelegationLogsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.DelegationLog, error)) *TransactionRollbackDelegationLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackDeposits mocks base method.
+func (m *MockTransaction) RollbackDeposits(ctx context.Context, height types.Level) ([]storage.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackDeposits", ctx, height)
+	ret0, _ := ret[0].([]storage.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackDeposits indicates an expected call of RollbackDeposits.
+func (mr *MockTransactionMockRecorder) RollbackDeposits(ctx, height any) *TransactionRollbackDepositsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackDeposits", reflect.TypeOf((*MockTransaction)(nil).RollbackDeposits), ctx, height)
+	return &TransactionRollbackDepositsCall{Call: call}
+}
+
+// TransactionRollbackDepositsCall wrap *gomock.Call
+type TransactionRollbackDepositsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackDepositsCall) Return(deposits []storage.Deposit, err error) *TransactionRollbackDepositsCall {
+	c.Call = c.Call.Return(deposits, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackDepositsCall) Do(f func(context.Context, types.Level) ([]storage.Deposit, error)) *TransactionRollbackDepositsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackDepositsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Deposit, error)) *TransactionRollbackDepositsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackEvents mocks base method.
+func (m *MockTransaction) RollbackEvents(ctx context.Context, height types.Level) ([]storage.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackEvents", ctx, height)
+	ret0, _ := ret[0].([]storage.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackEvents indicates an expected call of RollbackEvents.
+func (mr *MockTransactionMockRecorder) RollbackEvents(ctx, height any) *TransactionRollbackEventsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackEvents", reflect.TypeOf((*MockTransaction)(nil).RollbackEvents), ctx, height)
+	return &TransactionRollbackEventsCall{Call: call}
+}
+
+// TransactionRollbackEventsCall wrap *gomock.Call
+type TransactionRollbackEventsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackEventsCall) Return(events []storage.Event, err error) *TransactionRollbackEventsCall {
+	c.Call = c.Call.Return(events, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackEventsCall) Do(f func(context.Context, types.Level) ([]storage.Event, error)) *TransactionRollbackEventsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackEventsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Event, error)) *TransactionRollbackEventsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackEvidence mocks base method.
+func (m *MockTransaction) RollbackEvidence(ctx context.Context, height types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackEvidence", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackEvidence indicates an expected call of RollbackEvidence.
+func (mr *MockTransactionMockRecorder) RollbackEvidence(ctx, height any) *TransactionRollbackEvidenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackEvidence", reflect.TypeOf((*MockTransaction)(nil).RollbackEvidence), ctx, height)
+	return &TransactionRollbackEvidenceCall{Call: call}
+}
+
+// TransactionRollbackEvidenceCall wrap *gomock.Call
+type TransactionRollbackEvidenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackEvidenceCall) Return(err error) *TransactionRollbackEvidenceCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackEvidenceCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackEvidenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackEvidenceCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackEvidenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackFeeAllowances mocks base method.
+func (m *MockTransaction) RollbackFeeAllowances(ctx context.Context, height types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackFeeAllowances", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackFeeAllowances indicates an expected call of RollbackFeeAllowances.
+func (mr *MockTransactionMockRecorder) RollbackFeeAllowances(ctx, height any) *TransactionRollbackFeeAllowancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackFeeAllowances", reflect.TypeOf((*MockTransaction)(nil).RollbackFeeAllowances), ctx, height)
+	return &TransactionRollbackFeeAllowancesCall{Call: call}
+}
+
+// TransactionRollbackFeeAllowancesCall wrap *gomock.Call
+type TransactionRollbackFeeAllowancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackFeeAllowancesCall) Return(arg0 error) *TransactionRollbackFeeAllowancesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackFeeAllowancesCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackFeeAllowancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackFeeAllowancesCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackFeeAllowancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackGrants mocks base method.
+func (m *MockTransaction) RollbackGrants(ctx context.Context, height types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackGrants", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackGrants indicates an expected call of RollbackGrants.
+func (mr *MockTransactionMockRecorder) RollbackGrants(ctx, height any) *TransactionRollbackGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackGrants", reflect.TypeOf((*MockTransaction)(nil).RollbackGrants), ctx, height)
+	return &TransactionRollbackGrantsCall{Call: call}
+}
+
+// TransactionRollbackGrantsCall wrap *gomock.Call
+type TransactionRollbackGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackGrantsCall) Return(arg0 error) *TransactionRollbackGrantsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackGrantsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackGrantsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackIbcTransfers mocks base method.
+func (m *MockTransaction) RollbackIbcTransfers(ctx context.Context, height types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackIbcTransfers", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackIbcTransfers indicates an expected call of RollbackIbcTransfers.
+func (mr *MockTransactionMockRecorder) RollbackIbcTransfers(ctx, height any) *TransactionRollbackIbcTransfersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackIbcTransfers", reflect.TypeOf((*MockTransaction)(nil).RollbackIbcTransfers), ctx, height)
+	return &TransactionRollbackIbcTransfersCall{Call: call}
+}
+
+// TransactionRollbackIbcTransfersCall wrap *gomock.Call
+type TransactionRollbackIbcTransfersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackIbcTransfersCall) Return(arg0 error) *TransactionRollbackIbcTransfersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackIbcTransfersCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackIbcTransfersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackIbcTransfersCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackIbcTransfersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackMessageAddresses mocks base method.
+func (m *MockTransaction) RollbackMessageAddresses(ctx context.Context, msgIds []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackMessageAddresses", ctx, msgIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackMessageAddresses indicates an expected call of RollbackMessageAddresses.
+func (mr *MockTransactionMockRecorder) RollbackMessageAddresses(ctx, msgIds any) *TransactionRollbackMessageAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackMessageAddresses", reflect.TypeOf((*MockTransaction)(nil).RollbackMessageAddresses), ctx, msgIds)
+	return &TransactionRollbackMessageAddressesCall{Call: call}
+}
+
+// TransactionRollbackMessageAddressesCall wrap *gomock.Call
+type TransactionRollbackMessageAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackMessageAddressesCall) Return(err error) *TransactionRollbackMessageAddressesCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackMessageAddressesCall) Do(f func(context.Context, []uint64) error) *TransactionRollbackMessageAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackMessageAddressesCall) DoAndReturn(f func(context.Context, []uint64) error) *TransactionRollbackMessageAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackMessages mocks base method.
+func (m *MockTransaction) RollbackMessages(ctx context.Context, height types.Level) ([]storage.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackMessages", ctx, height)
+	ret0, _ := ret[0].([]storage.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackMessages indicates an expected call of RollbackMessages.
+func (mr *MockTransactionMockRecorder) RollbackMessages(ctx, height any) *TransactionRollbackMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackMessages", reflect.TypeOf((*MockTransaction)(nil).RollbackMessages), ctx, height)
+	return &TransactionRollbackMessagesCall{Call: call}
+}
+
+// TransactionRollbackMessagesCall wrap *gomock.Call
+type TransactionRollbackMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackMessagesCall) Return(msgs []storage.Message, err error) *TransactionRollbackMessagesCall {
+	c.Call = c.Call.Return(msgs, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackMessagesCall) Do(f func(context.Context, types.Level) ([]storage.Message, error)) *TransactionRollbackMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackMessagesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Message, error)) *TransactionRollbackMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackNamespaceMessages mocks base method.
+func (m *MockTransaction) RollbackNamespaceMessages(ctx context.Context, height types.Level) ([]storage.NamespaceMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackNamespaceMessages", ctx, height)
+	ret0, _ := ret[0].([]storage.NamespaceMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackNamespaceMessages indicates an expected call of RollbackNamespaceMessages.
+func (mr *MockTransactionMockRecorder) RollbackNamespaceMessages(ctx, height any) *TransactionRollbackNamespaceMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackNamespaceMessages", reflect.TypeOf((*MockTransaction)(nil).RollbackNamespaceMessages), ctx, height)
+	return &TransactionRollbackNamespaceMessagesCall{Call: call}
+}
+
+// TransactionRollbackNamespaceMessagesCall wrap *gomock.Call
+type TransactionRollbackNamespaceMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackNamespaceMessagesCall) Return(msgs []storage.NamespaceMessage, err error) *TransactionRollbackNamespaceMessagesCall {
+	c.Call = c.Call.Return(msgs, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackNamespaceMessagesCall) Do(f func(context.Context, types.Level) ([]storage.NamespaceMessage, error)) *TransactionRollbackNamespaceMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackNamespaceMessagesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.NamespaceMessage, error)) *TransactionRollbackNamespaceMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackNamespaces mocks base method.
+func (m *MockTransaction) RollbackNamespaces(ctx context.Context, height types.Level) ([]storage.Namespace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackNamespaces", ctx, height)
+	ret0, _ := ret[0].([]storage.Namespace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackNamespaces indicates an expected call of RollbackNamespaces.
+func (mr *MockTransactionMockRecorder) RollbackNamespaces(ctx, height any) *TransactionRollbackNamespacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackNamespaces", reflect.TypeOf((*MockTransaction)(nil).RollbackNamespaces), ctx, height)
+	return &TransactionRollbackNamespacesCall{Call: call}
+}
+
+// TransactionRollbackNamespacesCall wrap *gomock.Call
+type TransactionRollbackNamespacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackNamespacesCall) Return(ns []storage.Namespace, err error) *TransactionRollbackNamespacesCall {
+	c.Call = c.Call.Return(ns, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackNamespacesCall) Do(f func(context.Context, types.Level) ([]storage.Namespace, error)) *TransactionRollbackNamespacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackNamespacesCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Namespace, error)) *TransactionRollbackNamespacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackProposals mocks base method.
+func (m *MockTransaction) RollbackProposals(ctx context.Context, height types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackProposals", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackProposals indicates an expected call of RollbackProposals.
+func (mr *MockTransactionMockRecorder) RollbackProposals(ctx, height any) *TransactionRollbackProposalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackProposals", reflect.TypeOf((*MockTransaction)(nil).RollbackProposals), ctx, height)
+	return &TransactionRollbackProposalsCall{Call: call}
+}
+
+// TransactionRollbackProposalsCall wrap *gomock.Call
+type TransactionRollbackProposalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackProposalsCall) Return(arg0 error) *TransactionRollbackProposalsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackProposalsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackProposalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackProposalsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackProposalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackRedelegations mocks base method.
+func (m *MockTransaction) RollbackRedelegations(ctx context.Context, height types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackRedelegations", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackRedelegations indicates an expected call of RollbackRedelegations.
+func (mr *MockTransactionMockRecorder) RollbackRedelegations(ctx, height any) *TransactionRollbackRedelegationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackRedelegations", reflect.TypeOf((*MockTransaction)(nil).RollbackRedelegations), ctx, height)
+	return &TransactionRollbackRedelegationsCall{Call: call}
+}
+
+// TransactionRollbackRedelegationsCall wrap *gomock.Call
+type TransactionRollbackRedelegationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackRedelegationsCall) Return(arg0 error) *TransactionRollbackRedelegationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackRedelegationsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackRedelegationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackRedelegationsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackRedelegationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackSigners mocks base method.
+func (m *MockTransaction) RollbackSigners(ctx context.Context, txIds []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackSigners", ctx, txIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackSigners indicates an expected call of RollbackSigners.
+func (mr *MockTransactionMockRecorder) RollbackSigners(ctx, txIds any) *TransactionRollbackSignersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackSigners", reflect.TypeOf((*MockTransaction)(nil).RollbackSigners), ctx, txIds)
+	return &TransactionRollbackSignersCall{Call: call}
+}
+
+// TransactionRollbackSignersCall wrap *gomock.Call
+type TransactionRollbackSignersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackSignersCall) Return(err error) *TransactionRollbackSignersCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackSignersCall) Do(f func(context.Context, []uint64) error) *TransactionRollbackSignersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackSignersCall) DoAndReturn(f func(context.Context, []uint64) error) *TransactionRollbackSignersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackTxs mocks base method.
+func (m *MockTransaction) RollbackTxs(ctx context.Context, height types.Level) ([]storage.Tx, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackTxs", ctx, height)
+	ret0, _ := ret[0].([]storage.Tx)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackTxs indicates an expected call of RollbackTxs.
+func (mr *MockTransactionMockRecorder) RollbackTxs(ctx, height any) *TransactionRollbackTxsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTxs", reflect.TypeOf((*MockTransaction)(nil).RollbackTxs), ctx, height)
+	return &TransactionRollbackTxsCall{Call: call}
+}
+
+// TransactionRollbackTxsCall wrap *gomock.Call
+type TransactionRollbackTxsCall struct {
 	*gomock.Call
 }
 
@@ -1148,641 +1723,1607 @@ func (c *TransactionRollbackTxsCall) Return(txs []storage.Tx, err error) *Transa
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackTxsCall) Do(f func(context.Context, types.Level) ([]storage.Tx, error)) *TransactionRollbackTxsCall {
+func (c *TransactionRollbackTxsCall) Do(f func(context.Context, types.Level) ([]storage.Tx, error)) *TransactionRollbackTxsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackTxsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Tx, error)) *TransactionRollbackTxsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackUnbondings mocks base method.
+func (m *MockTransaction) RollbackUnbondings(ctx context.Context, height types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackUnbondings", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackUnbondings indicates an expected call of RollbackUnbondings.
+func (mr *MockTransactionMockRecorder) RollbackUnbondings(ctx, height any) *TransactionRollbackUnbondingsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackUnbondings", reflect.TypeOf((*MockTransaction)(nil).RollbackUnbondings), ctx, height)
+	return &TransactionRollbackUnbondingsCall{Call: call}
+}
+
+// TransactionRollbackUnbondingsCall wrap *gomock.Call
+type TransactionRollbackUnbondingsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackUnbondingsCall) Return(arg0 error) *TransactionRollbackUnbondingsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackUnbondingsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackUnbondingsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackUnbondingsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackUnbondingsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackValidatorHistory mocks base method.
+func (m *MockTransaction) RollbackValidatorHistory(ctx context.Context, height types.Level) ([]storage.ValidatorHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackValidatorHistory", ctx, height)
+	ret0, _ := ret[0].([]storage.ValidatorHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackValidatorHistory indicates an expected call of RollbackValidatorHistory.
+func (mr *MockTransactionMockRecorder) RollbackValidatorHistory(ctx, height any) *TransactionRollbackValidatorHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackValidatorHistory", reflect.TypeOf((*MockTransaction)(nil).RollbackValidatorHistory), ctx, height)
+	return &TransactionRollbackValidatorHistoryCall{Call: call}
+}
+
+// TransactionRollbackValidatorHistoryCall wrap *gomock.Call
+type TransactionRollbackValidatorHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackValidatorHistoryCall) Return(history []storage.ValidatorHistory, err error) *TransactionRollbackValidatorHistoryCall {
+	c.Call = c.Call.Return(history, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackValidatorHistoryCall) Do(f func(context.Context, types.Level) ([]storage.ValidatorHistory, error)) *TransactionRollbackValidatorHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackValidatorHistoryCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.ValidatorHistory, error)) *TransactionRollbackValidatorHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackValidatorMessages mocks base method.
+func (m *MockTransaction) RollbackValidatorMessages(ctx context.Context, msgIds []uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackValidatorMessages", ctx, msgIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackValidatorMessages indicates an expected call of RollbackValidatorMessages.
+func (mr *MockTransactionMockRecorder) RollbackValidatorMessages(ctx, msgIds any) *TransactionRollbackValidatorMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackValidatorMessages", reflect.TypeOf((*MockTransaction)(nil).RollbackValidatorMessages), ctx, msgIds)
+	return &TransactionRollbackValidatorMessagesCall{Call: call}
+}
+
+// TransactionRollbackValidatorMessagesCall wrap *gomock.Call
+type TransactionRollbackValidatorMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackValidatorMessagesCall) Return(err error) *TransactionRollbackValidatorMessagesCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackValidatorMessagesCall) Do(f func(context.Context, []uint64) error) *TransactionRollbackValidatorMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackValidatorMessagesCall) DoAndReturn(f func(context.Context, []uint64) error) *TransactionRollbackValidatorMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackValidators mocks base method.
+func (m *MockTransaction) RollbackValidators(ctx context.Context, height types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackValidators", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackValidators indicates an expected call of RollbackValidators.
+func (mr *MockTransactionMockRecorder) RollbackValidators(ctx, height any) *TransactionRollbackValidatorsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackValidators", reflect.TypeOf((*MockTransaction)(nil).RollbackValidators), ctx, height)
+	return &TransactionRollbackValidatorsCall{Call: call}
+}
+
+// TransactionRollbackValidatorsCall wrap *gomock.Call
+type TransactionRollbackValidatorsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackValidatorsCall) Return(err error) *TransactionRollbackValidatorsCall {
+	c.Call = c.Call.Return(err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackValidatorsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackValidatorsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackValidatorsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackValidatorsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RollbackVotes mocks base method.
+func (m *MockTransaction) RollbackVotes(ctx context.Context, height types.Level) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackVotes", ctx, height)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackVotes indicates an expected call of RollbackVotes.
+func (mr *MockTransactionMockRecorder) RollbackVotes(ctx, height any) *TransactionRollbackVotesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackVotes", reflect.TypeOf((*MockTransaction)(nil).RollbackVotes), ctx, height)
+	return &TransactionRollbackVotesCall{Call: call}
+}
+
+// TransactionRollbackVotesCall wrap *gomock.Call
+type TransactionRollbackVotesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionRollbackVotesCall) Return(arg0 error) *TransactionRollbackVotesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionRollbackVotesCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackVotesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionRollbackVotesCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackVotesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveAddresses mocks base method.
+func (m *MockTransaction) SaveAddresses(ctx context.Context, addresses ...*storage.Address) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range addresses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveAddresses", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAddresses indicates an expected call of SaveAddresses.
+func (mr *MockTransactionMockRecorder) SaveAddresses(ctx any, addresses ...any) *TransactionSaveAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, addresses...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveAddresses), varargs...)
+	return &TransactionSaveAddressesCall{Call: call}
+}
+
+// TransactionSaveAddressesCall wrap *gomock.Call
+type TransactionSaveAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveAddressesCall) Return(arg0 int64, arg1 error) *TransactionSaveAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveAddressesCall) Do(f func(context.Context, ...*storage.Address) (int64, error)) *TransactionSaveAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveAddressesCall) DoAndReturn(f func(context.Context, ...*storage.Address) (int64, error)) *TransactionSaveAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBalanceUpdates mocks base method.
+func (m *MockTransaction) SaveBalanceUpdates(ctx context.Context, updates ...storage.BalanceUpdate) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range updates {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBalanceUpdates", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBalanceUpdates indicates an expected call of SaveBalanceUpdates.
+func (mr *MockTransactionMockRecorder) SaveBalanceUpdates(ctx any, updates ...any) *TransactionSaveBalanceUpdatesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, updates...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBalanceUpdates", reflect.TypeOf((*MockTransaction)(nil).SaveBalanceUpdates), varargs...)
+	return &TransactionSaveBalanceUpdatesCall{Call: call}
+}
+
+// TransactionSaveBalanceUpdatesCall wrap *gomock.Call
+type TransactionSaveBalanceUpdatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveBalanceUpdatesCall) Return(arg0 error) *TransactionSaveBalanceUpdatesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveBalanceUpdatesCall) Do(f func(context.Context, ...storage.BalanceUpdate) error) *TransactionSaveBalanceUpdatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveBalanceUpdatesCall) DoAndReturn(f func(context.Context, ...storage.BalanceUpdate) error) *TransactionSaveBalanceUpdatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBalances mocks base method.
+func (m *MockTransaction) SaveBalances(ctx context.Context, balances ...storage.Balance) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range balances {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBalances", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBalances indicates an expected call of SaveBalances.
+func (mr *MockTransactionMockRecorder) SaveBalances(ctx any, balances ...any) *TransactionSaveBalancesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, balances...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBalances", reflect.TypeOf((*MockTransaction)(nil).SaveBalances), varargs...)
+	return &TransactionSaveBalancesCall{Call: call}
+}
+
+// TransactionSaveBalancesCall wrap *gomock.Call
+type TransactionSaveBalancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveBalancesCall) Return(arg0 error) *TransactionSaveBalancesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveBalancesCall) Do(f func(context.Context, ...storage.Balance) error) *TransactionSaveBalancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveBalancesCall) DoAndReturn(f func(context.Context, ...storage.Balance) error) *TransactionSaveBalancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveBlockSignatures mocks base method.
+func (m *MockTransaction) SaveBlockSignatures(ctx context.Context, signatures ...storage.BlockSignature) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range signatures {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveBlockSignatures", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBlockSignatures indicates an expected call of SaveBlockSignatures.
+func (mr *MockTransactionMockRecorder) SaveBlockSignatures(ctx any, signatures ...any) *TransactionSaveBlockSignaturesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, signatures...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBlockSignatures", reflect.TypeOf((*MockTransaction)(nil).SaveBlockSignatures), varargs...)
+	return &TransactionSaveBlockSignaturesCall{Call: call}
+}
+
+// TransactionSaveBlockSignaturesCall wrap *gomock.Call
+type TransactionSaveBlockSignaturesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveBlockSignaturesCall) Return(arg0 error) *TransactionSaveBlockSignaturesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveBlockSignaturesCall) Do(f func(context.Context, ...storage.BlockSignature) error) *TransactionSaveBlockSignaturesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveBlockSignaturesCall) DoAndReturn(f func(context.Context, ...storage.BlockSignature) error) *TransactionSaveBlockSignaturesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveConstants mocks base method.
+func (m *MockTransaction) SaveConstants(ctx context.Context, constants ...storage.Constant) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range constants {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveConstants", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveConstants indicates an expected call of SaveConstants.
+func (mr *MockTransactionMockRecorder) SaveConstants(ctx any, constants ...any) *TransactionSaveConstantsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, constants...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConstants", reflect.TypeOf((*MockTransaction)(nil).SaveConstants), varargs...)
+	return &TransactionSaveConstantsCall{Call: call}
+}
+
+// TransactionSaveConstantsCall wrap *gomock.Call
+type TransactionSaveConstantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveConstantsCall) Return(arg0 error) *TransactionSaveConstantsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveConstantsCall) Do(f func(context.Context, ...storage.Constant) error) *TransactionSaveConstantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveConstantsCall) DoAndReturn(f func(context.Context, ...storage.Constant) error) *TransactionSaveConstantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveDelegationLogs mocks base method.
+func (m *MockTransaction) SaveDelegationLogs(ctx context.Context, logs ...storage.DelegationLog) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range logs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveDelegationLogs", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDelegationLogs indicates an expected call of SaveDelegationLogs.
+func (mr *MockTransactionMockRecorder) SaveDelegationLogs(ctx any, logs ...any) *TransactionSaveDelegationLogsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, logs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDelegationLogs", reflect.TypeOf((*MockTransaction)(nil).SaveDelegationLogs), varargs...)
+	return &TransactionSaveDelegationLogsCall{Call: call}
+}
+
+// TransactionSaveDelegationLogsCall wrap *gomock.Call
+type TransactionSaveDelegationLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveDelegationLogsCall) Return(arg0 error) *TransactionSaveDelegationLogsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveDelegationLogsCall) Do(f func(context.Context, ...storage.DelegationLog) error) *TransactionSaveDelegationLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveDelegationLogsCall) DoAndReturn(f func(context.Context, ...storage.DelegationLog) error) *TransactionSaveDelegationLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveDelegations mocks base method.
+func (m *MockTransaction) SaveDelegations(ctx context.Context, delegations ...storage.Delegation) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range delegations {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveDelegations", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDelegations indicates an expected call of SaveDelegations.
+func (mr *MockTransactionMockRecorder) SaveDelegations(ctx any, delegations ...any) *TransactionSaveDelegationsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, delegations...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDelegations", reflect.TypeOf((*MockTransaction)(nil).SaveDelegations), varargs...)
+	return &TransactionSaveDelegationsCall{Call: call}
+}
+
+// TransactionSaveDelegationsCall wrap *gomock.Call
+type TransactionSaveDelegationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveDelegationsCall) Return(arg0 error) *TransactionSaveDelegationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveDelegationsCall) Do(f func(context.Context, ...storage.Delegation) error) *TransactionSaveDelegationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveDelegationsCall) DoAndReturn(f func(context.Context, ...storage.Delegation) error) *TransactionSaveDelegationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveDeposits mocks base method.
+func (m *MockTransaction) SaveDeposits(ctx context.Context, deposits ...storage.Deposit) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range deposits {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveDeposits", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDeposits indicates an expected call of SaveDeposits.
+func (mr *MockTransactionMockRecorder) SaveDeposits(ctx any, deposits ...any) *TransactionSaveDepositsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, deposits...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDeposits", reflect.TypeOf((*MockTransaction)(nil).SaveDeposits), varargs...)
+	return &TransactionSaveDepositsCall{Call: call}
+}
+
+// TransactionSaveDepositsCall wrap *gomock.Call
+type TransactionSaveDepositsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveDepositsCall) Return(arg0 error) *TransactionSaveDepositsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveDepositsCall) Do(f func(context.Context, ...storage.Deposit) error) *TransactionSaveDepositsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveDepositsCall) DoAndReturn(f func(context.Context, ...storage.Deposit) error) *TransactionSaveDepositsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveEvents mocks base method.
+func (m *MockTransaction) SaveEvents(ctx context.Context, events ...storage.Event) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveEvents", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveEvents indicates an expected call of SaveEvents.
+func (mr *MockTransactionMockRecorder) SaveEvents(ctx any, events ...any) *TransactionSaveEventsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, events...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEvents", reflect.TypeOf((*MockTransaction)(nil).SaveEvents), varargs...)
+	return &TransactionSaveEventsCall{Call: call}
+}
+
+// TransactionSaveEventsCall wrap *gomock.Call
+type TransactionSaveEventsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveEventsCall) Return(arg0 error) *TransactionSaveEventsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveEventsCall) Do(f func(context.Context, ...storage.Event) error) *TransactionSaveEventsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveEventsCall) DoAndReturn(f func(context.Context, ...storage.Event) error) *TransactionSaveEventsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveEvidence mocks base method.
+func (m *MockTransaction) SaveEvidence(ctx context.Context, evidence ...storage.Evidence) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range evidence {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveEvidence", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveEvidence indicates an expected call of SaveEvidence.
+func (mr *MockTransactionMockRecorder) SaveEvidence(ctx any, evidence ...any) *TransactionSaveEvidenceCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, evidence...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEvidence", reflect.TypeOf((*MockTransaction)(nil).SaveEvidence), varargs...)
+	return &TransactionSaveEvidenceCall{Call: call}
+}
+
+// TransactionSaveEvidenceCall wrap *gomock.Call
+type TransactionSaveEvidenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveEvidenceCall) Return(arg0 error) *TransactionSaveEvidenceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveEvidenceCall) Do(f func(context.Context, ...storage.Evidence) error) *TransactionSaveEvidenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveEvidenceCall) DoAndReturn(f func(context.Context, ...storage.Evidence) error) *TransactionSaveEvidenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveFeeAllowances mocks base method.
+func (m *MockTransaction) SaveFeeAllowances(ctx context.Context, allowances ...*storage.FeeAllowance) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range allowances {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveFeeAllowances", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFeeAllowances indicates an expected call of SaveFeeAllowances.
+func (mr *MockTransactionMockRecorder) SaveFeeAllowances(ctx any, allowances ...any) *TransactionSaveFeeAllowancesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, allowances...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFeeAllowances", reflect.TypeOf((*MockTransaction)(nil).SaveFeeAllowances), varargs...)
+	return &TransactionSaveFeeAllowancesCall{Call: call}
+}
+
+// TransactionSaveFeeAllowancesCall wrap *gomock.Call
+type TransactionSaveFeeAllowancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveFeeAllowancesCall) Return(arg0 error) *TransactionSaveFeeAllowancesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveFeeAllowancesCall) Do(f func(context.Context, ...*storage.FeeAllowance) error) *TransactionSaveFeeAllowancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveFeeAllowancesCall) DoAndReturn(f func(context.Context, ...*storage.FeeAllowance) error) *TransactionSaveFeeAllowancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveGrants mocks base method.
+func (m *MockTransaction) SaveGrants(ctx context.Context, grants ...*storage.Grant) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range grants {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveGrants", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveGrants indicates an expected call of SaveGrants.
+func (mr *MockTransactionMockRecorder) SaveGrants(ctx any, grants ...any) *TransactionSaveGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, grants...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveGrants", reflect.TypeOf((*MockTransaction)(nil).SaveGrants), varargs...)
+	return &TransactionSaveGrantsCall{Call: call}
+}
+
+// TransactionSaveGrantsCall wrap *gomock.Call
+type TransactionSaveGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveGrantsCall) Return(arg0 error) *TransactionSaveGrantsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveGrantsCall) Do(f func(context.Context, ...*storage.Grant) error) *TransactionSaveGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveGrantsCall) DoAndReturn(f func(context.Context, ...*storage.Grant) error) *TransactionSaveGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveIbcTransfers mocks base method.
+func (m *MockTransaction) SaveIbcTransfers(ctx context.Context, transfers ...*storage.IbcTransfer) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range transfers {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveIbcTransfers", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveIbcTransfers indicates an expected call of SaveIbcTransfers.
+func (mr *MockTransactionMockRecorder) SaveIbcTransfers(ctx any, transfers ...any) *TransactionSaveIbcTransfersCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, transfers...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIbcTransfers", reflect.TypeOf((*MockTransaction)(nil).SaveIbcTransfers), varargs...)
+	return &TransactionSaveIbcTransfersCall{Call: call}
+}
+
+// TransactionSaveIbcTransfersCall wrap *gomock.Call
+type TransactionSaveIbcTransfersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveIbcTransfersCall) Return(arg0 error) *TransactionSaveIbcTransfersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveIbcTransfersCall) Do(f func(context.Context, ...*storage.IbcTransfer) error) *TransactionSaveIbcTransfersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveIbcTransfersCall) DoAndReturn(f func(context.Context, ...*storage.IbcTransfer) error) *TransactionSaveIbcTransfersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveMessages mocks base method.
+func (m *MockTransaction) SaveMessages(ctx context.Context, msgs ...*storage.Message) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range msgs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveMessages", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveMessages indicates an expected call of SaveMessages.
+func (mr *MockTransactionMockRecorder) SaveMessages(ctx any, msgs ...any) *TransactionSaveMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, msgs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMessages", reflect.TypeOf((*MockTransaction)(nil).SaveMessages), varargs...)
+	return &TransactionSaveMessagesCall{Call: call}
+}
+
+// TransactionSaveMessagesCall wrap *gomock.Call
+type TransactionSaveMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveMessagesCall) Return(arg0 error) *TransactionSaveMessagesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveMessagesCall) Do(f func(context.Context, ...*storage.Message) error) *TransactionSaveMessagesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackTxsCall) DoAndReturn(f func(context.Context, types.Level) ([]storage.Tx, error)) *TransactionRollbackTxsCall {
+func (c *TransactionSaveMessagesCall) DoAndReturn(f func(context.Context, ...*storage.Message) error) *TransactionSaveMessagesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RollbackValidators mocks base method.
-func (m *MockTransaction) RollbackValidators(ctx context.Context, height types.Level) error {
+// SaveMsgAddresses mocks base method.
+func (m *MockTransaction) SaveMsgAddresses(ctx context.Context, addresses ...storage.MsgAddress) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackValidators", ctx, height)
+	varargs := []any{ctx}
+	for _, a := range addresses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveMsgAddresses", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackValidators indicates an expected call of RollbackValidators.
-func (mr *MockTransactionMockRecorder) RollbackValidators(ctx, height any) *TransactionRollbackValidatorsCall {
+// SaveMsgAddresses indicates an expected call of SaveMsgAddresses.
+func (mr *MockTransactionMockRecorder) SaveMsgAddresses(ctx any, addresses ...any) *TransactionSaveMsgAddressesCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackValidators", reflect.TypeOf((*MockTransaction)(nil).RollbackValidators), ctx, height)
-	return &TransactionRollbackValidatorsCall{Call: call}
+	varargs := append([]any{ctx}, addresses...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMsgAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveMsgAddresses), varargs...)
+	return &TransactionSaveMsgAddressesCall{Call: call}
 }
 
-// TransactionRollbackValidatorsCall wrap *gomock.Call
-type TransactionRollbackValidatorsCall struct {
+// TransactionSaveMsgAddressesCall wrap *gomock.Call
+type TransactionSaveMsgAddressesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionRollbackValidatorsCall) Return(err error) *TransactionRollbackValidatorsCall {
-	c.Call = c.Call.Return(err)
+func (c *TransactionSaveMsgAddressesCall) Return(arg0 error) *TransactionSaveMsgAddressesCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionRollbackValidatorsCall) Do(f func(context.Context, types.Level) error) *TransactionRollbackValidatorsCall {
+func (c *TransactionSaveMsgAddressesCall) Do(f func(context.Context, ...storage.MsgAddress) error) *TransactionSaveMsgAddressesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionRollbackValidatorsCall) DoAndReturn(f func(context.Context, types.Level) error) *TransactionRollbackValidatorsCall {
+func (c *TransactionSaveMsgAddressesCall) DoAndReturn(f func(context.Context, ...storage.MsgAddress) error) *TransactionSaveMsgAddressesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveAddresses mocks base method.
-func (m *MockTransaction) SaveAddresses(ctx context.Context, addresses ...*storage.Address) (int64, error) {
+// SaveNamespaceMessage mocks base method.
+func (m *MockTransaction) SaveNamespaceMessage(ctx context.Context, nsMsgs ...storage.NamespaceMessage) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range addresses {
+	for _, a := range nsMsgs {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveAddresses", varargs...)
+	ret := m.ctrl.Call(m, "SaveNamespaceMessage", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveNamespaceMessage indicates an expected call of SaveNamespaceMessage.
+func (mr *MockTransactionMockRecorder) SaveNamespaceMessage(ctx any, nsMsgs ...any) *TransactionSaveNamespaceMessageCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, nsMsgs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNamespaceMessage", reflect.TypeOf((*MockTransaction)(nil).SaveNamespaceMessage), varargs...)
+	return &TransactionSaveNamespaceMessageCall{Call: call}
+}
+
+// TransactionSaveNamespaceMessageCall wrap *gomock.Call
+type TransactionSaveNamespaceMessageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveNamespaceMessageCall) Return(arg0 error) *TransactionSaveNamespaceMessageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveNamespaceMessageCall) Do(f func(context.Context, ...storage.NamespaceMessage) error) *TransactionSaveNamespaceMessageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveNamespaceMessageCall) DoAndReturn(f func(context.Context, ...storage.NamespaceMessage) error) *TransactionSaveNamespaceMessageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveNamespaces mocks base method.
+func (m *MockTransaction) SaveNamespaces(ctx context.Context, namespaces ...*storage.Namespace) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range namespaces {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveNamespaces", varargs...)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SaveAddresses indicates an expected call of SaveAddresses.
-func (mr *MockTransactionMockRecorder) SaveAddresses(ctx any, addresses ...any) *TransactionSaveAddressesCall {
+// SaveNamespaces indicates an expected call of SaveNamespaces.
+func (mr *MockTransactionMockRecorder) SaveNamespaces(ctx any, namespaces ...any) *TransactionSaveNamespacesCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, addresses...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveAddresses), varargs...)
-	return &TransactionSaveAddressesCall{Call: call}
+	varargs := append([]any{ctx}, namespaces...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNamespaces", reflect.TypeOf((*MockTransaction)(nil).SaveNamespaces), varargs...)
+	return &TransactionSaveNamespacesCall{Call: call}
 }
 
-// TransactionSaveAddressesCall wrap *gomock.Call
-type TransactionSaveAddressesCall struct {
+// TransactionSaveNamespacesCall wrap *gomock.Call
+type TransactionSaveNamespacesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveAddressesCall) Return(arg0 int64, arg1 error) *TransactionSaveAddressesCall {
+func (c *TransactionSaveNamespacesCall) Return(arg0 int64, arg1 error) *TransactionSaveNamespacesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveAddressesCall) Do(f func(context.Context, ...*storage.Address) (int64, error)) *TransactionSaveAddressesCall {
+func (c *TransactionSaveNamespacesCall) Do(f func(context.Context, ...*storage.Namespace) (int64, error)) *TransactionSaveNamespacesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveAddressesCall) DoAndReturn(f func(context.Context, ...*storage.Address) (int64, error)) *TransactionSaveAddressesCall {
+func (c *TransactionSaveNamespacesCall) DoAndReturn(f func(context.Context, ...*storage.Namespace) (int64, error)) *TransactionSaveNamespacesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveBalances mocks base method.
-func (m *MockTransaction) SaveBalances(ctx context.Context, balances ...storage.Balance) error {
+// SaveProposals mocks base method.
+func (m *MockTransaction) SaveProposals(ctx context.Context, proposals ...*storage.Proposal) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range balances {
+	for _, a := range proposals {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveBalances", varargs...)
+	ret := m.ctrl.Call(m, "SaveProposals", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveBalances indicates an expected call of SaveBalances.
-func (mr *MockTransactionMockRecorder) SaveBalances(ctx any, balances ...any) *TransactionSaveBalancesCall {
+// SaveProposals indicates an expected call of SaveProposals.
+func (mr *MockTransactionMockRecorder) SaveProposals(ctx any, proposals ...any) *TransactionSaveProposalsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, balances...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBalances", reflect.TypeOf((*MockTransaction)(nil).SaveBalances), varargs...)
-	return &TransactionSaveBalancesCall{Call: call}
+	varargs := append([]any{ctx}, proposals...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProposals", reflect.TypeOf((*MockTransaction)(nil).SaveProposals), varargs...)
+	return &TransactionSaveProposalsCall{Call: call}
 }
 
-// TransactionSaveBalancesCall wrap *gomock.Call
-type TransactionSaveBalancesCall struct {
+// TransactionSaveProposalsCall wrap *gomock.Call
+type TransactionSaveProposalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveProposalsCall) Return(arg0 error) *TransactionSaveProposalsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveProposalsCall) Do(f func(context.Context, ...*storage.Proposal) error) *TransactionSaveProposalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveProposalsCall) DoAndReturn(f func(context.Context, ...*storage.Proposal) error) *TransactionSaveProposalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveRedelegations mocks base method.
+func (m *MockTransaction) SaveRedelegations(ctx context.Context, redelegations ...*storage.Redelegation) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range redelegations {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveRedelegations", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRedelegations indicates an expected call of SaveRedelegations.
+func (mr *MockTransactionMockRecorder) SaveRedelegations(ctx any, redelegations ...any) *TransactionSaveRedelegationsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, redelegations...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRedelegations", reflect.TypeOf((*MockTransaction)(nil).SaveRedelegations), varargs...)
+	return &TransactionSaveRedelegationsCall{Call: call}
+}
+
+// TransactionSaveRedelegationsCall wrap *gomock.Call
+type TransactionSaveRedelegationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveRedelegationsCall) Return(arg0 error) *TransactionSaveRedelegationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveRedelegationsCall) Do(f func(context.Context, ...*storage.Redelegation) error) *TransactionSaveRedelegationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveRedelegationsCall) DoAndReturn(f func(context.Context, ...*storage.Redelegation) error) *TransactionSaveRedelegationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveSigners mocks base method.
+func (m *MockTransaction) SaveSigners(ctx context.Context, addresses ...storage.Signer) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range addresses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveSigners", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSigners indicates an expected call of SaveSigners.
+func (mr *MockTransactionMockRecorder) SaveSigners(ctx any, addresses ...any) *TransactionSaveSignersCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, addresses...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSigners", reflect.TypeOf((*MockTransaction)(nil).SaveSigners), varargs...)
+	return &TransactionSaveSignersCall{Call: call}
+}
+
+// TransactionSaveSignersCall wrap *gomock.Call
+type TransactionSaveSignersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *TransactionSaveSignersCall) Return(arg0 error) *TransactionSaveSignersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *TransactionSaveSignersCall) Do(f func(context.Context, ...storage.Signer) error) *TransactionSaveSignersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *TransactionSaveSignersCall) DoAndReturn(f func(context.Context, ...storage.Signer) error) *TransactionSaveSignersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SaveTransactions mocks base method.
+func (m *MockTransaction) SaveTransactions(ctx context.Context, txs ...storage.Tx) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range txs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveTransactions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTransactions indicates an expected call of SaveTransactions.
+func (mr *MockTransactionMockRecorder) SaveTransactions(ctx any, txs ...any) *TransactionSaveTransactionsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, txs...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTransactions", reflect.TypeOf((*MockTransaction)(nil).SaveTransactions), varargs...)
+	return &TransactionSaveTransactionsCall{Call: call}
+}
+
+// TransactionSaveTransactionsCall wrap *gomock.Call
+type TransactionSaveTransactionsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveBalancesCall) Return(arg0 error) *TransactionSaveBalancesCall {
+func (c *TransactionSaveTransactionsCall) Return(arg0 error) *TransactionSaveTransactionsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveBalancesCall) Do(f func(context.Context, ...storage.Balance) error) *TransactionSaveBalancesCall {
+func (c *TransactionSaveTransactionsCall) Do(f func(context.Context, ...storage.Tx) error) *TransactionSaveTransactionsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveBalancesCall) DoAndReturn(f func(context.Context, ...storage.Balance) error) *TransactionSaveBalancesCall {
+func (c *TransactionSaveTransactionsCall) DoAndReturn(f func(context.Context, ...storage.Tx) error) *TransactionSaveTransactionsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveConstants mocks base method.
-func (m *MockTransaction) SaveConstants(ctx context.Context, constants ...storage.Constant) error {
+// SaveUnbondings mocks base method.
+func (m *MockTransaction) SaveUnbondings(ctx context.Context, unbondings ...*storage.Unbonding) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range constants {
+	for _, a := range unbondings {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveConstants", varargs...)
+	ret := m.ctrl.Call(m, "SaveUnbondings", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveConstants indicates an expected call of SaveConstants.
-func (mr *MockTransactionMockRecorder) SaveConstants(ctx any, constants ...any) *TransactionSaveConstantsCall {
+// SaveUnbondings indicates an expected call of SaveUnbondings.
+func (mr *MockTransactionMockRecorder) SaveUnbondings(ctx any, unbondings ...any) *TransactionSaveUnbondingsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, constants...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConstants", reflect.TypeOf((*MockTransaction)(nil).SaveConstants), varargs...)
-	return &TransactionSaveConstantsCall{Call: call}
+	varargs := append([]any{ctx}, unbondings...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUnbondings", reflect.TypeOf((*MockTransaction)(nil).SaveUnbondings), varargs...)
+	return &TransactionSaveUnbondingsCall{Call: call}
 }
 
-// TransactionSaveConstantsCall wrap *gomock.Call
-type TransactionSaveConstantsCall struct {
+// TransactionSaveUnbondingsCall wrap *gomock.Call
+type TransactionSaveUnbondingsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveConstantsCall) Return(arg0 error) *TransactionSaveConstantsCall {
+func (c *TransactionSaveUnbondingsCall) Return(arg0 error) *TransactionSaveUnbondingsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveConstantsCall) Do(f func(context.Context, ...storage.Constant) error) *TransactionSaveConstantsCall {
+func (c *TransactionSaveUnbondingsCall) Do(f func(context.Context, ...*storage.Unbonding) error) *TransactionSaveUnbondingsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveConstantsCall) DoAndReturn(f func(context.Context, ...storage.Constant) error) *TransactionSaveConstantsCall {
+func (c *TransactionSaveUnbondingsCall) DoAndReturn(f func(context.Context, ...*storage.Unbonding) error) *TransactionSaveUnbondingsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveEvents mocks base method.
-func (m *MockTransaction) SaveEvents(ctx context.Context, events ...storage.Event) error {
+// SaveValidatorHistory mocks base method.
+func (m *MockTransaction) SaveValidatorHistory(ctx context.Context, history ...storage.ValidatorHistory) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range events {
+	for _, a := range history {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveEvents", varargs...)
+	ret := m.ctrl.Call(m, "SaveValidatorHistory", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveEvents indicates an expected call of SaveEvents.
-func (mr *MockTransactionMockRecorder) SaveEvents(ctx any, events ...any) *TransactionSaveEventsCall {
+// SaveValidatorHistory indicates an expected call of SaveValidatorHistory.
+func (mr *MockTransactionMockRecorder) SaveValidatorHistory(ctx any, history ...any) *TransactionSaveValidatorHistoryCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, events...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEvents", reflect.TypeOf((*MockTransaction)(nil).SaveEvents), varargs...)
-	return &TransactionSaveEventsCall{Call: call}
+	varargs := append([]any{ctx}, history...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveValidatorHistory", reflect.TypeOf((*MockTransaction)(nil).SaveValidatorHistory), varargs...)
+	return &TransactionSaveValidatorHistoryCall{Call: call}
 }
 
-// TransactionSaveEventsCall wrap *gomock.Call
-type TransactionSaveEventsCall struct {
+// TransactionSaveValidatorHistoryCall wrap *gomock.Call
+type TransactionSaveValidatorHistoryCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveEventsCall) Return(arg0 error) *TransactionSaveEventsCall {
+func (c *TransactionSaveValidatorHistoryCall) Return(arg0 error) *TransactionSaveValidatorHistoryCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveEventsCall) Do(f func(context.Context, ...storage.Event) error) *TransactionSaveEventsCall {
+func (c *TransactionSaveValidatorHistoryCall) Do(f func(context.Context, ...storage.ValidatorHistory) error) *TransactionSaveValidatorHistoryCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveEventsCall) DoAndReturn(f func(context.Context, ...storage.Event) error) *TransactionSaveEventsCall {
+func (c *TransactionSaveValidatorHistoryCall) DoAndReturn(f func(context.Context, ...storage.ValidatorHistory) error) *TransactionSaveValidatorHistoryCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveMessages mocks base method.
-func (m *MockTransaction) SaveMessages(ctx context.Context, msgs ...*storage.Message) error {
+// SaveValidatorMessages mocks base method.
+func (m *MockTransaction) SaveValidatorMessages(ctx context.Context, msgs ...storage.ValidatorMessage) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range msgs {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveMessages", varargs...)
+	ret := m.ctrl.Call(m, "SaveValidatorMessages", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveMessages indicates an expected call of SaveMessages.
-func (mr *MockTransactionMockRecorder) SaveMessages(ctx any, msgs ...any) *TransactionSaveMessagesCall {
+// SaveValidatorMessages indicates an expected call of SaveValidatorMessages.
+func (mr *MockTransactionMockRecorder) SaveValidatorMessages(ctx any, msgs ...any) *TransactionSaveValidatorMessagesCall {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, msgs...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMessages", reflect.TypeOf((*MockTransaction)(nil).SaveMessages), varargs...)
-	return &TransactionSaveMessagesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveValidatorMessages", reflect.TypeOf((*MockTransaction)(nil).SaveValidatorMessages), varargs...)
+	return &TransactionSaveValidatorMessagesCall{Call: call}
 }
 
-// TransactionSaveMessagesCall wrap *gomock.Call
-type TransactionSaveMessagesCall struct {
+// TransactionSaveValidatorMessagesCall wrap *gomock.Call
+type TransactionSaveValidatorMessagesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveMessagesCall) Return(arg0 error) *TransactionSaveMessagesCall {
+func (c *TransactionSaveValidatorMessagesCall) Return(arg0 error) *TransactionSaveValidatorMessagesCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveMessagesCall) Do(f func(context.Context, ...*storage.Message) error) *TransactionSaveMessagesCall {
+func (c *TransactionSaveValidatorMessagesCall) Do(f func(context.Context, ...storage.ValidatorMessage) error) *TransactionSaveValidatorMessagesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveMessagesCall) DoAndReturn(f func(context.Context, ...*storage.Message) error) *TransactionSaveMessagesCall {
+func (c *TransactionSaveValidatorMessagesCall) DoAndReturn(f func(context.Context, ...storage.ValidatorMessage) error) *TransactionSaveValidatorMessagesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveMsgAddresses mocks base method.
-func (m *MockTransaction) SaveMsgAddresses(ctx context.Context, addresses ...storage.MsgAddress) error {
+// SaveValidators mocks base method.
+func (m *MockTransaction) SaveValidators(ctx context.Context, validators ...*storage.Validator) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range addresses {
+	for _, a := range validators {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveMsgAddresses", varargs...)
+	ret := m.ctrl.Call(m, "SaveValidators", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveMsgAddresses indicates an expected call of SaveMsgAddresses.
-func (mr *MockTransactionMockRecorder) SaveMsgAddresses(ctx any, addresses ...any) *TransactionSaveMsgAddressesCall {
+// SaveValidators indicates an expected call of SaveValidators.
+func (mr *MockTransactionMockRecorder) SaveValidators(ctx any, validators ...any) *TransactionSaveValidatorsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, addresses...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMsgAddresses", reflect.TypeOf((*MockTransaction)(nil).SaveMsgAddresses), varargs...)
-	return &TransactionSaveMsgAddressesCall{Call: call}
+	varargs := append([]any{ctx}, validators...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveValidators", reflect.TypeOf((*MockTransaction)(nil).SaveValidators), varargs...)
+	return &TransactionSaveValidatorsCall{Call: call}
 }
 
-// TransactionSaveMsgAddressesCall wrap *gomock.Call
-type TransactionSaveMsgAddressesCall struct {
+// TransactionSaveValidatorsCall wrap *gomock.Call
+type TransactionSaveValidatorsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveMsgAddressesCall) Return(arg0 error) *TransactionSaveMsgAddressesCall {
+func (c *TransactionSaveValidatorsCall) Return(arg0 error) *TransactionSaveValidatorsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveMsgAddressesCall) Do(f func(context.Context, ...storage.MsgAddress) error) *TransactionSaveMsgAddressesCall {
+func (c *TransactionSaveValidatorsCall) Do(f func(context.Context, ...*storage.Validator) error) *TransactionSaveValidatorsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveMsgAddressesCall) DoAndReturn(f func(context.Context, ...storage.MsgAddress) error) *TransactionSaveMsgAddressesCall {
+func (c *TransactionSaveValidatorsCall) DoAndReturn(f func(context.Context, ...*storage.Validator) error) *TransactionSaveValidatorsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveNamespaceMessage mocks base method.
-func (m *MockTransaction) SaveNamespaceMessage(ctx context.Context, nsMsgs ...storage.NamespaceMessage) error {
+// SaveVotes mocks base method.
+func (m *MockTransaction) SaveVotes(ctx context.Context, votes ...storage.Vote) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range nsMsgs {
+	for _, a := range votes {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveNamespaceMessage", varargs...)
+	ret := m.ctrl.Call(m, "SaveVotes", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveNamespaceMessage indicates an expected call of SaveNamespaceMessage.
-func (mr *MockTransactionMockRecorder) SaveNamespaceMessage(ctx any, nsMsgs ...any) *TransactionSaveNamespaceMessageCall {
+// SaveVotes indicates an expected call of SaveVotes.
+func (mr *MockTransactionMockRecorder) SaveVotes(ctx any, votes ...any) *TransactionSaveVotesCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, nsMsgs...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNamespaceMessage", reflect.TypeOf((*MockTransaction)(nil).SaveNamespaceMessage), varargs...)
-	return &TransactionSaveNamespaceMessageCall{Call: call}
+	varargs := append([]any{ctx}, votes...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveVotes", reflect.TypeOf((*MockTransaction)(nil).SaveVotes), varargs...)
+	return &TransactionSaveVotesCall{Call: call}
 }
 
-// TransactionSaveNamespaceMessageCall wrap *gomock.Call
-type TransactionSaveNamespaceMessageCall struct {
+// TransactionSaveVotesCall wrap *gomock.Call
+type TransactionSaveVotesCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveNamespaceMessageCall) Return(arg0 error) *TransactionSaveNamespaceMessageCall {
+func (c *TransactionSaveVotesCall) Return(arg0 error) *TransactionSaveVotesCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveNamespaceMessageCall) Do(f func(context.Context, ...storage.NamespaceMessage) error) *TransactionSaveNamespaceMessageCall {
+func (c *TransactionSaveVotesCall) Do(f func(context.Context, ...storage.Vote) error) *TransactionSaveVotesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveNamespaceMessageCall) DoAndReturn(f func(context.Context, ...storage.NamespaceMessage) error) *TransactionSaveNamespaceMessageCall {
+func (c *TransactionSaveVotesCall) DoAndReturn(f func(context.Context, ...storage.Vote) error) *TransactionSaveVotesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveNamespaces mocks base method.
-func (m *MockTransaction) SaveNamespaces(ctx context.Context, namespaces ...*storage.Namespace) (int64, error) {
+// State mocks base method.
+func (m *MockTransaction) State(ctx context.Context, name string) (storage.State, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range namespaces {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SaveNamespaces", varargs...)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "State", ctx, name)
+	ret0, _ := ret[0].(storage.State)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SaveNamespaces indicates an expected call of SaveNamespaces.
-func (mr *MockTransactionMockRecorder) SaveNamespaces(ctx any, namespaces ...any) *TransactionSaveNamespacesCall {
+// State indicates an expected call of State.
+func (mr *MockTransactionMockRecorder) State(ctx, name any) *TransactionStateCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, namespaces...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNamespaces", reflect.TypeOf((*MockTransaction)(nil).SaveNamespaces), varargs...)
-	return &TransactionSaveNamespacesCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "State", reflect.TypeOf((*MockTransaction)(nil).State), ctx, name)
+	return &TransactionStateCall{Call: call}
 }
 
-// TransactionSaveNamespacesCall wrap *gomock.Call
-type TransactionSaveNamespacesCall struct {
+// TransactionStateCall wrap *gomock.Call
+type TransactionStateCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveNamespacesCall) Return(arg0 int64, arg1 error) *TransactionSaveNamespacesCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *TransactionStateCall) Return(state storage.State, err error) *TransactionStateCall {
+	c.Call = c.Call.Return(state, err)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveNamespacesCall) Do(f func(context.Context, ...*storage.Namespace) (int64, error)) *TransactionSaveNamespacesCall {
+func (c *TransactionStateCall) Do(f func(context.Context, string) (storage.State, error)) *TransactionStateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveNamespacesCall) DoAndReturn(f func(context.Context, ...*storage.Namespace) (int64, error)) *TransactionSaveNamespacesCall {
+func (c *TransactionStateCall) DoAndReturn(f func(context.Context, string) (storage.State, error)) *TransactionStateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveSigners mocks base method.
-func (m *MockTransaction) SaveSigners(ctx context.Context, addresses ...storage.Signer) error {
+// Tx mocks base method.
+func (m *MockTransaction) Tx() *bun.Tx {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range addresses {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SaveSigners", varargs...)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Tx")
+	ret0, _ := ret[0].(*bun.Tx)
 	return ret0
 }
 
-// SaveSigners indicates an expected call of SaveSigners.
-func (mr *MockTransactionMockRecorder) SaveSigners(ctx any, addresses ...any) *TransactionSaveSignersCall {
+// Tx indicates an expected call of Tx.
+func (mr *MockTransactionMockRecorder) Tx() *TransactionTxCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, addresses...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSigners", reflect.TypeOf((*MockTransaction)(nil).SaveSigners), varargs...)
-	return &TransactionSaveSignersCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockTransaction)(nil).Tx))
+	return &TransactionTxCall{Call: call}
 }
 
-// TransactionSaveSignersCall wrap *gomock.Call
-type TransactionSaveSignersCall struct {
+// TransactionTxCall wrap *gomock.Call
+type TransactionTxCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveSignersCall) Return(arg0 error) *TransactionSaveSignersCall {
+func (c *TransactionTxCall) Return(arg0 *bun.Tx) *TransactionTxCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveSignersCall) Do(f func(context.Context, ...storage.Signer) error) *TransactionSaveSignersCall {
+func (c *TransactionTxCall) Do(f func() *bun.Tx) *TransactionTxCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveSignersCall) DoAndReturn(f func(context.Context, ...storage.Signer) error) *TransactionSaveSignersCall {
+func (c *TransactionTxCall) DoAndReturn(f func() *bun.Tx) *TransactionTxCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveTransactions mocks base method.
-func (m *MockTransaction) SaveTransactions(ctx context.Context, txs ...storage.Tx) error {
+// Update mocks base method.
+func (m *MockTransaction) Update(ctx context.Context, model any) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range txs {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SaveTransactions", varargs...)
+	ret := m.ctrl.Call(m, "Update", ctx, model)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveTransactions indicates an expected call of SaveTransactions.
-func (mr *MockTransactionMockRecorder) SaveTransactions(ctx any, txs ...any) *TransactionSaveTransactionsCall {
+// Update indicates an expected call of Update.
+func (mr *MockTransactionMockRecorder) Update(ctx, model any) *TransactionUpdateCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, txs...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTransactions", reflect.TypeOf((*MockTransaction)(nil).SaveTransactions), varargs...)
-	return &TransactionSaveTransactionsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransaction)(nil).Update), ctx, model)
+	return &TransactionUpdateCall{Call: call}
 }
 
-// TransactionSaveTransactionsCall wrap *gomock.Call
-type TransactionSaveTransactionsCall struct {
+// TransactionUpdateCall wrap *gomock.Call
+type TransactionUpdateCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveTransactionsCall) Return(arg0 error) *TransactionSaveTransactionsCall {
+func (c *TransactionUpdateCall) Return(arg0 error) *TransactionUpdateCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveTransactionsCall) Do(f func(context.Context, ...storage.Tx) error) *TransactionSaveTransactionsCall {
+func (c *TransactionUpdateCall) Do(f func(context.Context, any) error) *TransactionUpdateCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveTransactionsCall) DoAndReturn(f func(context.Context, ...storage.Tx) error) *TransactionSaveTransactionsCall {
+func (c *TransactionUpdateCall) DoAndReturn(f func(context.Context, any) error) *TransactionUpdateCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// SaveValidators mocks base method.
-func (m *MockTransaction) SaveValidators(ctx context.Context, validators ...*storage.Validator) error {
+// UpdateProposals mocks base method.
+func (m *MockTransaction) UpdateProposals(ctx context.Context, proposals ...*storage.Proposal) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range validators {
+	for _, a := range proposals {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SaveValidators", varargs...)
+	ret := m.ctrl.Call(m, "UpdateProposals", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SaveValidators indicates an expected call of SaveValidators.
-func (mr *MockTransactionMockRecorder) SaveValidators(ctx any, validators ...any) *TransactionSaveValidatorsCall {
+// UpdateProposals indicates an expected call of UpdateProposals.
+func (mr *MockTransactionMockRecorder) UpdateProposals(ctx any, proposals ...any) *TransactionUpdateProposalsCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, validators...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveValidators", reflect.TypeOf((*MockTransaction)(nil).SaveValidators), varargs...)
-	return &TransactionSaveValidatorsCall{Call: call}
+	varargs := append([]any{ctx}, proposals...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProposals", reflect.TypeOf((*MockTransaction)(nil).UpdateProposals), varargs...)
+	return &TransactionUpdateProposalsCall{Call: call}
 }
 
-// TransactionSaveValidatorsCall wrap *gomock.Call
-type TransactionSaveValidatorsCall struct {
+// TransactionUpdateProposalsCall wrap *gomock.Call
+type TransactionUpdateProposalsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionSaveValidatorsCall) Return(arg0 error) *TransactionSaveValidatorsCall {
+func (c *TransactionUpdateProposalsCall) Return(arg0 error) *TransactionUpdateProposalsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionSaveValidatorsCall) Do(f func(context.Context, ...*storage.Validator) error) *TransactionSaveValidatorsCall {
+func (c *TransactionUpdateProposalsCall) Do(f func(context.Context, ...*storage.Proposal) error) *TransactionUpdateProposalsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionSaveValidatorsCall) DoAndReturn(f func(context.Context, ...*storage.Validator) error) *TransactionSaveValidatorsCall {
+func (c *TransactionUpdateProposalsCall) DoAndReturn(f func(context.Context, ...*storage.Proposal) error) *TransactionUpdateProposalsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// State mocks base method.
-func (m *MockTransaction) State(ctx context.Context, name string) (storage.State, error) {
+// UpdateValidators mocks base method.
+func (m *MockTransaction) UpdateValidators(ctx context.Context, validators ...*storage.Validator) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "State", ctx, name)
-	ret0, _ := ret[0].(storage.State)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{ctx}
+	for _, a := range validators {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateValidators", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// State indicates an expected call of State.
-func (mr *MockTransactionMockRecorder) State(ctx, name any) *TransactionStateCall {
+// UpdateValidators indicates an expected call of UpdateValidators.
+func (mr *MockTransactionMockRecorder) UpdateValidators(ctx any, validators ...any) *TransactionUpdateValidatorsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "State", reflect.TypeOf((*MockTransaction)(nil).State), ctx, name)
-	return &TransactionStateCall{Call: call}
+	varargs := append([]any{ctx}, validators...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateValidators", reflect.TypeOf((*MockTransaction)(nil).UpdateValidators), varargs...)
+	return &TransactionUpdateValidatorsCall{Call: call}
 }
 
-// TransactionStateCall wrap *gomock.Call
-type TransactionStateCall struct {
+// TransactionUpdateValidatorsCall wrap *gomock.Call
+type TransactionUpdateValidatorsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionStateCall) Return(state storage.State, err error) *TransactionStateCall {
-	c.Call = c.Call.Return(state, err)
+func (c *TransactionUpdateValidatorsCall) Return(arg0 error) *TransactionUpdateValidatorsCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionStateCall) Do(f func(context.Context, string) (storage.State, error)) *TransactionStateCall {
+func (c *TransactionUpdateValidatorsCall) Do(f func(context.Context, ...*storage.Validator) error) *TransactionUpdateValidatorsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionStateCall) DoAndReturn(f func(context.Context, string) (storage.State, error)) *TransactionStateCall {
+func (c *TransactionUpdateValidatorsCall) DoAndReturn(f func(context.Context, ...*storage.Validator) error) *TransactionUpdateValidatorsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Tx mocks base method.
-func (m *MockTransaction) Tx() *bun.Tx {
+// UseFeeAllowance mocks base method.
+func (m *MockTransaction) UseFeeAllowance(ctx context.Context, usage storage.FeeAllowance) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Tx")
-	ret0, _ := ret[0].(*bun.Tx)
+	ret := m.ctrl.Call(m, "UseFeeAllowance", ctx, usage)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Tx indicates an expected call of Tx.
-func (mr *MockTransactionMockRecorder) Tx() *TransactionTxCall {
+// UseFeeAllowance indicates an expected call of UseFeeAllowance.
+func (mr *MockTransactionMockRecorder) UseFeeAllowance(ctx, usage any) *TransactionUseFeeAllowanceCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tx", reflect.TypeOf((*MockTransaction)(nil).Tx))
-	return &TransactionTxCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseFeeAllowance", reflect.TypeOf((*MockTransaction)(nil).UseFeeAllowance), ctx, usage)
+	return &TransactionUseFeeAllowanceCall{Call: call}
 }
 
-// TransactionTxCall wrap *gomock.Call
-type TransactionTxCall struct {
+// TransactionUseFeeAllowanceCall wrap *gomock.Call
+type TransactionUseFeeAllowanceCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionTxCall) Return(arg0 *bun.Tx) *TransactionTxCall {
+func (c *TransactionUseFeeAllowanceCall) Return(arg0 error) *TransactionUseFeeAllowanceCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionTxCall) Do(f func() *bun.Tx) *TransactionTxCall {
+func (c *TransactionUseFeeAllowanceCall) Do(f func(context.Context, storage.FeeAllowance) error) *TransactionUseFeeAllowanceCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionTxCall) DoAndReturn(f func() *bun.Tx) *TransactionTxCall {
+func (c *TransactionUseFeeAllowanceCall) DoAndReturn(f func(context.Context, storage.FeeAllowance) error) *TransactionUseFeeAllowanceCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// Update mocks base method.
-func (m *MockTransaction) Update(ctx context.Context, model any) error {
+// ValidatorsByConsAddress mocks base method.
+func (m *MockTransaction) ValidatorsByConsAddress(ctx context.Context, addresses ...[]byte) ([]storage.Validator, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, model)
-	ret0, _ := ret[0].(error)
-	return ret0
+	varargs := []any{ctx}
+	for _, a := range addresses {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorsByConsAddress", varargs...)
+	ret0, _ := ret[0].([]storage.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Update indicates an expected call of Update.
-func (mr *MockTransactionMockRecorder) Update(ctx, model any) *TransactionUpdateCall {
+// ValidatorsByConsAddress indicates an expected call of ValidatorsByConsAddress.
+func (mr *MockTransactionMockRecorder) ValidatorsByConsAddress(ctx any, addresses ...any) *TransactionValidatorsByConsAddressCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransaction)(nil).Update), ctx, model)
-	return &TransactionUpdateCall{Call: call}
+	varargs := append([]any{ctx}, addresses...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorsByConsAddress", reflect.TypeOf((*MockTransaction)(nil).ValidatorsByConsAddress), varargs...)
+	return &TransactionValidatorsByConsAddressCall{Call: call}
 }
 
-// TransactionUpdateCall wrap *gomock.Call
-type TransactionUpdateCall struct {
+// TransactionValidatorsByConsAddressCall wrap *gomock.Call
+type TransactionValidatorsByConsAddressCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *TransactionUpdateCall) Return(arg0 error) *TransactionUpdateCall {
-	c.Call = c.Call.Return(arg0)
+func (c *TransactionValidatorsByConsAddressCall) Return(arg0 []storage.Validator, arg1 error) *TransactionValidatorsByConsAddressCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *TransactionUpdateCall) Do(f func(context.Context, any) error) *TransactionUpdateCall {
+func (c *TransactionValidatorsByConsAddressCall) Do(f func(context.Context, ...[]byte) ([]storage.Validator, error)) *TransactionValidatorsByConsAddressCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *TransactionUpdateCall) DoAndReturn(f func(context.Context, any) error) *TransactionUpdateCall {
+func (c *TransactionValidatorsByConsAddressCall) DoAndReturn(f func(context.Context, ...[]byte) ([]storage.Validator, error)) *TransactionValidatorsByConsAddressCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,389 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: proposal.go
+//
+// Generated by this command:
+//
+//	mockgen -source=proposal.go -destination=mock/proposal.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/dipdup-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIProposal is a mock of IProposal interface.
+type MockIProposal struct {
+	ctrl     *gomock.Controller
+	recorder *MockIProposalMockRecorder
+}
+
+// MockIProposalMockRecorder is the mock recorder for MockIProposal.
+type MockIProposalMockRecorder struct {
+	mock *MockIProposal
+}
+
+// NewMockIProposal creates a new mock instance.
+func NewMockIProposal(ctrl *gomock.Controller) *MockIProposal {
+	mock := &MockIProposal{ctrl: ctrl}
+	mock.recorder = &MockIProposalMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIProposal) EXPECT() *MockIProposalMockRecorder {
+	return m.recorder
+}
+
+// ById mocks base method.
+func (m *MockIProposal) ById(ctx context.Context, id uint64) (storage.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ById", ctx, id)
+	ret0, _ := ret[0].(storage.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ById indicates an expected call of ById.
+func (mr *MockIProposalMockRecorder) ById(ctx, id any) *IProposalByIdCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ById", reflect.TypeOf((*MockIProposal)(nil).ById), ctx, id)
+	return &IProposalByIdCall{Call: call}
+}
+
+// IProposalByIdCall wrap *gomock.Call
+type IProposalByIdCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IProposalByIdCall) Return(arg0 storage.Proposal, arg1 error) *IProposalByIdCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IProposalByIdCall) Do(f func(context.Context, uint64) (storage.Proposal, error)) *IProposalByIdCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IProposalByIdCall) DoAndReturn(f func(context.Context, uint64) (storage.Proposal, error)) *IProposalByIdCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIProposal) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIProposalMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IProposalCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIProposal)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IProposalCursorListCall{Call: call}
+}
+
+// IProposalCursorListCall wrap *gomock.Call
+type IProposalCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IProposalCursorListCall) Return(arg0 []*storage.Proposal, arg1 error) *IProposalCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IProposalCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Proposal, error)) *IProposalCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IProposalCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Proposal, error)) *IProposalCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Filter mocks base method.
+func (m *MockIProposal) Filter(ctx context.Context, fltrs storage.ProposalFilter) ([]storage.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, fltrs)
+	ret0, _ := ret[0].([]storage.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockIProposalMockRecorder) Filter(ctx, fltrs any) *IProposalFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockIProposal)(nil).Filter), ctx, fltrs)
+	return &IProposalFilterCall{Call: call}
+}
+
+// IProposalFilterCall wrap *gomock.Call
+type IProposalFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IProposalFilterCall) Return(arg0 []storage.Proposal, arg1 error) *IProposalFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IProposalFilterCall) Do(f func(context.Context, storage.ProposalFilter) ([]storage.Proposal, error)) *IProposalFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IProposalFilterCall) DoAndReturn(f func(context.Context, storage.ProposalFilter) ([]storage.Proposal, error)) *IProposalFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIProposal) GetByID(ctx context.Context, id uint64) (*storage.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIProposalMockRecorder) GetByID(ctx, id any) *IProposalGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIProposal)(nil).GetByID), ctx, id)
+	return &IProposalGetByIDCall{Call: call}
+}
+
+// IProposalGetByIDCall wrap *gomock.Call
+type IProposalGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IProposalGetByIDCall) Return(arg0 *storage.Proposal, arg1 error) *IProposalGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IProposalGetByIDCall) Do(f func(context.Context, uint64) (*storage.Proposal, error)) *IProposalGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IProposalGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Proposal, error)) *IProposalGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIProposal) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIProposalMockRecorder) IsNoRows(err any) *IProposalIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIProposal)(nil).IsNoRows), err)
+	return &IProposalIsNoRowsCall{Call: call}
+}
+
+// IProposalIsNoRowsCall wrap *gomock.Call
+type IProposalIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IProposalIsNoRowsCall) Return(arg0 bool) *IProposalIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IProposalIsNoRowsCall) Do(f func(error) bool) *IProposalIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IProposalIsNoRowsCall) DoAndReturn(f func(error) bool) *IProposalIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIProposal) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIProposalMockRecorder) LastID(ctx any) *IProposalLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIProposal)(nil).LastID), ctx)
+	return &IProposalLastIDCall{Call: call}
+}
+
+// IProposalLastIDCall wrap *gomock.Call
+type IProposalLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IProposalLastIDCall) Return(arg0 uint64, arg1 error) *IProposalLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IProposalLastIDCall) Do(f func(context.Context) (uint64, error)) *IProposalLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IProposalLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IProposalLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIProposal) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Proposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIProposalMockRecorder) List(ctx, limit, offset, order any) *IProposalListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIProposal)(nil).List), ctx, limit, offset, order)
+	return &IProposalListCall{Call: call}
+}
+
+// IProposalListCall wrap *gomock.Call
+type IProposalListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IProposalListCall) Return(arg0 []*storage.Proposal, arg1 error) *IProposalListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IProposalListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Proposal, error)) *IProposalListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IProposalListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Proposal, error)) *IProposalListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIProposal) Save(ctx context.Context, m *storage.Proposal) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIProposalMockRecorder) Save(ctx, m any) *IProposalSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIProposal)(nil).Save), ctx, m)
+	return &IProposalSaveCall{Call: call}
+}
+
+// IProposalSaveCall wrap *gomock.Call
+type IProposalSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IProposalSaveCall) Return(arg0 error) *IProposalSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IProposalSaveCall) Do(f func(context.Context, *storage.Proposal) error) *IProposalSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IProposalSaveCall) DoAndReturn(f func(context.Context, *storage.Proposal) error) *IProposalSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIProposal) Update(ctx context.Context, m *storage.Proposal) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIProposalMockRecorder) Update(ctx, m any) *IProposalUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIProposal)(nil).Update), ctx, m)
+	return &IProposalUpdateCall{Call: call}
+}
+
+// IProposalUpdateCall wrap *gomock.Call
+type IProposalUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IProposalUpdateCall) Return(arg0 error) *IProposalUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IProposalUpdateCall) Do(f func(context.Context, *storage.Proposal) error) *IProposalUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IProposalUpdateCall) DoAndReturn(f func(context.Context, *storage.Proposal) error) *IProposalUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -311,6 +311,45 @@ func (c *IStateSaveCall) DoAndReturn(f func(context.Context, *storage.State) err
 	return c
 }
 
+// Totals mocks base method.
+func (m *MockIState) Totals(ctx context.Context) (storage.Totals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Totals", ctx)
+	ret0, _ := ret[0].(storage.Totals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Totals indicates an expected call of Totals.
+func (mr *MockIStateMockRecorder) Totals(ctx any) *IStateTotalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Totals", reflect.TypeOf((*MockIState)(nil).Totals), ctx)
+	return &IStateTotalsCall{Call: call}
+}
+
+// IStateTotalsCall wrap *gomock.Call
+type IStateTotalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IStateTotalsCall) Return(arg0 storage.Totals, arg1 error) *IStateTotalsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IStateTotalsCall) Do(f func(context.Context) (storage.Totals, error)) *IStateTotalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IStateTotalsCall) DoAndReturn(f func(context.Context) (storage.Totals, error)) *IStateTotalsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Update mocks base method.
 func (m_2 *MockIState) Update(ctx context.Context, m *storage.State) error {
 	m_2.ctrl.T.Helper()
@@ -0,0 +1,350 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: unbonding.go
+//
+// Generated by this command:
+//
+//	mockgen -source=unbonding.go -destination=mock/unbonding.go -package=mock -typed
+//
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storage "github.com/dipdup-io/celestia-indexer/internal/storage"
+	storage0 "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIUnbonding is a mock of IUnbonding interface.
+type MockIUnbonding struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUnbondingMockRecorder
+}
+
+// MockIUnbondingMockRecorder is the mock recorder for MockIUnbonding.
+type MockIUnbondingMockRecorder struct {
+	mock *MockIUnbonding
+}
+
+// NewMockIUnbonding creates a new mock instance.
+func NewMockIUnbonding(ctrl *gomock.Controller) *MockIUnbonding {
+	mock := &MockIUnbonding{ctrl: ctrl}
+	mock.recorder = &MockIUnbondingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUnbonding) EXPECT() *MockIUnbondingMockRecorder {
+	return m.recorder
+}
+
+// ByAddress mocks base method.
+func (m *MockIUnbonding) ByAddress(ctx context.Context, addressId uint64, limit, offset int) ([]storage.Unbonding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ByAddress", ctx, addressId, limit, offset)
+	ret0, _ := ret[0].([]storage.Unbonding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ByAddress indicates an expected call of ByAddress.
+func (mr *MockIUnbondingMockRecorder) ByAddress(ctx, addressId, limit, offset any) *IUnbondingByAddressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ByAddress", reflect.TypeOf((*MockIUnbonding)(nil).ByAddress), ctx, addressId, limit, offset)
+	return &IUnbondingByAddressCall{Call: call}
+}
+
+// IUnbondingByAddressCall wrap *gomock.Call
+type IUnbondingByAddressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUnbondingByAddressCall) Return(arg0 []storage.Unbonding, arg1 error) *IUnbondingByAddressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUnbondingByAddressCall) Do(f func(context.Context, uint64, int, int) ([]storage.Unbonding, error)) *IUnbondingByAddressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUnbondingByAddressCall) DoAndReturn(f func(context.Context, uint64, int, int) ([]storage.Unbonding, error)) *IUnbondingByAddressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CursorList mocks base method.
+func (m *MockIUnbonding) CursorList(ctx context.Context, id, limit uint64, order storage0.SortOrder, cmp storage0.Comparator) ([]*storage.Unbonding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CursorList", ctx, id, limit, order, cmp)
+	ret0, _ := ret[0].([]*storage.Unbonding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CursorList indicates an expected call of CursorList.
+func (mr *MockIUnbondingMockRecorder) CursorList(ctx, id, limit, order, cmp any) *IUnbondingCursorListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CursorList", reflect.TypeOf((*MockIUnbonding)(nil).CursorList), ctx, id, limit, order, cmp)
+	return &IUnbondingCursorListCall{Call: call}
+}
+
+// IUnbondingCursorListCall wrap *gomock.Call
+type IUnbondingCursorListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUnbondingCursorListCall) Return(arg0 []*storage.Unbonding, arg1 error) *IUnbondingCursorListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUnbondingCursorListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Unbonding, error)) *IUnbondingCursorListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUnbondingCursorListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder, storage0.Comparator) ([]*storage.Unbonding, error)) *IUnbondingCursorListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockIUnbonding) GetByID(ctx context.Context, id uint64) (*storage.Unbonding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*storage.Unbonding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIUnbondingMockRecorder) GetByID(ctx, id any) *IUnbondingGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIUnbonding)(nil).GetByID), ctx, id)
+	return &IUnbondingGetByIDCall{Call: call}
+}
+
+// IUnbondingGetByIDCall wrap *gomock.Call
+type IUnbondingGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUnbondingGetByIDCall) Return(arg0 *storage.Unbonding, arg1 error) *IUnbondingGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUnbondingGetByIDCall) Do(f func(context.Context, uint64) (*storage.Unbonding, error)) *IUnbondingGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUnbondingGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*storage.Unbonding, error)) *IUnbondingGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsNoRows mocks base method.
+func (m *MockIUnbonding) IsNoRows(err error) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsNoRows", err)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsNoRows indicates an expected call of IsNoRows.
+func (mr *MockIUnbondingMockRecorder) IsNoRows(err any) *IUnbondingIsNoRowsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsNoRows", reflect.TypeOf((*MockIUnbonding)(nil).IsNoRows), err)
+	return &IUnbondingIsNoRowsCall{Call: call}
+}
+
+// IUnbondingIsNoRowsCall wrap *gomock.Call
+type IUnbondingIsNoRowsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUnbondingIsNoRowsCall) Return(arg0 bool) *IUnbondingIsNoRowsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUnbondingIsNoRowsCall) Do(f func(error) bool) *IUnbondingIsNoRowsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUnbondingIsNoRowsCall) DoAndReturn(f func(error) bool) *IUnbondingIsNoRowsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LastID mocks base method.
+func (m *MockIUnbonding) LastID(ctx context.Context) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastID", ctx)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastID indicates an expected call of LastID.
+func (mr *MockIUnbondingMockRecorder) LastID(ctx any) *IUnbondingLastIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastID", reflect.TypeOf((*MockIUnbonding)(nil).LastID), ctx)
+	return &IUnbondingLastIDCall{Call: call}
+}
+
+// IUnbondingLastIDCall wrap *gomock.Call
+type IUnbondingLastIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUnbondingLastIDCall) Return(arg0 uint64, arg1 error) *IUnbondingLastIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUnbondingLastIDCall) Do(f func(context.Context) (uint64, error)) *IUnbondingLastIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUnbondingLastIDCall) DoAndReturn(f func(context.Context) (uint64, error)) *IUnbondingLastIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockIUnbonding) List(ctx context.Context, limit, offset uint64, order storage0.SortOrder) ([]*storage.Unbonding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset, order)
+	ret0, _ := ret[0].([]*storage.Unbonding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIUnbondingMockRecorder) List(ctx, limit, offset, order any) *IUnbondingListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIUnbonding)(nil).List), ctx, limit, offset, order)
+	return &IUnbondingListCall{Call: call}
+}
+
+// IUnbondingListCall wrap *gomock.Call
+type IUnbondingListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUnbondingListCall) Return(arg0 []*storage.Unbonding, arg1 error) *IUnbondingListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUnbondingListCall) Do(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Unbonding, error)) *IUnbondingListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUnbondingListCall) DoAndReturn(f func(context.Context, uint64, uint64, storage0.SortOrder) ([]*storage.Unbonding, error)) *IUnbondingListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Save mocks base method.
+func (m_2 *MockIUnbonding) Save(ctx context.Context, m *storage.Unbonding) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Save", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIUnbondingMockRecorder) Save(ctx, m any) *IUnbondingSaveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIUnbonding)(nil).Save), ctx, m)
+	return &IUnbondingSaveCall{Call: call}
+}
+
+// IUnbondingSaveCall wrap *gomock.Call
+type IUnbondingSaveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUnbondingSaveCall) Return(arg0 error) *IUnbondingSaveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUnbondingSaveCall) Do(f func(context.Context, *storage.Unbonding) error) *IUnbondingSaveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUnbondingSaveCall) DoAndReturn(f func(context.Context, *storage.Unbonding) error) *IUnbondingSaveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Update mocks base method.
+func (m_2 *MockIUnbonding) Update(ctx context.Context, m *storage.Unbonding) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Update", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIUnbondingMockRecorder) Update(ctx, m any) *IUnbondingUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIUnbonding)(nil).Update), ctx, m)
+	return &IUnbondingUpdateCall{Call: call}
+}
+
+// IUnbondingUpdateCall wrap *gomock.Call
+type IUnbondingUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IUnbondingUpdateCall) Return(arg0 error) *IUnbondingUpdateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IUnbondingUpdateCall) Do(f func(context.Context, *storage.Unbonding) error) *IUnbondingUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IUnbondingUpdateCall) DoAndReturn(f func(context.Context, *storage.Unbonding) error) *IUnbondingUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
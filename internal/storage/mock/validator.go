@@ -118,6 +118,45 @@ func (c *IValidatorCursorListCall) DoAndReturn(f func(context.Context, uint64, u
 	return c
 }
 
+// Filter mocks base method.
+func (m *MockIValidator) Filter(ctx context.Context, fltrs storage.ValidatorFilter) ([]storage.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, fltrs)
+	ret0, _ := ret[0].([]storage.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockIValidatorMockRecorder) Filter(ctx, fltrs any) *IValidatorFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockIValidator)(nil).Filter), ctx, fltrs)
+	return &IValidatorFilterCall{Call: call}
+}
+
+// IValidatorFilterCall wrap *gomock.Call
+type IValidatorFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorFilterCall) Return(arg0 []storage.Validator, arg1 error) *IValidatorFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorFilterCall) Do(f func(context.Context, storage.ValidatorFilter) ([]storage.Validator, error)) *IValidatorFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorFilterCall) DoAndReturn(f func(context.Context, storage.ValidatorFilter) ([]storage.Validator, error)) *IValidatorFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetByID mocks base method.
 func (m *MockIValidator) GetByID(ctx context.Context, id uint64) (*storage.Validator, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +196,45 @@ func (c *IValidatorGetByIDCall) DoAndReturn(f func(context.Context, uint64) (*st
 	return c
 }
 
+// History mocks base method.
+func (m *MockIValidator) History(ctx context.Context, validatorId uint64, limit, offset int) ([]storage.ValidatorHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, validatorId, limit, offset)
+	ret0, _ := ret[0].([]storage.ValidatorHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockIValidatorMockRecorder) History(ctx, validatorId, limit, offset any) *IValidatorHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockIValidator)(nil).History), ctx, validatorId, limit, offset)
+	return &IValidatorHistoryCall{Call: call}
+}
+
+// IValidatorHistoryCall wrap *gomock.Call
+type IValidatorHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorHistoryCall) Return(arg0 []storage.ValidatorHistory, arg1 error) *IValidatorHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorHistoryCall) Do(f func(context.Context, uint64, int, int) ([]storage.ValidatorHistory, error)) *IValidatorHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorHistoryCall) DoAndReturn(f func(context.Context, uint64, int, int) ([]storage.ValidatorHistory, error)) *IValidatorHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IsNoRows mocks base method.
 func (m *MockIValidator) IsNoRows(err error) bool {
 	m.ctrl.T.Helper()
@@ -273,6 +351,45 @@ func (c *IValidatorListCall) DoAndReturn(f func(context.Context, uint64, uint64,
 	return c
 }
 
+// Messages mocks base method.
+func (m *MockIValidator) Messages(ctx context.Context, address string, limit, offset int) ([]storage.ValidatorMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Messages", ctx, address, limit, offset)
+	ret0, _ := ret[0].([]storage.ValidatorMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Messages indicates an expected call of Messages.
+func (mr *MockIValidatorMockRecorder) Messages(ctx, address, limit, offset any) *IValidatorMessagesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Messages", reflect.TypeOf((*MockIValidator)(nil).Messages), ctx, address, limit, offset)
+	return &IValidatorMessagesCall{Call: call}
+}
+
+// IValidatorMessagesCall wrap *gomock.Call
+type IValidatorMessagesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *IValidatorMessagesCall) Return(arg0 []storage.ValidatorMessage, arg1 error) *IValidatorMessagesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *IValidatorMessagesCall) Do(f func(context.Context, string, int, int) ([]storage.ValidatorMessage, error)) *IValidatorMessagesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *IValidatorMessagesCall) DoAndReturn(f func(context.Context, string, int, int) ([]storage.ValidatorMessage, error)) *IValidatorMessagesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Save mocks base method.
 func (m_2 *MockIValidator) Save(ctx context.Context, m *storage.Validator) error {
 	m_2.ctrl.T.Helper()
@@ -27,7 +27,8 @@ func NewAddress(db *database.Bun) *Address {
 func (a *Address) ByHash(ctx context.Context, hash []byte) (address storage.Address, err error) {
 	err = a.DB().NewSelect().Model(&address).
 		Where("hash = ?", hash).
-		Relation("Balance").
+		Relation("Balances").
+		Relation("Balances.Metadata").
 		Scan(ctx)
 	return
 }
@@ -35,7 +36,8 @@ func (a *Address) ByHash(ctx context.Context, hash []byte) (address storage.Addr
 func (a *Address) ListWithBalance(ctx context.Context, fltrs storage.AddressListFilter) (result []storage.Address, err error) {
 	query := a.DB().NewSelect().Model(&result).
 		Offset(fltrs.Offset).
-		Relation("Balance")
+		Relation("Balances").
+		Relation("Balances.Metadata")
 
 	query = addressListFilter(query, fltrs)
 
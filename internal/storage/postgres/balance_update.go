@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// BalanceUpdate -
+type BalanceUpdate struct {
+	*postgres.Table[*storage.BalanceUpdate]
+}
+
+// NewBalanceUpdate -
+func NewBalanceUpdate(db *database.Bun) *BalanceUpdate {
+	return &BalanceUpdate{
+		Table: postgres.NewTable[*storage.BalanceUpdate](db),
+	}
+}
+
+// ByAddress -
+func (bu *BalanceUpdate) ByAddress(ctx context.Context, addressId uint64, fltrs storage.BalanceUpdateFilter) (updates []storage.BalanceUpdate, err error) {
+	query := bu.DB().NewSelect().Model(&updates).
+		Where("address_id = ?", addressId).
+		Offset(fltrs.Offset)
+
+	if fltrs.Currency != "" {
+		query = query.Where("currency = ?", fltrs.Currency)
+	}
+
+	query = limitScope(query, fltrs.Limit)
+	query = sortScope(query, "id", fltrs.Sort)
+
+	err = query.Scan(ctx)
+	return
+}
+
+// BalancesAt - returns address balances at the end of block with passed height. It's current balances without updates made after the height.
+func (bu *BalanceUpdate) BalancesAt(ctx context.Context, addressId uint64, height pkgTypes.Level) (balances []storage.Balance, err error) {
+	later := bu.DB().NewSelect().
+		Model((*storage.BalanceUpdate)(nil)).
+		ColumnExpr("COALESCE(SUM(balance_update.delta), 0)").
+		Where("balance_update.address_id = balance.id").
+		Where("balance_update.currency = balance.currency").
+		Where("balance_update.height > ?", height)
+
+	err = bu.DB().NewSelect().Model(&balances).
+		ColumnExpr("balance.id, balance.currency").
+		ColumnExpr("balance.total - (?) AS total", later).
+		Where("balance.id = ?", addressId).
+		Relation("Metadata").
+		Scan(ctx)
+	return
+}
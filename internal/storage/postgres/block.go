@@ -110,6 +110,12 @@ func (b *Blocks) Last(ctx context.Context) (block storage.Block, err error) {
 	return
 }
 
+// First - returns the first indexed block
+func (b *Blocks) First(ctx context.Context) (block storage.Block, err error) {
+	err = b.DB().NewSelect().Model(&block).Order("id asc").Limit(1).Scan(ctx)
+	return
+}
+
 // ByHash -
 func (b *Blocks) ByHash(ctx context.Context, hash []byte) (block storage.Block, err error) {
 	err = b.DB().NewSelect().
@@ -121,6 +127,15 @@ func (b *Blocks) ByHash(ctx context.Context, hash []byte) (block storage.Block,
 	return
 }
 
+// ProposedCount - returns count of blocks proposed by validator with the consensus address
+func (b *Blocks) ProposedCount(ctx context.Context, proposerAddress []byte) (count int64, err error) {
+	count64, err := b.DB().NewSelect().
+		Model((*storage.Block)(nil)).
+		Where("proposer_address = ?", proposerAddress).
+		Count(ctx)
+	return int64(count64), err
+}
+
 type listTypeCount struct {
 	Height types.Level          `bun:"height"`
 	Type   storageTypes.MsgType `bun:"type"`
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// BlockSignature -
+type BlockSignature struct {
+	*postgres.Table[*storage.BlockSignature]
+}
+
+// NewBlockSignature -
+func NewBlockSignature(db *database.Bun) *BlockSignature {
+	return &BlockSignature{
+		Table: postgres.NewTable[*storage.BlockSignature](db),
+	}
+}
+
+// LevelsByValidator - returns heights of blocks signed by validator starting from `startHeight`. The latest blocks go first.
+func (bs *BlockSignature) LevelsByValidator(ctx context.Context, validatorId uint64, startHeight pkgTypes.Level) (levels []pkgTypes.Level, err error) {
+	err = bs.DB().NewSelect().
+		Model((*storage.BlockSignature)(nil)).
+		Column("height").
+		Where("validator_id = ?", validatorId).
+		Where("height >= ?", startHeight).
+		Order("height desc").
+		Scan(ctx, &levels)
+	return
+}
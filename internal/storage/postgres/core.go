@@ -24,16 +24,28 @@ type Storage struct {
 
 	Blocks        models.IBlock
 	BlockStats    models.IBlockStats
+	Signatures    models.IBlockSignature
+	Evidence      models.IEvidence
 	Constants     models.IConstant
 	DenomMetadata models.IDenomMetadata
 	Tx            models.ITx
 	Message       models.IMessage
 	Event         models.IEvent
 	Address       models.IAddress
+	BalanceUpdate models.IBalanceUpdate
 	Namespace     models.INamespace
 	State         models.IState
 	Stats         models.IStats
 	Validator     models.IValidator
+	Delegation    models.IDelegation
+	Unbonding     models.IUnbonding
+	Redelegation  models.IRedelegation
+	Proposal      models.IProposal
+	Vote          models.IVote
+	Deposit       models.IDeposit
+	Grant         models.IGrant
+	FeeAllowance  models.IFeeAllowance
+	IbcTransfer   models.IIbcTransfer
 	Notificator   *Notificator
 }
 
@@ -49,16 +61,28 @@ func Create(ctx context.Context, cfg config.Database) (Storage, error) {
 		Storage:       strg,
 		Blocks:        NewBlocks(strg.Connection()),
 		BlockStats:    NewBlockStats(strg.Connection()),
+		Signatures:    NewBlockSignature(strg.Connection()),
+		Evidence:      NewEvidence(strg.Connection()),
 		Constants:     NewConstant(strg.Connection()),
 		DenomMetadata: NewDenomMetadata(strg.Connection()),
 		Message:       NewMessage(strg.Connection()),
 		Event:         NewEvent(strg.Connection()),
 		Address:       NewAddress(strg.Connection()),
+		BalanceUpdate: NewBalanceUpdate(strg.Connection()),
 		Tx:            NewTx(strg.Connection()),
 		State:         NewState(strg.Connection()),
 		Namespace:     NewNamespace(strg.Connection()),
 		Stats:         NewStats(strg.Connection()),
 		Validator:     NewValidator(strg.Connection()),
+		Delegation:    NewDelegation(strg.Connection()),
+		Unbonding:     NewUnbonding(strg.Connection()),
+		Redelegation:  NewRedelegation(strg.Connection()),
+		Proposal:      NewProposal(strg.Connection()),
+		Vote:          NewVote(strg.Connection()),
+		Deposit:       NewDeposit(strg.Connection()),
+		Grant:         NewGrant(strg.Connection()),
+		FeeAllowance:  NewFeeAllowance(strg.Connection()),
+		IbcTransfer:   NewIbcTransfer(strg.Connection()),
 		Notificator:   NewNotificator(cfg, strg.Connection().DB()),
 	}
 
@@ -75,6 +99,7 @@ func initDatabase(ctx context.Context, conn *database.Bun) error {
 		(*models.NamespaceMessage)(nil),
 		(*models.Signer)(nil),
 		(*models.MsgAddress)(nil),
+		(*models.ValidatorMessage)(nil),
 	)
 
 	if err := database.CreateTables(ctx, conn, models.Models...); err != nil {
@@ -110,9 +135,18 @@ func createHypertables(ctx context.Context, conn *database.Bun) error {
 		for _, model := range []storage.Model{
 			&models.Block{},
 			&models.BlockStats{},
+			&models.BlockSignature{},
+			&models.Evidence{},
 			&models.Tx{},
 			&models.Message{},
 			&models.Event{},
+			&models.BalanceUpdate{},
+			&models.DelegationLog{},
+			&models.Unbonding{},
+			&models.Redelegation{},
+			&models.ValidatorHistory{},
+			&models.Vote{},
+			&models.Deposit{},
 		} {
 			if _, err := tx.ExecContext(ctx,
 				`SELECT create_hypertable(?, 'time', chunk_time_interval => INTERVAL '1 month', if_not_exists => TRUE);`,
@@ -74,6 +74,96 @@ func createTypes(ctx context.Context, conn *database.Bun) error {
 		); err != nil {
 			return err
 		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"validator_status",
+			bun.Safe("validator_status"),
+			bun.In(types.ValidatorStatusValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"validator_history_type",
+			bun.Safe("validator_history_type"),
+			bun.In(types.ValidatorHistoryTypeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"evidence_type",
+			bun.Safe("evidence_type"),
+			bun.In(types.EvidenceTypeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"proposal_type",
+			bun.Safe("proposal_type"),
+			bun.In(types.ProposalTypeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"proposal_status",
+			bun.Safe("proposal_status"),
+			bun.In(types.ProposalStatusValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"vote_option",
+			bun.Safe("vote_option"),
+			bun.In(types.VoteOptionValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"authorization_type",
+			bun.Safe("authorization_type"),
+			bun.In(types.AuthorizationTypeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"fee_allowance_type",
+			bun.Safe("fee_allowance_type"),
+			bun.In(types.FeeAllowanceTypeValues()),
+		); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(
+			ctx,
+			createTypeQuery,
+			"ibc_transfer_status",
+			bun.Safe("ibc_transfer_status"),
+			bun.In(types.IbcTransferStatusValues()),
+		); err != nil {
+			return err
+		}
 		return nil
 	})
 }
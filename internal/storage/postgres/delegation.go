@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Delegation -
+type Delegation struct {
+	*postgres.Table[*storage.Delegation]
+}
+
+// NewDelegation -
+func NewDelegation(db *database.Bun) *Delegation {
+	return &Delegation{
+		Table: postgres.NewTable[*storage.Delegation](db),
+	}
+}
+
+// ByAddress - returns active delegations of the address
+func (d *Delegation) ByAddress(ctx context.Context, addressId uint64, limit, offset int) (delegations []storage.Delegation, err error) {
+	query := d.DB().NewSelect().Model(&delegations).
+		Where("address_id = ?", addressId).
+		Where("amount > 0").
+		Offset(offset).
+		Order("amount desc")
+
+	query = limitScope(query, limit)
+	err = query.Scan(ctx)
+	return
+}
+
+// ByValidator - returns active delegators of the validator
+func (d *Delegation) ByValidator(ctx context.Context, validator string, limit, offset int) (delegations []storage.Delegation, err error) {
+	query := d.DB().NewSelect().Model(&delegations).
+		Where("delegation.validator = ?", validator).
+		Where("delegation.amount > 0").
+		Offset(offset).
+		Relation("Address").
+		Order("delegation.amount desc")
+
+	query = limitScope(query, limit)
+	err = query.Scan(ctx)
+	return
+}
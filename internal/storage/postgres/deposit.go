@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Deposit -
+type Deposit struct {
+	*postgres.Table[*storage.Deposit]
+}
+
+// NewDeposit -
+func NewDeposit(db *database.Bun) *Deposit {
+	return &Deposit{
+		Table: postgres.NewTable[*storage.Deposit](db),
+	}
+}
+
+// ByProposal - returns deposits to the proposal with depositors. The latest deposits are first.
+func (d *Deposit) ByProposal(ctx context.Context, proposalId uint64, limit, offset int) (deposits []storage.Deposit, err error) {
+	query := d.DB().NewSelect().Model(&deposits).
+		Where("deposit.proposal_id = ?", proposalId).
+		Relation("Depositor").
+		Order("deposit.id desc")
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/database"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Evidence -
+type Evidence struct {
+	*postgres.Table[*storage.Evidence]
+}
+
+// NewEvidence -
+func NewEvidence(db *database.Bun) *Evidence {
+	return &Evidence{
+		Table: postgres.NewTable[*storage.Evidence](db),
+	}
+}
+
+// ByHeight - returns evidence included into the block
+func (e *Evidence) ByHeight(ctx context.Context, height pkgTypes.Level) (evidence []storage.Evidence, err error) {
+	err = e.DB().NewSelect().Model(&evidence).
+		Where("evidence.height = ?", height).
+		Relation("Validator").
+		Order("evidence.id asc").
+		Scan(ctx)
+	return
+}
+
+// ListWithValidators - returns evidence with accused validators
+func (e *Evidence) ListWithValidators(ctx context.Context, limit, offset uint64, order sdk.SortOrder) (evidence []storage.Evidence, err error) {
+	query := e.DB().NewSelect().Model(&evidence).
+		Offset(int(offset)).
+		Relation("Validator")
+	query = limitScope(query, int(limit))
+	query = sortScope(query, "evidence.id", order)
+	err = query.Scan(ctx)
+	return
+}
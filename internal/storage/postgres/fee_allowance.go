@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// FeeAllowance -
+type FeeAllowance struct {
+	*postgres.Table[*storage.FeeAllowance]
+}
+
+// NewFeeAllowance -
+func NewFeeAllowance(db *database.Bun) *FeeAllowance {
+	return &FeeAllowance{
+		Table: postgres.NewTable[*storage.FeeAllowance](db),
+	}
+}
+
+// ByAddress - returns active fee allowances where the address is granter or grantee. The latest ones are first.
+func (fa *FeeAllowance) ByAddress(ctx context.Context, addressId uint64, limit, offset int) (allowances []storage.FeeAllowance, err error) {
+	query := fa.DB().NewSelect().Model(&allowances).
+		Where("fee_allowance.end_height = 0").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("fee_allowance.granter_id = ?", addressId).
+				WhereOr("fee_allowance.grantee_id = ?", addressId)
+		}).
+		Relation("Granter").
+		Relation("Grantee").
+		Order("fee_allowance.id desc")
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
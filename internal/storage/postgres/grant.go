@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+	"github.com/uptrace/bun"
+)
+
+// Grant -
+type Grant struct {
+	*postgres.Table[*storage.Grant]
+}
+
+// NewGrant -
+func NewGrant(db *database.Bun) *Grant {
+	return &Grant{
+		Table: postgres.NewTable[*storage.Grant](db),
+	}
+}
+
+// ByAddress - returns active authz grants where the address is granter or grantee. The latest ones are first.
+func (g *Grant) ByAddress(ctx context.Context, addressId uint64, limit, offset int) (grants []storage.Grant, err error) {
+	query := g.DB().NewSelect().Model(&grants).
+		Where("grant.end_height = 0").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("grant.granter_id = ?", addressId).
+				WhereOr("grant.grantee_id = ?", addressId)
+		}).
+		Relation("Granter").
+		Relation("Grantee").
+		Order("grant.id desc")
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
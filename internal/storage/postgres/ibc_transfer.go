@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// IbcTransfer -
+type IbcTransfer struct {
+	*postgres.Table[*storage.IbcTransfer]
+}
+
+// NewIbcTransfer -
+func NewIbcTransfer(db *database.Bun) *IbcTransfer {
+	return &IbcTransfer{
+		Table: postgres.NewTable[*storage.IbcTransfer](db),
+	}
+}
+
+// Filter - returns IBC transfers filtered by address of sender or receiver, celestia side channel and status
+func (t *IbcTransfer) Filter(ctx context.Context, fltrs storage.IbcTransferFilter) (transfers []storage.IbcTransfer, err error) {
+	query := t.DB().NewSelect().Model(&transfers).
+		Offset(fltrs.Offset)
+	query = ibcTransferListFilter(query, fltrs)
+	err = query.Scan(ctx)
+	return
+}
@@ -46,6 +46,15 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Block)(nil)).
+			Index("block_proposer_idx").
+			Column("proposer_address").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// BlockStats
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
@@ -57,6 +66,44 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// BlockSignature
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BlockSignature)(nil)).
+			Index("block_signature_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BlockSignature)(nil)).
+			Index("block_signature_validator_idx").
+			Column("validator_id", "height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Evidence
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Evidence)(nil)).
+			Index("evidence_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Evidence)(nil)).
+			Index("evidence_validator_idx").
+			Column("validator_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// Tx
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
@@ -112,6 +159,266 @@ func createIndices(ctx context.Context, conn *database.Bun) error {
 			return err
 		}
 
+		// BalanceUpdate
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceUpdate)(nil)).
+			Index("balance_update_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.BalanceUpdate)(nil)).
+			Index("balance_update_address_idx").
+			Column("address_id", "currency", "height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Delegation
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Delegation)(nil)).
+			Index("delegation_validator_idx").
+			Column("validator").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// DelegationLog
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.DelegationLog)(nil)).
+			Index("delegation_log_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Unbonding
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Unbonding)(nil)).
+			Index("unbonding_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Unbonding)(nil)).
+			Index("unbonding_address_idx").
+			Column("address_id", "validator", "height").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Redelegation
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Redelegation)(nil)).
+			Index("redelegation_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Redelegation)(nil)).
+			Index("redelegation_address_idx").
+			Column("address_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Validator
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Validator)(nil)).
+			Index("validator_cons_address_idx").
+			Column("cons_address").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// ValidatorHistory
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ValidatorHistory)(nil)).
+			Index("validator_history_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.ValidatorHistory)(nil)).
+			Index("validator_history_validator_idx").
+			Column("validator_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Proposal
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Proposal)(nil)).
+			Index("proposal_status_idx").
+			Column("status").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Vote
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Vote)(nil)).
+			Index("vote_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Vote)(nil)).
+			Index("vote_proposal_idx").
+			Column("proposal_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Vote)(nil)).
+			Index("vote_voter_idx").
+			Column("voter_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Deposit
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Deposit)(nil)).
+			Index("deposit_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Deposit)(nil)).
+			Index("deposit_proposal_idx").
+			Column("proposal_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Grant
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Grant)(nil)).
+			Index("authz_grant_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Grant)(nil)).
+			Index("authz_grant_granter_idx").
+			Column("granter_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.Grant)(nil)).
+			Index("authz_grant_grantee_idx").
+			Column("grantee_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// FeeAllowance
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.FeeAllowance)(nil)).
+			Index("fee_allowance_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.FeeAllowance)(nil)).
+			Index("fee_allowance_granter_idx").
+			Column("granter_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.FeeAllowance)(nil)).
+			Index("fee_allowance_grantee_idx").
+			Column("grantee_id").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// IbcTransfer
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_height_idx").
+			Column("height").
+			Using("BRIN").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_resolved_height_idx").
+			Column("resolved_height").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_packet_idx").
+			Column("source_port", "source_channel", "sequence").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_sender_idx").
+			Column("sender").
+			Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := tx.NewCreateIndex().
+			IfNotExists().
+			Model((*storage.IbcTransfer)(nil)).
+			Index("ibc_transfer_receiver_idx").
+			Column("receiver").
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// Message
 		if _, err := tx.NewCreateIndex().
 			IfNotExists().
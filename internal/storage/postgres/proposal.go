@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Proposal -
+type Proposal struct {
+	*postgres.Table[*storage.Proposal]
+}
+
+// NewProposal -
+func NewProposal(db *database.Bun) *Proposal {
+	return &Proposal{
+		Table: postgres.NewTable[*storage.Proposal](db),
+	}
+}
+
+// ById - returns proposal with proposer
+func (p *Proposal) ById(ctx context.Context, id uint64) (proposal storage.Proposal, err error) {
+	err = p.DB().NewSelect().Model(&proposal).
+		Where("proposal.id = ?", id).
+		Relation("Proposer").
+		Scan(ctx)
+	return
+}
+
+// Filter - returns proposals with proposers filtered by status
+func (p *Proposal) Filter(ctx context.Context, fltrs storage.ProposalFilter) (proposals []storage.Proposal, err error) {
+	query := p.DB().NewSelect().Model(&proposals).
+		Offset(fltrs.Offset).
+		Relation("Proposer")
+	query = proposalListFilter(query, fltrs)
+	err = query.Scan(ctx)
+	return
+}
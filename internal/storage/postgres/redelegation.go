@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Redelegation -
+type Redelegation struct {
+	*postgres.Table[*storage.Redelegation]
+}
+
+// NewRedelegation -
+func NewRedelegation(db *database.Bun) *Redelegation {
+	return &Redelegation{
+		Table: postgres.NewTable[*storage.Redelegation](db),
+	}
+}
+
+// ByAddress - returns redelegations of the address. The latest entries go first.
+func (r *Redelegation) ByAddress(ctx context.Context, addressId uint64, limit, offset int) (entries []storage.Redelegation, err error) {
+	query := r.DB().NewSelect().Model(&entries).
+		Where("address_id = ?", addressId).
+		Offset(offset).
+		Order("id desc")
+
+	query = limitScope(query, limit)
+	err = query.Scan(ctx)
+	return
+}
@@ -78,3 +78,47 @@ func addressListFilter(query *bun.SelectQuery, fltrs storage.AddressListFilter)
 	query = sortScope(query, "id", fltrs.Sort)
 	return query
 }
+
+func validatorListFilter(query *bun.SelectQuery, fltrs storage.ValidatorFilter) *bun.SelectQuery {
+	query = limitScope(query, fltrs.Limit)
+	switch fltrs.SortField {
+	case "rate", "max_rate", "min_self_delegation", "height", "power":
+		query = sortScope(query, fltrs.SortField, fltrs.Sort)
+	}
+	query = sortScope(query, "id", fltrs.Sort)
+	return query
+}
+
+func proposalListFilter(query *bun.SelectQuery, fltrs storage.ProposalFilter) *bun.SelectQuery {
+	query = limitScope(query, fltrs.Limit)
+	query = sortScope(query, "proposal.id", fltrs.Sort)
+
+	if len(fltrs.Status) > 0 {
+		query = query.Where("proposal.status IN (?)", bun.In(fltrs.Status))
+	}
+	return query
+}
+
+func ibcTransferListFilter(query *bun.SelectQuery, fltrs storage.IbcTransferFilter) *bun.SelectQuery {
+	query = limitScope(query, fltrs.Limit)
+	query = sortScope(query, "ibc_transfer.id", fltrs.Sort)
+
+	if fltrs.Address != "" {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("ibc_transfer.sender = ?", fltrs.Address).
+				WhereOr("ibc_transfer.receiver = ?", fltrs.Address)
+		})
+	}
+	if fltrs.Channel != "" {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("ibc_transfer.incoming = false AND ibc_transfer.source_channel = ?", fltrs.Channel).
+				WhereOr("ibc_transfer.incoming = true AND ibc_transfer.destination_channel = ?", fltrs.Channel)
+		})
+	}
+	if len(fltrs.Status) > 0 {
+		query = query.Where("ibc_transfer.status IN (?)", bun.In(fltrs.Status))
+	}
+	return query
+}
@@ -28,3 +28,14 @@ func (s *State) ByName(ctx context.Context, name string) (state storage.State, e
 	err = s.DB().NewSelect().Model(&state).Where("name = ?", name).Scan(ctx)
 	return
 }
+
+// Totals - recomputes state totals from transactions, block stats and addresses tables
+func (s *State) Totals(ctx context.Context) (totals storage.Totals, err error) {
+	err = s.DB().NewSelect().
+		ColumnExpr("(SELECT count(*) FROM tx) AS tx_count").
+		ColumnExpr("(SELECT coalesce(sum(blobs_size), 0) FROM block_stats) AS blobs_size").
+		ColumnExpr("(SELECT coalesce(sum(fee), 0) FROM tx) AS fee").
+		ColumnExpr("(SELECT count(*) FROM address) AS accounts").
+		Scan(ctx, &totals)
+	return
+}
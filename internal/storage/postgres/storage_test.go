@@ -14,6 +14,7 @@ import (
 
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
 	"github.com/dipdup-net/go-lib/config"
 	"github.com/dipdup-net/go-lib/database"
 	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
@@ -119,6 +120,15 @@ func (s *StorageTestSuite) TestBlockLast() {
 	s.Require().Equal(hash, block.Hash.Bytes())
 }
 
+func (s *StorageTestSuite) TestBlockFirst() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	block, err := s.storage.Blocks.First(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(999, block.Height)
+}
+
 func (s *StorageTestSuite) TestBlockByHeight() {
 	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer ctxCancel()
@@ -265,6 +275,11 @@ func (s *StorageTestSuite) TestAddressByHash() {
 	s.Require().EqualValues(1, address.Id)
 	s.Require().EqualValues(100, address.Height)
 	s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", address.Address)
+	s.Require().Len(address.Balances, 1)
+	s.Require().Equal("123", address.Balances[0].Total.String())
+	s.Require().Equal("utia", address.Balances[0].Currency)
+	s.Require().NotNil(address.Balances[0].Metadata)
+	s.Require().Equal("TIA", address.Balances[0].Metadata.Symbol)
 }
 
 func (s *StorageTestSuite) TestAddressList() {
@@ -282,14 +297,106 @@ func (s *StorageTestSuite) TestAddressList() {
 	s.Require().EqualValues(1, addresses[0].Id)
 	s.Require().EqualValues(100, addresses[0].Height)
 	s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", addresses[0].Address)
-	s.Require().Equal("123", addresses[0].Balance.Total.String())
-	s.Require().Equal("utia", addresses[0].Balance.Currency)
+	s.Require().Len(addresses[0].Balances, 1)
+	s.Require().Equal("123", addresses[0].Balances[0].Total.String())
+	s.Require().Equal("utia", addresses[0].Balances[0].Currency)
 
 	s.Require().EqualValues(2, addresses[1].Id)
 	s.Require().EqualValues(101, addresses[1].Height)
 	s.Require().Equal("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", addresses[1].Address)
-	s.Require().Equal("321", addresses[1].Balance.Total.String())
-	s.Require().Equal("utia", addresses[1].Balance.Currency)
+	s.Require().Len(addresses[1].Balances, 1)
+	s.Require().Equal("321", addresses[1].Balances[0].Total.String())
+	s.Require().Equal("utia", addresses[1].Balances[0].Currency)
+}
+
+func (s *StorageTestSuite) TestBalanceUpdatesByAddress() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	updates, err := s.storage.BalanceUpdate.ByAddress(ctx, 1, storage.BalanceUpdateFilter{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(updates, 2)
+
+	s.Require().EqualValues(2, updates[0].Id)
+	s.Require().EqualValues(1000, updates[0].Height)
+	s.Require().Equal("23", updates[0].Delta.String())
+	s.Require().NotNil(updates[0].TxId)
+	s.Require().EqualValues(1, *updates[0].TxId)
+
+	s.Require().EqualValues(1, updates[1].Id)
+	s.Require().Nil(updates[1].TxId)
+}
+
+func (s *StorageTestSuite) TestBalancesAt() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	balances, err := s.storage.BalanceUpdate.BalancesAt(ctx, 1, 500)
+	s.Require().NoError(err)
+	s.Require().Len(balances, 1)
+	s.Require().Equal("utia", balances[0].Currency)
+	s.Require().Equal("100", balances[0].Total.String())
+
+	balances, err = s.storage.BalanceUpdate.BalancesAt(ctx, 1, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(balances, 1)
+	s.Require().Equal("123", balances[0].Total.String())
+}
+
+func (s *StorageTestSuite) TestDelegationByAddress() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	delegations, err := s.storage.Delegation.ByAddress(ctx, 2, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(delegations, 1)
+	s.Require().EqualValues(2, delegations[0].AddressId)
+	s.Require().Equal("celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x", delegations[0].Validator)
+	s.Require().Equal("150", delegations[0].Amount.String())
+}
+
+func (s *StorageTestSuite) TestDelegationByValidator() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	delegations, err := s.storage.Delegation.ByValidator(ctx, "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x", 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(delegations, 2)
+	s.Require().EqualValues(2, delegations[0].AddressId)
+	s.Require().Equal("150", delegations[0].Amount.String())
+	s.Require().NotNil(delegations[0].Address)
+	s.Require().Equal("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", delegations[0].Address.Address)
+	s.Require().EqualValues(1, delegations[1].AddressId)
+	s.Require().Equal("70", delegations[1].Amount.String())
+}
+
+func (s *StorageTestSuite) TestUnbondingByAddress() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	unbondings, err := s.storage.Unbonding.ByAddress(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(unbondings, 1)
+	s.Require().EqualValues(1, unbondings[0].Id)
+	s.Require().EqualValues(1000, unbondings[0].Height)
+	s.Require().Equal("30", unbondings[0].Amount.String())
+	s.Require().EqualValues(1, unbondings[0].TxId)
+}
+
+func (s *StorageTestSuite) TestRedelegationByAddress() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	redelegations, err := s.storage.Redelegation.ByAddress(ctx, 2, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(redelegations, 1)
+	s.Require().EqualValues(1, redelegations[0].Id)
+	s.Require().Equal("celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y", redelegations[0].Source)
+	s.Require().Equal("celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x", redelegations[0].Destination)
+	s.Require().Equal("50", redelegations[0].Amount.String())
 }
 
 func (s *StorageTestSuite) TestEventByTxId() {
@@ -684,6 +791,141 @@ func (s *StorageTestSuite) TestValidatorByAddress() {
 	s.Require().Equal("1", validator.MinSelfDelegation.String())
 	s.Require().Equal("0.2", validator.MaxRate.String())
 	s.Require().EqualValues(4, validator.MsgId)
+	s.Require().Equal("0102030405060708090A0B0C0D0E0F1011121314", validator.ConsAddress.String())
+	s.Require().Equal(types.ValidatorStatusJailed, validator.Status)
+	s.Require().EqualValues(90, validator.Power)
+}
+
+func (s *StorageTestSuite) TestValidatorFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	validators, err := s.storage.Validator.Filter(ctx, storage.ValidatorFilter{
+		Limit:     10,
+		Sort:      sdk.SortOrderDesc,
+		SortField: "rate",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(validators, 2)
+	s.Require().Equal("celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x", validators[0].Address)
+	s.Require().Equal("0.1", validators[0].Rate.String())
+	s.Require().Equal("celestiavaloper17vmk8m246t648hpmde2q7kp4ft9uwrayy09dmw", validators[1].Address)
+
+	validators, err = s.storage.Validator.Filter(ctx, storage.ValidatorFilter{
+		Limit:  1,
+		Offset: 1,
+		Sort:   sdk.SortOrderAsc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(validators, 1)
+	s.Require().EqualValues(2, validators[0].Id)
+}
+
+func (s *StorageTestSuite) TestValidatorMessages() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	msgs, err := s.storage.Validator.Messages(ctx, "celestiavaloper17vmk8m246t648hpmde2q7kp4ft9uwrayy09dmw", 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 2)
+
+	s.Require().EqualValues(3, msgs[0].MsgId)
+	s.Require().Equal(types.MsgAddressTypeValidator, msgs[0].Type)
+	s.Require().NotNil(msgs[0].Msg)
+	s.Require().Equal(types.MsgUnjail, msgs[0].Msg.Type)
+	s.Require().EqualValues(2, msgs[1].MsgId)
+	s.Require().NotNil(msgs[1].Msg)
+	s.Require().Equal(types.MsgDelegate, msgs[1].Msg.Type)
+}
+
+func (s *StorageTestSuite) TestValidatorHistory() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	history, err := s.storage.Validator.History(ctx, 1, 2, 0)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+
+	s.Require().EqualValues(3, history[0].Id)
+	s.Require().Equal(types.ValidatorHistoryTypeJail, history[0].Type)
+	s.Require().Equal(types.ValidatorStatusJailed, history[0].Status)
+	s.Require().Equal(types.ValidatorStatusBonded, history[0].PrevStatus)
+
+	s.Require().EqualValues(2, history[1].Id)
+	s.Require().Equal(types.ValidatorHistoryTypeSlash, history[1].Type)
+	s.Require().EqualValues(90, history[1].Power)
+	s.Require().EqualValues(100, history[1].PrevPower)
+	s.Require().Equal("missing_signature", history[1].Reason)
+	s.Require().Equal("1000", history[1].BurnedCoins.String())
+}
+
+func (s *StorageTestSuite) TestBlockSignatureLevelsByValidator() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	levels, err := s.storage.Signatures.LevelsByValidator(ctx, 1, 998)
+	s.Require().NoError(err)
+	s.Require().Equal([]pkgTypes.Level{999, 998}, levels)
+
+	levels, err = s.storage.Signatures.LevelsByValidator(ctx, 2, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(levels, 0)
+}
+
+func (s *StorageTestSuite) TestEvidenceByHeight() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	evidence, err := s.storage.Evidence.ByHeight(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(evidence, 2)
+
+	s.Require().EqualValues(1, evidence[0].Id)
+	s.Require().Equal(types.EvidenceTypeDuplicateVote, evidence[0].Type)
+	s.Require().EqualValues(999, evidence[0].InfractionHeight)
+	s.Require().NotNil(evidence[0].Validator)
+	s.Require().Equal("Conqueror", evidence[0].Validator.Moniker)
+
+	s.Require().EqualValues(2, evidence[1].Id)
+	s.Require().Equal(types.EvidenceTypeLightClientAttack, evidence[1].Type)
+	s.Require().Nil(evidence[1].Validator)
+
+	evidence, err = s.storage.Evidence.ByHeight(ctx, 999)
+	s.Require().NoError(err)
+	s.Require().Len(evidence, 0)
+}
+
+func (s *StorageTestSuite) TestEvidenceListWithValidators() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	evidence, err := s.storage.Evidence.ListWithValidators(ctx, 10, 0, sdk.SortOrderDesc)
+	s.Require().NoError(err)
+	s.Require().Len(evidence, 2)
+
+	s.Require().EqualValues(2, evidence[0].Id)
+	s.Require().Nil(evidence[0].Validator)
+	s.Require().Equal("2829303132333435363738394041424344454647", evidence[0].ConsAddress.String())
+
+	s.Require().EqualValues(1, evidence[1].Id)
+	s.Require().NotNil(evidence[1].Validator)
+	s.Require().Equal("celestiavaloper17vmk8m246t648hpmde2q7kp4ft9uwrayy09dmw", evidence[1].Validator.Address)
+}
+
+func (s *StorageTestSuite) TestBlockProposedCount() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	proposer, err := hex.DecodeString("81A24EE534DEFE1557A4C7C437E8E8FBC2F834E8")
+	s.Require().NoError(err)
+
+	count, err := s.storage.Blocks.ProposedCount(ctx, proposer)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, count)
+
+	count, err = s.storage.Blocks.ProposedCount(ctx, []byte{0x01})
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, count)
 }
 
 func (s *StorageTestSuite) TestConstantGet() {
@@ -755,6 +997,195 @@ func (s *StorageTestSuite) TestNotify() {
 	}
 }
 
+func (s *StorageTestSuite) TestProposalById() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	proposal, err := s.storage.Proposal.ById(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, proposal.Id)
+	s.Require().Equal(types.ProposalTypeParamChanged, proposal.Type)
+	s.Require().Equal(types.ProposalStatusVotingPeriod, proposal.Status)
+	s.Require().Equal("10000000000", proposal.Deposit.String())
+	s.Require().EqualValues(1001, proposal.VotingStartHeight)
+	s.Require().EqualValues(0, proposal.EndHeight)
+	s.Require().True(proposal.EndTime.IsZero())
+	s.Require().NotNil(proposal.Proposer)
+	s.Require().Equal("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", proposal.Proposer.Address)
+}
+
+func (s *StorageTestSuite) TestProposalFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	proposals, err := s.storage.Proposal.Filter(ctx, storage.ProposalFilter{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(proposals, 2)
+	s.Require().EqualValues(2, proposals[0].Id)
+	s.Require().EqualValues(1, proposals[1].Id)
+	s.Require().NotNil(proposals[1].Proposer)
+
+	proposals, err = s.storage.Proposal.Filter(ctx, storage.ProposalFilter{
+		Limit:  10,
+		Status: []types.ProposalStatus{types.ProposalStatusPassed},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(proposals, 1)
+	s.Require().EqualValues(1, proposals[0].Id)
+}
+
+func (s *StorageTestSuite) TestVoteByProposal() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	votes, err := s.storage.Vote.ByProposal(ctx, 2, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 4)
+	s.Require().EqualValues(4, votes[0].Id)
+	s.Require().NotNil(votes[0].Voter)
+	s.Require().Equal("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", votes[0].Voter.Address)
+
+	votes, err = s.storage.Vote.ByProposal(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 0)
+}
+
+func (s *StorageTestSuite) TestVoteByVoter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	votes, err := s.storage.Vote.ByVoter(ctx, 1, 2, 0)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 2)
+	s.Require().EqualValues(3, votes[0].Id)
+	s.Require().Equal(types.VoteOptionAbstain, votes[0].Option)
+	s.Require().Equal("0.3", votes[0].Weight.String())
+	s.Require().EqualValues(2, votes[1].Id)
+}
+
+func (s *StorageTestSuite) TestVoteCounts() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	counts, err := s.storage.Vote.VoteCounts(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(counts, 2)
+
+	s.Require().Equal(types.VoteOptionYes, counts[0].Option)
+	s.Require().Equal("1.7", counts[0].Weight.String())
+	s.Require().EqualValues(2, counts[0].Count)
+
+	s.Require().Equal(types.VoteOptionAbstain, counts[1].Option)
+	s.Require().Equal("0.3", counts[1].Weight.String())
+	s.Require().EqualValues(1, counts[1].Count)
+}
+
+func (s *StorageTestSuite) TestDepositByProposal() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	deposits, err := s.storage.Deposit.ByProposal(ctx, 2, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(deposits, 2)
+	s.Require().EqualValues(3, deposits[0].Id)
+	s.Require().Equal("6000000000", deposits[0].Amount.String())
+	s.Require().NotNil(deposits[0].Depositor)
+	s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", deposits[0].Depositor.Address)
+	s.Require().EqualValues(2, deposits[1].Id)
+}
+
+func (s *StorageTestSuite) TestGrantByAddress() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	grants, err := s.storage.Grant.ByAddress(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(grants, 2)
+
+	s.Require().EqualValues(3, grants[0].Id)
+	s.Require().EqualValues(2, grants[0].GranterId)
+	s.Require().Equal(types.AuthorizationTypeGeneric, grants[0].Authorization)
+	s.Require().Equal("/cosmos.gov.v1beta1.MsgVote", grants[0].MsgType)
+	s.Require().NotNil(grants[0].Granter)
+	s.Require().Equal("celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60", grants[0].Granter.Address)
+	s.Require().NotNil(grants[0].Grantee)
+	s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", grants[0].Grantee.Address)
+
+	s.Require().EqualValues(1, grants[1].Id)
+	s.Require().Equal(types.AuthorizationTypeSend, grants[1].Authorization)
+	s.Require().Equal("1000", grants[1].SpendLimit.String())
+	s.Require().False(grants[1].Expiration.IsZero())
+}
+
+func (s *StorageTestSuite) TestIbcTransferFilter() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	transfers, err := s.storage.IbcTransfer.Filter(ctx, storage.IbcTransferFilter{
+		Limit: 10,
+		Sort:  sdk.SortOrderDesc,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 4)
+	s.Require().EqualValues(4, transfers[0].Id)
+	s.Require().Equal(types.IbcTransferStatusTimeout, transfers[0].Status)
+	s.Require().EqualValues(1000, transfers[0].ResolvedHeight)
+	s.Require().False(transfers[0].ResolvedTime.IsZero())
+
+	transfers, err = s.storage.IbcTransfer.Filter(ctx, storage.IbcTransferFilter{
+		Limit:   10,
+		Sort:    sdk.SortOrderAsc,
+		Channel: "channel-2",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 3)
+	s.Require().EqualValues(1, transfers[0].Id)
+	s.Require().EqualValues(2, transfers[1].Id)
+	s.Require().EqualValues(3, transfers[2].Id)
+	s.Require().True(transfers[2].Incoming)
+	s.Require().Equal("transfer/channel-2/uosmo", transfers[2].Denom)
+
+	transfers, err = s.storage.IbcTransfer.Filter(ctx, storage.IbcTransferFilter{
+		Limit:   10,
+		Sort:    sdk.SortOrderAsc,
+		Address: "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60",
+		Status:  []types.IbcTransferStatus{types.IbcTransferStatusSuccess},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+	s.Require().EqualValues(3, transfers[0].Id)
+	s.Require().Equal("osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkm8xnha", transfers[0].Sender)
+	s.Require().Equal("200", transfers[0].Amount.String())
+
+	transfers, err = s.storage.IbcTransfer.Filter(ctx, storage.IbcTransferFilter{
+		Limit:  10,
+		Status: []types.IbcTransferStatus{types.IbcTransferStatusPending},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+	s.Require().EqualValues(5, transfers[0].Sequence)
+}
+
+func (s *StorageTestSuite) TestFeeAllowanceByAddress() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	allowances, err := s.storage.FeeAllowance.ByAddress(ctx, 2, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(allowances, 1)
+	s.Require().EqualValues(2, allowances[0].Id)
+	s.Require().Equal(types.FeeAllowanceTypePeriodic, allowances[0].Type)
+	s.Require().Equal("800", allowances[0].SpendLimit.String())
+	s.Require().Equal("200", allowances[0].Spent.String())
+	s.Require().Equal("100", allowances[0].PeriodSpendLimit.String())
+	s.Require().EqualValues(3600, allowances[0].Period)
+	s.Require().NotNil(allowances[0].Granter)
+	s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", allowances[0].Granter.Address)
+}
+
 func TestSuiteStorage_Run(t *testing.T) {
 	suite.Run(t, new(StorageTestSuite))
 }
@@ -5,11 +5,15 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
 
 	models "github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
 )
 
@@ -134,6 +138,15 @@ func (tx Transaction) SaveBalances(ctx context.Context, balances ...models.Balan
 	return err
 }
 
+func (tx Transaction) SaveBalanceUpdates(ctx context.Context, updates ...models.BalanceUpdate) error {
+	if len(updates) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&updates).Exec(ctx)
+	return err
+}
+
 func (tx Transaction) SaveEvents(ctx context.Context, events ...models.Event) error {
 	switch {
 	case len(events) == 0:
@@ -208,6 +221,322 @@ func (tx Transaction) SaveValidators(ctx context.Context, validators ...*models.
 	return err
 }
 
+func (tx Transaction) SaveValidatorMessages(ctx context.Context, msgs ...models.ValidatorMessage) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&msgs).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveValidatorHistory(ctx context.Context, history ...models.ValidatorHistory) error {
+	if len(history) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&history).Returning("id").Exec(ctx)
+	return err
+}
+
+func (tx Transaction) UpdateValidators(ctx context.Context, validators ...*models.Validator) error {
+	if len(validators) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewUpdate().
+		Model(&validators).
+		Column("power", "status").
+		Bulk().
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveBlockSignatures(ctx context.Context, signatures ...models.BlockSignature) error {
+	if len(signatures) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&signatures).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveEvidence(ctx context.Context, evidence ...models.Evidence) error {
+	if len(evidence) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&evidence).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveDelegations(ctx context.Context, delegations ...models.Delegation) error {
+	if len(delegations) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&delegations).
+		Column("address_id", "validator", "amount").
+		On("CONFLICT (address_id, validator) DO UPDATE").
+		Set("amount = EXCLUDED.amount + delegation.amount").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveDelegationLogs(ctx context.Context, logs ...models.DelegationLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&logs).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveUnbondings(ctx context.Context, unbondings ...*models.Unbonding) error {
+	if len(unbondings) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&unbondings).Returning("id").Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveRedelegations(ctx context.Context, redelegations ...*models.Redelegation) error {
+	if len(redelegations) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&redelegations).Returning("id").Exec(ctx)
+	return err
+}
+
+// CancelUnbonding - decreases amount of the first unbonding entry of delegator created at `creationHeight` and returns its id.
+// Zero id is returned if the entry is unknown, e.g. unbonding was created before the first indexed block.
+func (tx Transaction) CancelUnbonding(ctx context.Context, addressId uint64, validator string, creationHeight types.Level, amount decimal.Decimal) (id uint64, err error) {
+	entry := tx.Tx().NewSelect().
+		Model((*models.Unbonding)(nil)).
+		Column("id").
+		Where("address_id = ?", addressId).
+		Where("validator = ?", validator).
+		Where("height = ?", creationHeight).
+		Where("amount > 0").
+		Order("id asc").
+		Limit(1)
+
+	_, err = tx.Tx().NewUpdate().
+		Model((*models.Unbonding)(nil)).
+		Set("amount = amount - ?", amount).
+		Where("id = (?)", entry).
+		Returning("id").
+		Exec(ctx, &id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return
+}
+
+// RestoreUnbonding - returns cancelled amount to the unbonding entry
+func (tx Transaction) RestoreUnbonding(ctx context.Context, id uint64, amount decimal.Decimal) error {
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.Unbonding)(nil)).
+		Set("amount = amount + ?", amount).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveProposals(ctx context.Context, proposals ...*models.Proposal) error {
+	if len(proposals) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&proposals).Exec(ctx)
+	return err
+}
+
+// UpdateProposals - applies proposal changes: adds deposit and sets status, voting period start and end if they are passed
+func (tx Transaction) UpdateProposals(ctx context.Context, proposals ...*models.Proposal) error {
+	for i := range proposals {
+		query := tx.Tx().NewUpdate().
+			Model((*models.Proposal)(nil)).
+			Where("id = ?", proposals[i].Id)
+
+		isEmpty := true
+		if !proposals[i].Deposit.IsZero() {
+			query = query.Set("deposit = deposit + ?", proposals[i].Deposit)
+			isEmpty = false
+		}
+		if proposals[i].Status != "" {
+			query = query.Set("status = ?", proposals[i].Status)
+			isEmpty = false
+		}
+		if proposals[i].VotingStartHeight > 0 {
+			query = query.
+				Set("voting_start_height = ?", proposals[i].VotingStartHeight).
+				Set("voting_start_time = ?", proposals[i].VotingStartTime)
+			isEmpty = false
+		}
+		if proposals[i].EndHeight > 0 {
+			query = query.
+				Set("end_height = ?", proposals[i].EndHeight).
+				Set("end_time = ?", proposals[i].EndTime)
+			isEmpty = false
+		}
+		if isEmpty {
+			continue
+		}
+
+		if _, err := query.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tx Transaction) SaveVotes(ctx context.Context, votes ...models.Vote) error {
+	if len(votes) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&votes).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveDeposits(ctx context.Context, deposits ...models.Deposit) error {
+	if len(deposits) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&deposits).Exec(ctx)
+	return err
+}
+
+// SaveGrants - saves authz grants. Active grant of the same granter, grantee and message type is overwritten, so its state is closed.
+func (tx Transaction) SaveGrants(ctx context.Context, grants ...*models.Grant) error {
+	for i := range grants {
+		if _, err := tx.Tx().NewUpdate().
+			Model((*models.Grant)(nil)).
+			Set("end_height = ?", grants[i].Height).
+			Where("granter_id = ?", grants[i].GranterId).
+			Where("grantee_id = ?", grants[i].GranteeId).
+			Where("msg_type = ?", grants[i].MsgType).
+			Where("end_height = 0").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.Tx().NewInsert().Model(grants[i]).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RevokeGrant - closes state of the active grant with granter, grantee and message type of the passed one
+func (tx Transaction) RevokeGrant(ctx context.Context, grant models.Grant) error {
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.Grant)(nil)).
+		Set("end_height = ?", grant.EndHeight).
+		Where("granter_id = ?", grant.GranterId).
+		Where("grantee_id = ?", grant.GranteeId).
+		Where("msg_type = ?", grant.MsgType).
+		Where("end_height = 0").
+		Exec(ctx)
+	return err
+}
+
+// SaveFeeAllowances - saves fee allowances. Active allowance of the same granter and grantee is replaced, so its state is closed.
+func (tx Transaction) SaveFeeAllowances(ctx context.Context, allowances ...*models.FeeAllowance) error {
+	for i := range allowances {
+		if err := tx.closeFeeAllowance(ctx, allowances[i].GranterId, allowances[i].GranteeId, allowances[i].Height); err != nil {
+			return err
+		}
+
+		if _, err := tx.Tx().NewInsert().Model(allowances[i]).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RevokeFeeAllowance - closes state of the active allowance with granter and grantee of the passed one
+func (tx Transaction) RevokeFeeAllowance(ctx context.Context, allowance models.FeeAllowance) error {
+	return tx.closeFeeAllowance(ctx, allowance.GranterId, allowance.GranteeId, allowance.EndHeight)
+}
+
+// UseFeeAllowance - closes state of the active allowance and creates the new one where paid fee is added to spent amount and subtracted from spend limit.
+// Usage is skipped if there is no active allowance, e.g. when allowance is revoked by exhausting before `use_feegrant` event.
+func (tx Transaction) UseFeeAllowance(ctx context.Context, usage models.FeeAllowance) error {
+	var allowances []models.FeeAllowance
+	if err := tx.Tx().NewSelect().
+		Model(&allowances).
+		Where("granter_id = ?", usage.GranterId).
+		Where("grantee_id = ?", usage.GranteeId).
+		Where("end_height = 0").
+		Limit(1).
+		Scan(ctx); err != nil {
+		return err
+	}
+	if len(allowances) == 0 {
+		return nil
+	}
+
+	if err := tx.closeFeeAllowance(ctx, usage.GranterId, usage.GranteeId, usage.Height); err != nil {
+		return err
+	}
+
+	next := allowances[0]
+	next.Id = 0
+	next.Height = usage.Height
+	next.Time = usage.Time
+	next.TxId = usage.TxId
+	next.MsgId = 0
+	next.Spent = next.Spent.Add(usage.Spent)
+	if next.SpendLimit.IsPositive() {
+		next.SpendLimit = decimal.Max(next.SpendLimit.Sub(usage.Spent), decimal.Zero)
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&next).Exec(ctx)
+	return err
+}
+
+func (tx Transaction) closeFeeAllowance(ctx context.Context, granterId, granteeId uint64, height types.Level) error {
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.FeeAllowance)(nil)).
+		Set("end_height = ?", height).
+		Where("granter_id = ?", granterId).
+		Where("grantee_id = ?", granteeId).
+		Where("end_height = 0").
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) SaveIbcTransfers(ctx context.Context, transfers ...*models.IbcTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	_, err := tx.Tx().NewInsert().Model(&transfers).Exec(ctx)
+	return err
+}
+
+// ResolveIbcTransfer - sets status of the pending outgoing transfer with source port, channel and sequence of the passed acknowledgement or timeout
+func (tx Transaction) ResolveIbcTransfer(ctx context.Context, resolution models.IbcTransfer) error {
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.IbcTransfer)(nil)).
+		Set("status = ?", resolution.Status).
+		Set("resolved_height = ?", resolution.ResolvedHeight).
+		Set("resolved_time = ?", resolution.ResolvedTime).
+		Set("resolved_tx_id = ?", resolution.ResolvedTxId).
+		Where("incoming = false").
+		Where("source_port = ?", resolution.SourcePort).
+		Where("source_channel = ?", resolution.SourceChannel).
+		Where("sequence = ?", resolution.Sequence).
+		Where("status = ?", storageTypes.IbcTransferStatusPending).
+		Exec(ctx)
+	return err
+}
+
 func (tx Transaction) LastBlock(ctx context.Context) (block models.Block, err error) {
 	err = tx.Tx().NewSelect().Model(&block).Order("id desc").Limit(1).Scan(ctx)
 	return
@@ -287,6 +616,170 @@ func (tx Transaction) RollbackMessageAddresses(ctx context.Context, msgIds []uin
 	return
 }
 
+func (tx Transaction) RollbackValidatorMessages(ctx context.Context, msgIds []uint64) (err error) {
+	_, err = tx.Tx().NewDelete().
+		Model((*models.ValidatorMessage)(nil)).
+		Where("msg_id IN (?)", bun.In(msgIds)).
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackValidatorHistory(ctx context.Context, height types.Level) (history []models.ValidatorHistory, err error) {
+	_, err = tx.Tx().NewDelete().Model(&history).Where("height = ?", height).Returning("*").Exec(ctx)
+	return
+}
+
+// RollbackBlockSignatures - removes signatures received from the last commit of the block at `height`. They belong to the previous block.
+func (tx Transaction) RollbackBlockSignatures(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().Model((*models.BlockSignature)(nil)).Where("height = ?", height-1).Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackEvidence(ctx context.Context, height types.Level) (err error) {
+	_, err = tx.Tx().NewDelete().Model((*models.Evidence)(nil)).Where("height = ?", height).Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackBalanceUpdates(ctx context.Context, height types.Level) (updates []models.BalanceUpdate, err error) {
+	err = tx.Tx().NewSelect().Model(&updates).
+		Where("balance_update.height = ?", height).
+		Relation("Address").
+		Scan(ctx)
+	if err != nil {
+		return
+	}
+
+	_, err = tx.Tx().NewDelete().
+		Model((*models.BalanceUpdate)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackDelegationLogs(ctx context.Context, height types.Level) (logs []models.DelegationLog, err error) {
+	_, err = tx.Tx().NewDelete().
+		Model(&logs).
+		Where("height = ?", height).
+		Returning("*").
+		Exec(ctx)
+	return
+}
+
+func (tx Transaction) RollbackUnbondings(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.Unbonding)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackRedelegations(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.Redelegation)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+// RollbackProposals - removes proposals submitted at `height` and reverts status changes which were made at `height`
+func (tx Transaction) RollbackProposals(ctx context.Context, height types.Level) error {
+	if _, err := tx.Tx().NewDelete().
+		Model((*models.Proposal)(nil)).
+		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := tx.Tx().NewUpdate().
+		Model((*models.Proposal)(nil)).
+		Set("status = (CASE WHEN voting_start_height > 0 THEN ? ELSE ? END)::proposal_status", storageTypes.ProposalStatusVotingPeriod, storageTypes.ProposalStatusDepositPeriod).
+		Set("end_height = 0").
+		Set("end_time = NULL").
+		Where("end_height = ?", height).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.Proposal)(nil)).
+		Set("status = ?", storageTypes.ProposalStatusDepositPeriod).
+		Set("voting_start_height = 0").
+		Set("voting_start_time = NULL").
+		Where("voting_start_height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackVotes(ctx context.Context, height types.Level) error {
+	_, err := tx.Tx().NewDelete().
+		Model((*models.Vote)(nil)).
+		Where("height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackDeposits(ctx context.Context, height types.Level) (deposits []models.Deposit, err error) {
+	_, err = tx.Tx().NewDelete().
+		Model(&deposits).
+		Where("height = ?", height).
+		Returning("*").
+		Exec(ctx)
+	return
+}
+
+// RollbackGrants - removes grant states created at `height` and reopens grant states closed at `height`
+func (tx Transaction) RollbackGrants(ctx context.Context, height types.Level) error {
+	if _, err := tx.Tx().NewDelete().
+		Model((*models.Grant)(nil)).
+		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.Grant)(nil)).
+		Set("end_height = 0").
+		Where("end_height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+// RollbackFeeAllowances - removes allowance states created at `height` and reopens allowance states closed at `height`
+func (tx Transaction) RollbackFeeAllowances(ctx context.Context, height types.Level) error {
+	if _, err := tx.Tx().NewDelete().
+		Model((*models.FeeAllowance)(nil)).
+		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.FeeAllowance)(nil)).
+		Set("end_height = 0").
+		Where("end_height = ?", height).
+		Exec(ctx)
+	return err
+}
+
+func (tx Transaction) RollbackIbcTransfers(ctx context.Context, height types.Level) error {
+	if _, err := tx.Tx().NewDelete().
+		Model((*models.IbcTransfer)(nil)).
+		Where("height = ?", height).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	_, err := tx.Tx().NewUpdate().
+		Model((*models.IbcTransfer)(nil)).
+		Set("status = ?", storageTypes.IbcTransferStatusPending).
+		Set("resolved_height = 0").
+		Set("resolved_time = NULL").
+		Set("resolved_tx_id = 0").
+		Where("resolved_height = ?", height).
+		Exec(ctx)
+	return err
+}
+
 func (tx Transaction) DeleteBalances(ctx context.Context, ids []uint64) error {
 	if len(ids) == 0 {
 		return nil
@@ -315,3 +808,13 @@ func (tx Transaction) LastAddressAction(ctx context.Context, address []byte) (ui
 		Scan(ctx, &height)
 	return height, err
 }
+
+func (tx Transaction) ValidatorsByConsAddress(ctx context.Context, addresses ...[]byte) (validators []models.Validator, err error) {
+	if len(addresses) == 0 {
+		return
+	}
+	err = tx.Tx().NewSelect().Model(&validators).
+		Where("cons_address IN (?)", bun.In(addresses)).
+		Scan(ctx)
+	return
+}
@@ -6,6 +6,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/hex"
 	"testing"
 	"time"
 
@@ -604,6 +605,42 @@ func (s *StorageTestSuite) TestDeleteBalances() {
 	s.Require().NoError(tx.Close(ctx))
 }
 
+func (s *StorageTestSuite) TestRollbackBalanceUpdates() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("timescaledb"),
+		testfixtures.Directory("../../../test/data"),
+		testfixtures.UseAlterConstraint(),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+	s.Require().NoError(db.Close())
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	updates, err := tx.RollbackBalanceUpdates(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(updates, 1)
+	s.Require().EqualValues(2, updates[0].Id)
+	s.Require().Equal("23", updates[0].Delta.String())
+	s.Require().NotNil(updates[0].Address)
+	s.Require().Equal("celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8", updates[0].Address.Address)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	left, err := s.storage.BalanceUpdate.ByAddress(ctx, 1, storage.BalanceUpdateFilter{Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(left, 1)
+}
+
 func (s *StorageTestSuite) TestLastAddressAction() {
 	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
 	s.Require().NoError(err)
@@ -716,3 +753,598 @@ func (s *StorageTestSuite) TestSaveEventsWithCopy() {
 func TestSuiteTransaction_Run(t *testing.T) {
 	suite.Run(t, new(TransactionTestSuite))
 }
+
+func (s *StorageTestSuite) TestSaveDelegations() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("timescaledb"),
+		testfixtures.Directory("../../../test/data"),
+		testfixtures.UseAlterConstraint(),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+	s.Require().NoError(db.Close())
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveDelegations(ctx, storage.Delegation{
+		AddressId: 1,
+		Validator: "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
+		Amount:    decimal.RequireFromString("-20"),
+	}, storage.Delegation{
+		AddressId: 1,
+		Validator: "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y",
+		Amount:    decimal.RequireFromString("20"),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	delegations, err := s.storage.Delegation.ByAddress(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(delegations, 2)
+	s.Require().Equal("celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x", delegations[0].Validator)
+	s.Require().Equal("50", delegations[0].Amount.String())
+	s.Require().Equal("celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y", delegations[1].Validator)
+	s.Require().Equal("20", delegations[1].Amount.String())
+}
+
+func (s *StorageTestSuite) TestCancelAndRestoreUnbonding() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("timescaledb"),
+		testfixtures.Directory("../../../test/data"),
+		testfixtures.UseAlterConstraint(),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+	s.Require().NoError(db.Close())
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	id, err := tx.CancelUnbonding(ctx, 1, "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x", 1000, decimal.RequireFromString("10"))
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, id)
+
+	var unbonding storage.Unbonding
+	err = tx.Tx().NewSelect().Model(&unbonding).Where("id = ?", id).Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal("20", unbonding.Amount.String())
+
+	err = tx.RestoreUnbonding(ctx, id, decimal.RequireFromString("10"))
+	s.Require().NoError(err)
+
+	err = tx.Tx().NewSelect().Model(&unbonding).Where("id = ?", id).Scan(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal("30", unbonding.Amount.String())
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *StorageTestSuite) TestCancelUnknownUnbonding() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("timescaledb"),
+		testfixtures.Directory("../../../test/data"),
+		testfixtures.UseAlterConstraint(),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+	s.Require().NoError(db.Close())
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	id, err := tx.CancelUnbonding(ctx, 1, "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x", 1, decimal.RequireFromString("10"))
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, id)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+}
+
+func (s *StorageTestSuite) TestRollbackDelegations() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("timescaledb"),
+		testfixtures.Directory("../../../test/data"),
+		testfixtures.UseAlterConstraint(),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+	s.Require().NoError(db.Close())
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	logs, err := tx.RollbackDelegationLogs(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(logs, 1)
+	s.Require().EqualValues(2, logs[0].Id)
+	s.Require().Equal("-30", logs[0].Amount.String())
+
+	s.Require().NoError(tx.RollbackUnbondings(ctx, 1000))
+	s.Require().NoError(tx.RollbackRedelegations(ctx, 1000))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	unbondings, err := s.storage.Unbonding.ByAddress(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(unbondings, 0)
+
+	redelegations, err := s.storage.Redelegation.ByAddress(ctx, 2, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(redelegations, 0)
+}
+
+func (s *StorageTestSuite) TestSaveAndRollbackValidatorMessages() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveValidatorMessages(ctx, storage.ValidatorMessage{
+		Validator: "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
+		MsgId:     5,
+		Type:      types.MsgAddressTypeValidatorDst,
+		Time:      time.Date(2023, 7, 4, 3, 11, 57, 0, time.UTC),
+		Height:    999,
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.RollbackValidatorMessages(ctx, []uint64{1}))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	msgs, err := s.storage.Validator.Messages(ctx, "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x", 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 1)
+	s.Require().EqualValues(5, msgs[0].MsgId)
+	s.Require().Equal(types.MsgAddressTypeValidatorDst, msgs[0].Type)
+}
+
+func (s *StorageTestSuite) TestSaveAndRollbackValidatorHistory() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	consAddress, err := hex.DecodeString("1415161718191A1B1C1D1E1F2021222324252627")
+	s.Require().NoError(err)
+
+	validators, err := tx.ValidatorsByConsAddress(ctx, consAddress)
+	s.Require().NoError(err)
+	s.Require().Len(validators, 1)
+	s.Require().EqualValues(2, validators[0].Id)
+
+	err = tx.SaveValidatorHistory(ctx, storage.ValidatorHistory{
+		Height:      1001,
+		Time:        time.Date(2023, 7, 4, 3, 11, 57, 0, time.UTC),
+		ValidatorId: 2,
+		Type:        types.ValidatorHistoryTypePowerChange,
+		Power:       0,
+		PrevPower:   1000,
+		Status:      types.ValidatorStatusUnbonding,
+		PrevStatus:  types.ValidatorStatusBonded,
+	})
+	s.Require().NoError(err)
+
+	err = tx.UpdateValidators(ctx, &storage.Validator{
+		Id:     2,
+		Power:  0,
+		Status: types.ValidatorStatusUnbonding,
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	validator, err := s.storage.Validator.GetByID(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, validator.Power)
+	s.Require().Equal(types.ValidatorStatusUnbonding, validator.Status)
+	s.Require().Equal("Validator", validator.Moniker)
+
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	history, err := tx.RollbackValidatorHistory(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(history, 3)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	history, err = s.storage.Validator.History(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().EqualValues(999, history[0].Height)
+}
+
+func (s *StorageTestSuite) TestSaveAndRollbackBlockSignatures() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveBlockSignatures(ctx, storage.BlockSignature{
+		Height:      1000,
+		Time:        time.Date(2023, 7, 4, 3, 10, 58, 0, time.UTC),
+		ValidatorId: 2,
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	levels, err := s.storage.Signatures.LevelsByValidator(ctx, 2, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]pkgTypes.Level{1000, 999}, levels)
+
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	// block 1000 contains signatures of block 999
+	s.Require().NoError(tx.RollbackBlockSignatures(ctx, 1000))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	levels, err = s.storage.Signatures.LevelsByValidator(ctx, 2, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]pkgTypes.Level{1000}, levels)
+}
+
+func (s *StorageTestSuite) TestSaveAndRollbackEvidence() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveEvidence(ctx, storage.Evidence{
+		Height:           1001,
+		Time:             time.Date(2023, 7, 4, 3, 10, 58, 0, time.UTC),
+		Type:             types.EvidenceTypeDuplicateVote,
+		ValidatorId:      2,
+		ConsAddress:      pkgTypes.Hex{0x14, 0x15},
+		InfractionHeight: 1000,
+		InfractionTime:   time.Date(2023, 7, 4, 3, 10, 57, 0, time.UTC),
+		ValidatorPower:   10,
+		TotalVotingPower: 1000,
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	evidence, err := s.storage.Evidence.ByHeight(ctx, 1001)
+	s.Require().NoError(err)
+	s.Require().Len(evidence, 1)
+	s.Require().EqualValues(2, evidence[0].ValidatorId)
+	s.Require().NotNil(evidence[0].Validator)
+	s.Require().EqualValues(1000, evidence[0].InfractionHeight)
+
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.RollbackEvidence(ctx, 1001))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	evidence, err = s.storage.Evidence.ByHeight(ctx, 1001)
+	s.Require().NoError(err)
+	s.Require().Len(evidence, 0)
+
+	evidence, err = s.storage.Evidence.ByHeight(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(evidence, 2)
+}
+
+func (s *StorageTestSuite) TestSaveAndRollbackProposals() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	ts := time.Date(2023, 7, 11, 3, 13, 57, 0, time.UTC)
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveProposals(ctx, &storage.Proposal{
+		Id:         3,
+		Height:     1003,
+		Time:       ts,
+		ProposerId: 1,
+		Title:      "Community pool spend",
+		Type:       types.ProposalTypeCommunityPoolSpend,
+		Status:     types.ProposalStatusDepositPeriod,
+		Deposit:    decimal.Zero,
+	})
+	s.Require().NoError(err)
+
+	err = tx.SaveDeposits(ctx, storage.Deposit{
+		Height:      1003,
+		Time:        ts,
+		ProposalId:  3,
+		DepositorId: 1,
+		Amount:      decimal.RequireFromString("100"),
+	})
+	s.Require().NoError(err)
+
+	err = tx.SaveVotes(ctx, storage.Vote{
+		Height:     1003,
+		Time:       ts,
+		ProposalId: 2,
+		VoterId:    2,
+		Option:     types.VoteOptionNoWithVeto,
+		Weight:     decimal.RequireFromString("1"),
+		MsgId:      6,
+	})
+	s.Require().NoError(err)
+
+	err = tx.UpdateProposals(ctx,
+		&storage.Proposal{Id: 3, Deposit: decimal.RequireFromString("100")},
+		&storage.Proposal{Id: 2, Status: types.ProposalStatusRejected, EndHeight: 1003, EndTime: ts},
+	)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	proposal, err := s.storage.Proposal.ById(ctx, 3)
+	s.Require().NoError(err)
+	s.Require().Equal("100", proposal.Deposit.String())
+
+	proposal, err = s.storage.Proposal.ById(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusRejected, proposal.Status)
+	s.Require().EqualValues(1003, proposal.EndHeight)
+
+	counts, err := s.storage.Vote.VoteCounts(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(counts, 3)
+
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	deposits, err := tx.RollbackDeposits(ctx, 1003)
+	s.Require().NoError(err)
+	s.Require().Len(deposits, 1)
+	s.Require().EqualValues(3, deposits[0].ProposalId)
+	s.Require().NoError(tx.RollbackVotes(ctx, 1003))
+	s.Require().NoError(tx.RollbackProposals(ctx, 1003))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	_, err = s.storage.Proposal.ById(ctx, 3)
+	s.Require().Error(err)
+
+	proposal, err = s.storage.Proposal.ById(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ProposalStatusVotingPeriod, proposal.Status)
+	s.Require().EqualValues(0, proposal.EndHeight)
+	s.Require().True(proposal.EndTime.IsZero())
+
+	counts, err = s.storage.Vote.VoteCounts(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(counts, 2)
+}
+
+func (s *StorageTestSuite) TestSaveAndRollbackGrants() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	ts := time.Date(2023, 7, 11, 3, 13, 57, 0, time.UTC)
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveGrants(ctx, &storage.Grant{
+		Height:        1003,
+		Time:          ts,
+		GranterId:     1,
+		GranteeId:     2,
+		Authorization: types.AuthorizationTypeGeneric,
+		MsgType:       "/cosmos.bank.v1beta1.MsgSend",
+		SpendLimit:    decimal.Zero,
+	})
+	s.Require().NoError(err)
+
+	err = tx.RevokeGrant(ctx, storage.Grant{
+		EndHeight: 1003,
+		GranterId: 2,
+		GranteeId: 1,
+		MsgType:   "/cosmos.gov.v1beta1.MsgVote",
+	})
+	s.Require().NoError(err)
+
+	err = tx.UseFeeAllowance(ctx, storage.FeeAllowance{
+		Height:    1003,
+		Time:      ts,
+		GranterId: 1,
+		GranteeId: 2,
+		Spent:     decimal.RequireFromString("50"),
+		TxId:      3,
+	})
+	s.Require().NoError(err)
+
+	err = tx.SaveFeeAllowances(ctx, &storage.FeeAllowance{
+		Height:           1003,
+		Time:             ts,
+		GranterId:        2,
+		GranteeId:        1,
+		Type:             types.FeeAllowanceTypeBasic,
+		SpendLimit:       decimal.Zero,
+		Spent:            decimal.Zero,
+		PeriodSpendLimit: decimal.Zero,
+	})
+	s.Require().NoError(err)
+
+	err = tx.RevokeFeeAllowance(ctx, storage.FeeAllowance{
+		EndHeight: 1003,
+		GranterId: 2,
+		GranteeId: 1,
+	})
+	s.Require().NoError(err)
+
+	err = tx.UseFeeAllowance(ctx, storage.FeeAllowance{
+		Height:    1003,
+		Time:      ts,
+		GranterId: 2,
+		GranteeId: 1,
+		Spent:     decimal.RequireFromString("50"),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	grants, err := s.storage.Grant.ByAddress(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(grants, 1)
+	s.Require().Equal(types.AuthorizationTypeGeneric, grants[0].Authorization)
+	s.Require().EqualValues(1003, grants[0].Height)
+
+	allowances, err := s.storage.FeeAllowance.ByAddress(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(allowances, 1)
+	s.Require().EqualValues(1003, allowances[0].Height)
+	s.Require().EqualValues(3, allowances[0].TxId)
+	s.Require().Equal("750", allowances[0].SpendLimit.String())
+	s.Require().Equal("250", allowances[0].Spent.String())
+	s.Require().Equal(types.FeeAllowanceTypePeriodic, allowances[0].Type)
+
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.RollbackGrants(ctx, 1003))
+	s.Require().NoError(tx.RollbackFeeAllowances(ctx, 1003))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	grants, err = s.storage.Grant.ByAddress(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(grants, 2)
+	s.Require().EqualValues(3, grants[0].Id)
+	s.Require().EqualValues(1, grants[1].Id)
+
+	allowances, err = s.storage.FeeAllowance.ByAddress(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(allowances, 1)
+	s.Require().EqualValues(2, allowances[0].Id)
+	s.Require().Equal("800", allowances[0].SpendLimit.String())
+}
+
+func (s *StorageTestSuite) TestSaveAndRollbackIbcTransfers() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ctxCancel()
+
+	ts := time.Date(2023, 7, 11, 3, 13, 57, 0, time.UTC)
+
+	tx, err := BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	err = tx.SaveIbcTransfers(ctx, &storage.IbcTransfer{
+		Height:             1003,
+		Time:               ts,
+		Incoming:           true,
+		Status:             types.IbcTransferStatusSuccess,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-6994",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-2",
+		Sequence:           11,
+		Sender:             "osmo1mm8yykm46ec3t0dgwls70g0jvtm055wkm8xnha",
+		Receiver:           "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8",
+		Amount:             decimal.RequireFromString("100"),
+		Denom:              "transfer/channel-2/uosmo",
+		TxId:               3,
+		MsgId:              3,
+	})
+	s.Require().NoError(err)
+
+	err = tx.ResolveIbcTransfer(ctx, storage.IbcTransfer{
+		Status:         types.IbcTransferStatusFailed,
+		SourcePort:     "transfer",
+		SourceChannel:  "channel-2",
+		Sequence:       5,
+		ResolvedHeight: 1003,
+		ResolvedTime:   ts,
+		ResolvedTxId:   3,
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	transfers, err := s.storage.IbcTransfer.Filter(ctx, storage.IbcTransferFilter{
+		Limit:   10,
+		Sort:    sdk.SortOrderDesc,
+		Address: "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 3)
+	s.Require().True(transfers[0].Incoming)
+	s.Require().EqualValues(1003, transfers[0].Height)
+	s.Require().EqualValues(1, transfers[2].Id)
+	s.Require().Equal(types.IbcTransferStatusFailed, transfers[2].Status)
+	s.Require().EqualValues(1003, transfers[2].ResolvedHeight)
+	s.Require().EqualValues(3, transfers[2].ResolvedTxId)
+
+	tx, err = BeginTransaction(ctx, s.storage.Transactable)
+	s.Require().NoError(err)
+
+	s.Require().NoError(tx.RollbackIbcTransfers(ctx, 1003))
+
+	s.Require().NoError(tx.Flush(ctx))
+	s.Require().NoError(tx.Close(ctx))
+
+	transfers, err = s.storage.IbcTransfer.Filter(ctx, storage.IbcTransferFilter{
+		Limit:   10,
+		Sort:    sdk.SortOrderDesc,
+		Address: "celestia1mm8yykm46ec3t0dgwls70g0jvtm055wk9ayal8",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 2)
+	s.Require().EqualValues(2, transfers[0].Id)
+	s.Require().EqualValues(1, transfers[1].Id)
+	s.Require().Equal(types.IbcTransferStatusPending, transfers[1].Status)
+	s.Require().EqualValues(0, transfers[1].ResolvedHeight)
+	s.Require().True(transfers[1].ResolvedTime.IsZero())
+}
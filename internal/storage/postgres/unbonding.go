@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Unbonding -
+type Unbonding struct {
+	*postgres.Table[*storage.Unbonding]
+}
+
+// NewUnbonding -
+func NewUnbonding(db *database.Bun) *Unbonding {
+	return &Unbonding{
+		Table: postgres.NewTable[*storage.Unbonding](db),
+	}
+}
+
+// ByAddress - returns unbonding entries of the address. The latest entries go first.
+func (u *Unbonding) ByAddress(ctx context.Context, addressId uint64, limit, offset int) (entries []storage.Unbonding, err error) {
+	query := u.DB().NewSelect().Model(&entries).
+		Where("address_id = ?", addressId).
+		Offset(offset).
+		Order("id desc")
+
+	query = limitScope(query, limit)
+	err = query.Scan(ctx)
+	return
+}
@@ -29,3 +29,38 @@ func (v *Validator) ByAddress(ctx context.Context, address string) (validator st
 		Scan(ctx)
 	return
 }
+
+func (v *Validator) Filter(ctx context.Context, fltrs storage.ValidatorFilter) (validators []storage.Validator, err error) {
+	query := v.DB().NewSelect().Model(&validators).
+		Offset(fltrs.Offset)
+
+	query = validatorListFilter(query, fltrs)
+
+	err = query.Scan(ctx)
+	return
+}
+
+func (v *Validator) Messages(ctx context.Context, address string, limit, offset int) (msgs []storage.ValidatorMessage, err error) {
+	query := v.DB().NewSelect().Model(&msgs).
+		Where("validator_message.validator = ?", address).
+		Order("validator_message.msg_id desc").
+		Relation("Msg")
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
+
+func (v *Validator) History(ctx context.Context, validatorId uint64, limit, offset int) (history []storage.ValidatorHistory, err error) {
+	query := v.DB().NewSelect().Model(&history).
+		Where("validator_id = ?", validatorId).
+		Order("id desc")
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage/postgres"
+)
+
+// Vote -
+type Vote struct {
+	*postgres.Table[*storage.Vote]
+}
+
+// NewVote -
+func NewVote(db *database.Bun) *Vote {
+	return &Vote{
+		Table: postgres.NewTable[*storage.Vote](db),
+	}
+}
+
+// ByProposal - returns votes for the proposal with voters. The latest votes are first.
+func (v *Vote) ByProposal(ctx context.Context, proposalId uint64, limit, offset int) (votes []storage.Vote, err error) {
+	query := v.DB().NewSelect().Model(&votes).
+		Where("vote.proposal_id = ?", proposalId).
+		Relation("Voter").
+		Order("vote.id desc")
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
+
+// ByVoter - returns vote history of the address. The latest votes are first.
+func (v *Vote) ByVoter(ctx context.Context, voterId uint64, limit, offset int) (votes []storage.Vote, err error) {
+	query := v.DB().NewSelect().Model(&votes).
+		Where("voter_id = ?", voterId).
+		Order("id desc")
+	query = limitScope(query, limit)
+	if offset > 0 {
+		query = query.Offset(offset)
+	}
+	err = query.Scan(ctx)
+	return
+}
+
+// VoteCounts - returns count of voters and summary option weight per option. Only the last vote of each voter is counted.
+// Votes are not weighted by stake of voters.
+func (v *Vote) VoteCounts(ctx context.Context, proposalId uint64) (counts []storage.VoteCount, err error) {
+	lastVotes := v.DB().NewSelect().
+		Model((*storage.Vote)(nil)).
+		ColumnExpr("max(msg_id)").
+		Where("proposal_id = ?", proposalId).
+		Group("voter_id")
+
+	err = v.DB().NewSelect().
+		Model((*storage.Vote)(nil)).
+		ColumnExpr("option, sum(weight) as weight, count(*) as count").
+		Where("proposal_id = ?", proposalId).
+		Where("msg_id IN (?)", lastVotes).
+		Group("option").
+		Order("option").
+		Scan(ctx, &counts)
+	return
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type ProposalFilter struct {
+	Limit  int
+	Offset int
+	Sort   storage.SortOrder
+	Status []types.ProposalStatus
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IProposal interface {
+	storage.Table[*Proposal]
+
+	ById(ctx context.Context, id uint64) (Proposal, error)
+	Filter(ctx context.Context, fltrs ProposalFilter) ([]Proposal, error)
+}
+
+// Proposal - governance proposal. Status is changed by `submit_proposal` and `proposal_deposit` events when voting period starts
+// and by end-block `active_proposal` and `inactive_proposal` events when proposal is finished.
+type Proposal struct {
+	bun.BaseModel `bun:"proposal" comment:"Table with governance proposals."`
+
+	Id                uint64               `bun:"id,pk,notnull"               comment:"Proposal id"`
+	Height            pkgTypes.Level       `bun:"height,notnull"              comment:"The number (height) of block where proposal was submitted"`
+	Time              time.Time            `bun:"time,notnull"                comment:"The time of block where proposal was submitted"`
+	ProposerId        uint64               `bun:"proposer_id"                 comment:"Proposer internal identity"`
+	Title             string               `bun:"title,type:text"             comment:"Proposal title"`
+	Description       string               `bun:"description,type:text"       comment:"Proposal description"`
+	Type              types.ProposalType   `bun:"type,type:proposal_type"     comment:"Proposal type"`
+	Status            types.ProposalStatus `bun:"status,type:proposal_status" comment:"Proposal status"`
+	Deposit           decimal.Decimal      `bun:"deposit,type:numeric"        comment:"Total deposit"`
+	VotingStartHeight pkgTypes.Level       `bun:"voting_start_height"         comment:"The number (height) of block where voting period was started. Zero if voting period isn't started."`
+	VotingStartTime   time.Time            `bun:"voting_start_time,nullzero"  comment:"The time of block where voting period was started"`
+	EndHeight         pkgTypes.Level       `bun:"end_height"                  comment:"The number (height) of block where proposal was finished or removed. Zero if proposal is active."`
+	EndTime           time.Time            `bun:"end_time,nullzero"           comment:"The time of block where proposal was finished or removed"`
+	TxId              uint64               `bun:"tx_id"                       comment:"Transaction id"`
+	MsgId             uint64               `bun:"msg_id"                      comment:"Message id"`
+
+	Proposer *Address `bun:"rel:belongs-to,join:proposer_id=id"`
+}
+
+// TableName -
+func (Proposal) TableName() string {
+	return "proposal"
+}
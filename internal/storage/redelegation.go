@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IRedelegation interface {
+	storage.Table[*Redelegation]
+
+	ByAddress(ctx context.Context, addressId uint64, limit, offset int) ([]Redelegation, error)
+}
+
+// Redelegation - redelegation entry created by `MsgBeginRedelegate`
+type Redelegation struct {
+	bun.BaseModel `bun:"redelegation" comment:"Table with redelegations."`
+
+	Id             uint64          `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height         pkgTypes.Level  `bun:"height,notnull"              comment:"The number (height) of block where redelegation was started"`
+	Time           time.Time       `bun:"time,pk,notnull"             comment:"The time of block"`
+	AddressId      uint64          `bun:"address_id,notnull"          comment:"Delegator internal identity"`
+	Source         string          `bun:"source,type:text"            comment:"Source validator operator address"`
+	Destination    string          `bun:"destination,type:text"       comment:"Destination validator operator address"`
+	Amount         decimal.Decimal `bun:"amount,type:numeric"         comment:"Redelegated amount"`
+	CompletionTime time.Time       `bun:"completion_time"             comment:"The time when redelegation will be completed"`
+	TxId           uint64          `bun:"tx_id"                       comment:"Transaction id"`
+	MsgId          uint64          `bun:"msg_id"                      comment:"Message id"`
+
+	Address *Address `bun:"rel:belongs-to,join:address_id=id"`
+}
+
+// TableName -
+func (Redelegation) TableName() string {
+	return "redelegation"
+}
@@ -18,6 +18,15 @@ type IState interface {
 	storage.Table[*State]
 
 	ByName(ctx context.Context, name string) (State, error)
+	Totals(ctx context.Context) (Totals, error)
+}
+
+// Totals - state totals recomputed from the stored data
+type Totals struct {
+	TxCount   int64           `bun:"tx_count"`
+	BlobsSize int64           `bun:"blobs_size"`
+	Fee       decimal.Decimal `bun:"fee"`
+	Accounts  int64           `bun:"accounts"`
 }
 
 // State -
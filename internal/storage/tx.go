@@ -64,7 +64,10 @@ type Tx struct {
 	Events   []Event   `bun:"rel:has-many"`
 	Signers  []Address `bun:"m2m:signer,join:Tx=Address"`
 
-	BlobsSize int64 `bun:"-"`
+	BlobsSize      int64           `bun:"-"`
+	BalanceUpdates []BalanceUpdate `bun:"-"` // internal field for passing balance updates caused by transaction events
+	Grants         []*Grant        `bun:"-"` // internal field for passing authz grants and revocations in order of transaction events
+	FeeAllowances  []*FeeAllowance `bun:"-"` // internal field for passing fee allowances, their usages and revocations in order of transaction events
 }
 
 // TableName -
@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum AuthorizationType
+/*
+	ENUM(
+		generic,
+		send,
+		stake,
+		unknown
+	)
+*/
+//go:generate go-enum --marshal --sql --values
+type AuthorizationType string
@@ -0,0 +1,118 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+const (
+	// AuthorizationTypeGeneric is a AuthorizationType of type generic.
+	AuthorizationTypeGeneric AuthorizationType = "generic"
+	// AuthorizationTypeSend is a AuthorizationType of type send.
+	AuthorizationTypeSend AuthorizationType = "send"
+	// AuthorizationTypeStake is a AuthorizationType of type stake.
+	AuthorizationTypeStake AuthorizationType = "stake"
+	// AuthorizationTypeUnknown is a AuthorizationType of type unknown.
+	AuthorizationTypeUnknown AuthorizationType = "unknown"
+)
+
+var ErrInvalidAuthorizationType = errors.New("not a valid AuthorizationType")
+
+// AuthorizationTypeValues returns a list of the values for AuthorizationType
+func AuthorizationTypeValues() []AuthorizationType {
+	return []AuthorizationType{
+		AuthorizationTypeGeneric,
+		AuthorizationTypeSend,
+		AuthorizationTypeStake,
+		AuthorizationTypeUnknown,
+	}
+}
+
+// String implements the Stringer interface.
+func (x AuthorizationType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x AuthorizationType) IsValid() bool {
+	_, err := ParseAuthorizationType(string(x))
+	return err == nil
+}
+
+var _AuthorizationTypeValue = map[string]AuthorizationType{
+	"generic": AuthorizationTypeGeneric,
+	"send":    AuthorizationTypeSend,
+	"stake":   AuthorizationTypeStake,
+	"unknown": AuthorizationTypeUnknown,
+}
+
+// ParseAuthorizationType attempts to convert a string to a AuthorizationType.
+func ParseAuthorizationType(name string) (AuthorizationType, error) {
+	if x, ok := _AuthorizationTypeValue[name]; ok {
+		return x, nil
+	}
+	return AuthorizationType(""), fmt.Errorf("%s is %w", name, ErrInvalidAuthorizationType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x AuthorizationType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *AuthorizationType) UnmarshalText(text []byte) error {
+	tmp, err := ParseAuthorizationType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errAuthorizationTypeNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *AuthorizationType) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = AuthorizationType("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseAuthorizationType(v)
+	case []byte:
+		*x, err = ParseAuthorizationType(string(v))
+	case AuthorizationType:
+		*x = v
+	case *AuthorizationType:
+		if v == nil {
+			return errAuthorizationTypeNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errAuthorizationTypeNilPtr
+		}
+		*x, err = ParseAuthorizationType(*v)
+	default:
+		return errors.New("invalid type for AuthorizationType")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x AuthorizationType) Value() (driver.Value, error) {
+	return x.String(), nil
+}
@@ -41,11 +41,45 @@ package types
 		proposal_vote,
 		proposal_deposit,
 		submit_proposal,
+		active_proposal,
+		inactive_proposal,
 
 		cosmos.authz.v1beta1.EventGrant,
+		cosmos.authz.v1beta1.EventRevoke,
 
 		send_packet,
-		ibc_transfer
+		ibc_transfer,
+
+		create_client,
+		update_client,
+		upgrade_client,
+		client_misbehaviour,
+		update_client_proposal,
+		upgrade_client_proposal,
+
+		connection_open_init,
+		connection_open_try,
+		connection_open_ack,
+		connection_open_confirm,
+
+		channel_open_init,
+		channel_open_try,
+		channel_open_ack,
+		channel_open_confirm,
+		channel_close_init,
+		channel_close_confirm,
+		channel_close,
+
+		recv_packet,
+		write_acknowledgement,
+		acknowledge_packet,
+		timeout_packet,
+		timeout_on_close_packet,
+
+		fungible_token_packet,
+		denomination_trace,
+		timeout,
+		channel_closed
 	)
 */
 //go:generate go-enum --marshal --sql --values
@@ -75,12 +75,70 @@ const (
 	EventTypeProposalDeposit EventType = "proposal_deposit"
 	// EventTypeSubmitProposal is a EventType of type submit_proposal.
 	EventTypeSubmitProposal EventType = "submit_proposal"
+	// EventTypeActiveProposal is a EventType of type active_proposal.
+	EventTypeActiveProposal EventType = "active_proposal"
+	// EventTypeInactiveProposal is a EventType of type inactive_proposal.
+	EventTypeInactiveProposal EventType = "inactive_proposal"
 	// EventTypeCosmosauthzv1beta1EventGrant is a EventType of type cosmos.authz.v1beta1.EventGrant.
 	EventTypeCosmosauthzv1beta1EventGrant EventType = "cosmos.authz.v1beta1.EventGrant"
+	// EventTypeCosmosauthzv1beta1EventRevoke is a EventType of type cosmos.authz.v1beta1.EventRevoke.
+	EventTypeCosmosauthzv1beta1EventRevoke EventType = "cosmos.authz.v1beta1.EventRevoke"
 	// EventTypeSendPacket is a EventType of type send_packet.
 	EventTypeSendPacket EventType = "send_packet"
 	// EventTypeIbcTransfer is a EventType of type ibc_transfer.
 	EventTypeIbcTransfer EventType = "ibc_transfer"
+	// EventTypeCreateClient is a EventType of type create_client.
+	EventTypeCreateClient EventType = "create_client"
+	// EventTypeUpdateClient is a EventType of type update_client.
+	EventTypeUpdateClient EventType = "update_client"
+	// EventTypeUpgradeClient is a EventType of type upgrade_client.
+	EventTypeUpgradeClient EventType = "upgrade_client"
+	// EventTypeClientMisbehaviour is a EventType of type client_misbehaviour.
+	EventTypeClientMisbehaviour EventType = "client_misbehaviour"
+	// EventTypeUpdateClientProposal is a EventType of type update_client_proposal.
+	EventTypeUpdateClientProposal EventType = "update_client_proposal"
+	// EventTypeUpgradeClientProposal is a EventType of type upgrade_client_proposal.
+	EventTypeUpgradeClientProposal EventType = "upgrade_client_proposal"
+	// EventTypeConnectionOpenInit is a EventType of type connection_open_init.
+	EventTypeConnectionOpenInit EventType = "connection_open_init"
+	// EventTypeConnectionOpenTry is a EventType of type connection_open_try.
+	EventTypeConnectionOpenTry EventType = "connection_open_try"
+	// EventTypeConnectionOpenAck is a EventType of type connection_open_ack.
+	EventTypeConnectionOpenAck EventType = "connection_open_ack"
+	// EventTypeConnectionOpenConfirm is a EventType of type connection_open_confirm.
+	EventTypeConnectionOpenConfirm EventType = "connection_open_confirm"
+	// EventTypeChannelOpenInit is a EventType of type channel_open_init.
+	EventTypeChannelOpenInit EventType = "channel_open_init"
+	// EventTypeChannelOpenTry is a EventType of type channel_open_try.
+	EventTypeChannelOpenTry EventType = "channel_open_try"
+	// EventTypeChannelOpenAck is a EventType of type channel_open_ack.
+	EventTypeChannelOpenAck EventType = "channel_open_ack"
+	// EventTypeChannelOpenConfirm is a EventType of type channel_open_confirm.
+	EventTypeChannelOpenConfirm EventType = "channel_open_confirm"
+	// EventTypeChannelCloseInit is a EventType of type channel_close_init.
+	EventTypeChannelCloseInit EventType = "channel_close_init"
+	// EventTypeChannelCloseConfirm is a EventType of type channel_close_confirm.
+	EventTypeChannelCloseConfirm EventType = "channel_close_confirm"
+	// EventTypeChannelClose is a EventType of type channel_close.
+	EventTypeChannelClose EventType = "channel_close"
+	// EventTypeRecvPacket is a EventType of type recv_packet.
+	EventTypeRecvPacket EventType = "recv_packet"
+	// EventTypeWriteAcknowledgement is a EventType of type write_acknowledgement.
+	EventTypeWriteAcknowledgement EventType = "write_acknowledgement"
+	// EventTypeAcknowledgePacket is a EventType of type acknowledge_packet.
+	EventTypeAcknowledgePacket EventType = "acknowledge_packet"
+	// EventTypeTimeoutPacket is a EventType of type timeout_packet.
+	EventTypeTimeoutPacket EventType = "timeout_packet"
+	// EventTypeTimeoutOnClosePacket is a EventType of type timeout_on_close_packet.
+	EventTypeTimeoutOnClosePacket EventType = "timeout_on_close_packet"
+	// EventTypeFungibleTokenPacket is a EventType of type fungible_token_packet.
+	EventTypeFungibleTokenPacket EventType = "fungible_token_packet"
+	// EventTypeDenominationTrace is a EventType of type denomination_trace.
+	EventTypeDenominationTrace EventType = "denomination_trace"
+	// EventTypeTimeout is a EventType of type timeout.
+	EventTypeTimeout EventType = "timeout"
+	// EventTypeChannelClosed is a EventType of type channel_closed.
+	EventTypeChannelClosed EventType = "channel_closed"
 )
 
 var ErrInvalidEventType = errors.New("not a valid EventType")
@@ -119,9 +177,38 @@ func EventTypeValues() []EventType {
 		EventTypeProposalVote,
 		EventTypeProposalDeposit,
 		EventTypeSubmitProposal,
+		EventTypeActiveProposal,
+		EventTypeInactiveProposal,
 		EventTypeCosmosauthzv1beta1EventGrant,
+		EventTypeCosmosauthzv1beta1EventRevoke,
 		EventTypeSendPacket,
 		EventTypeIbcTransfer,
+		EventTypeCreateClient,
+		EventTypeUpdateClient,
+		EventTypeUpgradeClient,
+		EventTypeClientMisbehaviour,
+		EventTypeUpdateClientProposal,
+		EventTypeUpgradeClientProposal,
+		EventTypeConnectionOpenInit,
+		EventTypeConnectionOpenTry,
+		EventTypeConnectionOpenAck,
+		EventTypeConnectionOpenConfirm,
+		EventTypeChannelOpenInit,
+		EventTypeChannelOpenTry,
+		EventTypeChannelOpenAck,
+		EventTypeChannelOpenConfirm,
+		EventTypeChannelCloseInit,
+		EventTypeChannelCloseConfirm,
+		EventTypeChannelClose,
+		EventTypeRecvPacket,
+		EventTypeWriteAcknowledgement,
+		EventTypeAcknowledgePacket,
+		EventTypeTimeoutPacket,
+		EventTypeTimeoutOnClosePacket,
+		EventTypeFungibleTokenPacket,
+		EventTypeDenominationTrace,
+		EventTypeTimeout,
+		EventTypeChannelClosed,
 	}
 }
 
@@ -169,9 +256,38 @@ var _EventTypeValue = map[string]EventType{
 	"proposal_vote":                     EventTypeProposalVote,
 	"proposal_deposit":                  EventTypeProposalDeposit,
 	"submit_proposal":                   EventTypeSubmitProposal,
+	"active_proposal":                   EventTypeActiveProposal,
+	"inactive_proposal":                 EventTypeInactiveProposal,
 	"cosmos.authz.v1beta1.EventGrant":   EventTypeCosmosauthzv1beta1EventGrant,
+	"cosmos.authz.v1beta1.EventRevoke":  EventTypeCosmosauthzv1beta1EventRevoke,
 	"send_packet":                       EventTypeSendPacket,
 	"ibc_transfer":                      EventTypeIbcTransfer,
+	"create_client":                     EventTypeCreateClient,
+	"update_client":                     EventTypeUpdateClient,
+	"upgrade_client":                    EventTypeUpgradeClient,
+	"client_misbehaviour":               EventTypeClientMisbehaviour,
+	"update_client_proposal":            EventTypeUpdateClientProposal,
+	"upgrade_client_proposal":           EventTypeUpgradeClientProposal,
+	"connection_open_init":              EventTypeConnectionOpenInit,
+	"connection_open_try":               EventTypeConnectionOpenTry,
+	"connection_open_ack":               EventTypeConnectionOpenAck,
+	"connection_open_confirm":           EventTypeConnectionOpenConfirm,
+	"channel_open_init":                 EventTypeChannelOpenInit,
+	"channel_open_try":                  EventTypeChannelOpenTry,
+	"channel_open_ack":                  EventTypeChannelOpenAck,
+	"channel_open_confirm":              EventTypeChannelOpenConfirm,
+	"channel_close_init":                EventTypeChannelCloseInit,
+	"channel_close_confirm":             EventTypeChannelCloseConfirm,
+	"channel_close":                     EventTypeChannelClose,
+	"recv_packet":                       EventTypeRecvPacket,
+	"write_acknowledgement":             EventTypeWriteAcknowledgement,
+	"acknowledge_packet":                EventTypeAcknowledgePacket,
+	"timeout_packet":                    EventTypeTimeoutPacket,
+	"timeout_on_close_packet":           EventTypeTimeoutOnClosePacket,
+	"fungible_token_packet":             EventTypeFungibleTokenPacket,
+	"denomination_trace":                EventTypeDenominationTrace,
+	"timeout":                           EventTypeTimeout,
+	"channel_closed":                    EventTypeChannelClosed,
 }
 
 // ParseEventType attempts to convert a string to a EventType.
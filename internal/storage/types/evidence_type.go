@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum EvidenceType
+/*
+	ENUM(
+		duplicate_vote,
+		light_client_attack
+	)
+*/
+//go:generate go-enum --marshal --sql --values
+type EvidenceType string
@@ -0,0 +1,110 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+const (
+	// EvidenceTypeDuplicateVote is a EvidenceType of type duplicate_vote.
+	EvidenceTypeDuplicateVote EvidenceType = "duplicate_vote"
+	// EvidenceTypeLightClientAttack is a EvidenceType of type light_client_attack.
+	EvidenceTypeLightClientAttack EvidenceType = "light_client_attack"
+)
+
+var ErrInvalidEvidenceType = errors.New("not a valid EvidenceType")
+
+// EvidenceTypeValues returns a list of the values for EvidenceType
+func EvidenceTypeValues() []EvidenceType {
+	return []EvidenceType{
+		EvidenceTypeDuplicateVote,
+		EvidenceTypeLightClientAttack,
+	}
+}
+
+// String implements the Stringer interface.
+func (x EvidenceType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x EvidenceType) IsValid() bool {
+	_, err := ParseEvidenceType(string(x))
+	return err == nil
+}
+
+var _EvidenceTypeValue = map[string]EvidenceType{
+	"duplicate_vote":      EvidenceTypeDuplicateVote,
+	"light_client_attack": EvidenceTypeLightClientAttack,
+}
+
+// ParseEvidenceType attempts to convert a string to a EvidenceType.
+func ParseEvidenceType(name string) (EvidenceType, error) {
+	if x, ok := _EvidenceTypeValue[name]; ok {
+		return x, nil
+	}
+	return EvidenceType(""), fmt.Errorf("%s is %w", name, ErrInvalidEvidenceType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x EvidenceType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *EvidenceType) UnmarshalText(text []byte) error {
+	tmp, err := ParseEvidenceType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errEvidenceTypeNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *EvidenceType) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = EvidenceType("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseEvidenceType(v)
+	case []byte:
+		*x, err = ParseEvidenceType(string(v))
+	case EvidenceType:
+		*x = v
+	case *EvidenceType:
+		if v == nil {
+			return errEvidenceTypeNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errEvidenceTypeNilPtr
+		}
+		*x, err = ParseEvidenceType(*v)
+	default:
+		return errors.New("invalid type for EvidenceType")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x EvidenceType) Value() (driver.Value, error) {
+	return x.String(), nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+const (
+	// FeeAllowanceTypeBasic is a FeeAllowanceType of type basic.
+	FeeAllowanceTypeBasic FeeAllowanceType = "basic"
+	// FeeAllowanceTypePeriodic is a FeeAllowanceType of type periodic.
+	FeeAllowanceTypePeriodic FeeAllowanceType = "periodic"
+	// FeeAllowanceTypeAllowedMsg is a FeeAllowanceType of type allowed_msg.
+	FeeAllowanceTypeAllowedMsg FeeAllowanceType = "allowed_msg"
+	// FeeAllowanceTypeUnknown is a FeeAllowanceType of type unknown.
+	FeeAllowanceTypeUnknown FeeAllowanceType = "unknown"
+)
+
+var ErrInvalidFeeAllowanceType = errors.New("not a valid FeeAllowanceType")
+
+// FeeAllowanceTypeValues returns a list of the values for FeeAllowanceType
+func FeeAllowanceTypeValues() []FeeAllowanceType {
+	return []FeeAllowanceType{
+		FeeAllowanceTypeBasic,
+		FeeAllowanceTypePeriodic,
+		FeeAllowanceTypeAllowedMsg,
+		FeeAllowanceTypeUnknown,
+	}
+}
+
+// String implements the Stringer interface.
+func (x FeeAllowanceType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x FeeAllowanceType) IsValid() bool {
+	_, err := ParseFeeAllowanceType(string(x))
+	return err == nil
+}
+
+var _FeeAllowanceTypeValue = map[string]FeeAllowanceType{
+	"basic":       FeeAllowanceTypeBasic,
+	"periodic":    FeeAllowanceTypePeriodic,
+	"allowed_msg": FeeAllowanceTypeAllowedMsg,
+	"unknown":     FeeAllowanceTypeUnknown,
+}
+
+// ParseFeeAllowanceType attempts to convert a string to a FeeAllowanceType.
+func ParseFeeAllowanceType(name string) (FeeAllowanceType, error) {
+	if x, ok := _FeeAllowanceTypeValue[name]; ok {
+		return x, nil
+	}
+	return FeeAllowanceType(""), fmt.Errorf("%s is %w", name, ErrInvalidFeeAllowanceType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x FeeAllowanceType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *FeeAllowanceType) UnmarshalText(text []byte) error {
+	tmp, err := ParseFeeAllowanceType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errFeeAllowanceTypeNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *FeeAllowanceType) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = FeeAllowanceType("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseFeeAllowanceType(v)
+	case []byte:
+		*x, err = ParseFeeAllowanceType(string(v))
+	case FeeAllowanceType:
+		*x = v
+	case *FeeAllowanceType:
+		if v == nil {
+			return errFeeAllowanceTypeNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errFeeAllowanceTypeNilPtr
+		}
+		*x, err = ParseFeeAllowanceType(*v)
+	default:
+		return errors.New("invalid type for FeeAllowanceType")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x FeeAllowanceType) Value() (driver.Value, error) {
+	return x.String(), nil
+}
@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum IbcTransferStatus
+/*
+	ENUM(
+		pending,
+		success,
+		failed,
+		timeout
+	)
+*/
+//go:generate go-enum --marshal --sql --values
+type IbcTransferStatus string
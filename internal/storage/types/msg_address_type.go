@@ -29,7 +29,19 @@ package types
 
 		sender,
 		receiver,
+
+		relayer,
 	)
 */
 //go:generate go-enum --marshal --sql --values
 type MsgAddressType string
+
+// IsValidator - returns true if address type points to validator operator address
+func (x MsgAddressType) IsValidator() bool {
+	switch x {
+	case MsgAddressTypeValidator, MsgAddressTypeValidatorSrc, MsgAddressTypeValidatorDst:
+		return true
+	default:
+		return false
+	}
+}
@@ -47,6 +47,28 @@ package types
 		MsgDeposit,
 
 		IBCTransfer,
+
+		MsgCreateClient,
+		MsgUpdateClient,
+		MsgUpgradeClient,
+		MsgSubmitMisbehaviour,
+
+		MsgConnectionOpenInit,
+		MsgConnectionOpenTry,
+		MsgConnectionOpenAck,
+		MsgConnectionOpenConfirm,
+
+		MsgChannelOpenInit,
+		MsgChannelOpenTry,
+		MsgChannelOpenAck,
+		MsgChannelOpenConfirm,
+		MsgChannelCloseInit,
+		MsgChannelCloseConfirm,
+
+		MsgRecvPacket,
+		MsgTimeout,
+		MsgTimeoutOnClose,
+		MsgAcknowledgement,
 	)
 */
 //go:generate go-enum --marshal --sql --values --noprefix
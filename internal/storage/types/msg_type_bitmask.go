@@ -54,6 +54,30 @@ const (
 	MsgTypeBitsVote
 	MsgTypeBitsVoteWeighted
 	MsgTypeBitsDeposit
+
+	MsgTypeBitsIBCTransfer
+
+	MsgTypeBitsCreateClient
+	MsgTypeBitsUpdateClient
+	MsgTypeBitsUpgradeClient
+	MsgTypeBitsSubmitMisbehaviour
+
+	MsgTypeBitsConnectionOpenInit
+	MsgTypeBitsConnectionOpenTry
+	MsgTypeBitsConnectionOpenAck
+	MsgTypeBitsConnectionOpenConfirm
+
+	MsgTypeBitsChannelOpenInit
+	MsgTypeBitsChannelOpenTry
+	MsgTypeBitsChannelOpenAck
+	MsgTypeBitsChannelOpenConfirm
+	MsgTypeBitsChannelCloseInit
+	MsgTypeBitsChannelCloseConfirm
+
+	MsgTypeBitsRecvPacket
+	MsgTypeBitsTimeout
+	MsgTypeBitsTimeoutOnClose
+	MsgTypeBitsAcknowledgement
 )
 
 func NewMsgTypeBitMask(values ...MsgType) MsgTypeBits {
@@ -135,6 +159,49 @@ func (mask *MsgTypeBits) SetBit(value MsgType) {
 	case MsgDeposit:
 		mask.Set(Bits(MsgTypeBitsDeposit))
 
+	case IBCTransfer:
+		mask.Set(Bits(MsgTypeBitsIBCTransfer))
+
+	case MsgCreateClient:
+		mask.Set(Bits(MsgTypeBitsCreateClient))
+	case MsgUpdateClient:
+		mask.Set(Bits(MsgTypeBitsUpdateClient))
+	case MsgUpgradeClient:
+		mask.Set(Bits(MsgTypeBitsUpgradeClient))
+	case MsgSubmitMisbehaviour:
+		mask.Set(Bits(MsgTypeBitsSubmitMisbehaviour))
+
+	case MsgConnectionOpenInit:
+		mask.Set(Bits(MsgTypeBitsConnectionOpenInit))
+	case MsgConnectionOpenTry:
+		mask.Set(Bits(MsgTypeBitsConnectionOpenTry))
+	case MsgConnectionOpenAck:
+		mask.Set(Bits(MsgTypeBitsConnectionOpenAck))
+	case MsgConnectionOpenConfirm:
+		mask.Set(Bits(MsgTypeBitsConnectionOpenConfirm))
+
+	case MsgChannelOpenInit:
+		mask.Set(Bits(MsgTypeBitsChannelOpenInit))
+	case MsgChannelOpenTry:
+		mask.Set(Bits(MsgTypeBitsChannelOpenTry))
+	case MsgChannelOpenAck:
+		mask.Set(Bits(MsgTypeBitsChannelOpenAck))
+	case MsgChannelOpenConfirm:
+		mask.Set(Bits(MsgTypeBitsChannelOpenConfirm))
+	case MsgChannelCloseInit:
+		mask.Set(Bits(MsgTypeBitsChannelCloseInit))
+	case MsgChannelCloseConfirm:
+		mask.Set(Bits(MsgTypeBitsChannelCloseConfirm))
+
+	case MsgRecvPacket:
+		mask.Set(Bits(MsgTypeBitsRecvPacket))
+	case MsgTimeout:
+		mask.Set(Bits(MsgTypeBitsTimeout))
+	case MsgTimeoutOnClose:
+		mask.Set(Bits(MsgTypeBitsTimeoutOnClose))
+	case MsgAcknowledgement:
+		mask.Set(Bits(MsgTypeBitsAcknowledgement))
+
 	}
 }
 
@@ -265,6 +332,87 @@ func (mask MsgTypeBits) Names() []MsgType {
 	}
 	if mask.Has(Bits(MsgTypeBitsDeposit)) {
 		names[i] = MsgDeposit
+		i++
+	}
+
+	if mask.Has(Bits(MsgTypeBitsIBCTransfer)) {
+		names[i] = IBCTransfer
+		i++
+	}
+
+	if mask.Has(Bits(MsgTypeBitsCreateClient)) {
+		names[i] = MsgCreateClient
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsUpdateClient)) {
+		names[i] = MsgUpdateClient
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsUpgradeClient)) {
+		names[i] = MsgUpgradeClient
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsSubmitMisbehaviour)) {
+		names[i] = MsgSubmitMisbehaviour
+		i++
+	}
+
+	if mask.Has(Bits(MsgTypeBitsConnectionOpenInit)) {
+		names[i] = MsgConnectionOpenInit
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsConnectionOpenTry)) {
+		names[i] = MsgConnectionOpenTry
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsConnectionOpenAck)) {
+		names[i] = MsgConnectionOpenAck
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsConnectionOpenConfirm)) {
+		names[i] = MsgConnectionOpenConfirm
+		i++
+	}
+
+	if mask.Has(Bits(MsgTypeBitsChannelOpenInit)) {
+		names[i] = MsgChannelOpenInit
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsChannelOpenTry)) {
+		names[i] = MsgChannelOpenTry
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsChannelOpenAck)) {
+		names[i] = MsgChannelOpenAck
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsChannelOpenConfirm)) {
+		names[i] = MsgChannelOpenConfirm
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsChannelCloseInit)) {
+		names[i] = MsgChannelCloseInit
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsChannelCloseConfirm)) {
+		names[i] = MsgChannelCloseConfirm
+		i++
+	}
+
+	if mask.Has(Bits(MsgTypeBitsRecvPacket)) {
+		names[i] = MsgRecvPacket
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsTimeout)) {
+		names[i] = MsgTimeout
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsTimeoutOnClose)) {
+		names[i] = MsgTimeoutOnClose
+		i++
+	}
+	if mask.Has(Bits(MsgTypeBitsAcknowledgement)) {
+		names[i] = MsgAcknowledgement
 		// i++
 	}
 
@@ -131,6 +131,86 @@ func TestMsgTypeBits_Names(t *testing.T) {
 			name: string(MsgDeposit),
 			Bits: Bits(MsgTypeBitsDeposit),
 			want: []MsgType{MsgDeposit},
+		}, {
+			name: string(IBCTransfer),
+			Bits: Bits(MsgTypeBitsIBCTransfer),
+			want: []MsgType{IBCTransfer},
+		}, {
+			name: string(MsgCreateClient),
+			Bits: Bits(MsgTypeBitsCreateClient),
+			want: []MsgType{MsgCreateClient},
+		}, {
+			name: string(MsgUpdateClient),
+			Bits: Bits(MsgTypeBitsUpdateClient),
+			want: []MsgType{MsgUpdateClient},
+		}, {
+			name: string(MsgUpgradeClient),
+			Bits: Bits(MsgTypeBitsUpgradeClient),
+			want: []MsgType{MsgUpgradeClient},
+		}, {
+			name: string(MsgSubmitMisbehaviour),
+			Bits: Bits(MsgTypeBitsSubmitMisbehaviour),
+			want: []MsgType{MsgSubmitMisbehaviour},
+		}, {
+			name: string(MsgConnectionOpenInit),
+			Bits: Bits(MsgTypeBitsConnectionOpenInit),
+			want: []MsgType{MsgConnectionOpenInit},
+		}, {
+			name: string(MsgConnectionOpenTry),
+			Bits: Bits(MsgTypeBitsConnectionOpenTry),
+			want: []MsgType{MsgConnectionOpenTry},
+		}, {
+			name: string(MsgConnectionOpenAck),
+			Bits: Bits(MsgTypeBitsConnectionOpenAck),
+			want: []MsgType{MsgConnectionOpenAck},
+		}, {
+			name: string(MsgConnectionOpenConfirm),
+			Bits: Bits(MsgTypeBitsConnectionOpenConfirm),
+			want: []MsgType{MsgConnectionOpenConfirm},
+		}, {
+			name: string(MsgChannelOpenInit),
+			Bits: Bits(MsgTypeBitsChannelOpenInit),
+			want: []MsgType{MsgChannelOpenInit},
+		}, {
+			name: string(MsgChannelOpenTry),
+			Bits: Bits(MsgTypeBitsChannelOpenTry),
+			want: []MsgType{MsgChannelOpenTry},
+		}, {
+			name: string(MsgChannelOpenAck),
+			Bits: Bits(MsgTypeBitsChannelOpenAck),
+			want: []MsgType{MsgChannelOpenAck},
+		}, {
+			name: string(MsgChannelOpenConfirm),
+			Bits: Bits(MsgTypeBitsChannelOpenConfirm),
+			want: []MsgType{MsgChannelOpenConfirm},
+		}, {
+			name: string(MsgChannelCloseInit),
+			Bits: Bits(MsgTypeBitsChannelCloseInit),
+			want: []MsgType{MsgChannelCloseInit},
+		}, {
+			name: string(MsgChannelCloseConfirm),
+			Bits: Bits(MsgTypeBitsChannelCloseConfirm),
+			want: []MsgType{MsgChannelCloseConfirm},
+		}, {
+			name: string(MsgRecvPacket),
+			Bits: Bits(MsgTypeBitsRecvPacket),
+			want: []MsgType{MsgRecvPacket},
+		}, {
+			name: string(MsgTimeout),
+			Bits: Bits(MsgTypeBitsTimeout),
+			want: []MsgType{MsgTimeout},
+		}, {
+			name: string(MsgTimeoutOnClose),
+			Bits: Bits(MsgTypeBitsTimeoutOnClose),
+			want: []MsgType{MsgTimeoutOnClose},
+		}, {
+			name: string(MsgAcknowledgement),
+			Bits: Bits(MsgTypeBitsAcknowledgement),
+			want: []MsgType{MsgAcknowledgement},
+		}, {
+			name: "ibc relaying",
+			Bits: Bits(MsgTypeBitsUpdateClient | MsgTypeBitsRecvPacket | MsgTypeBitsAcknowledgement),
+			want: []MsgType{MsgUpdateClient, MsgRecvPacket, MsgAcknowledgement},
 		},
 	}
 	for _, tt := range tests {
@@ -273,6 +353,82 @@ func TestNewMsgTypeBitMask(t *testing.T) {
 			name:   "test 30",
 			values: []MsgType{MsgWithdrawDelegatorReward, MsgBeginRedelegate},
 			want:   MsgTypeBits{Bits(260)},
+		}, {
+			name:   "test 31",
+			values: []MsgType{IBCTransfer},
+			want:   MsgTypeBits{Bits(MsgTypeBitsIBCTransfer)},
+		}, {
+			name:   "test 32",
+			values: []MsgType{MsgCreateClient},
+			want:   MsgTypeBits{Bits(MsgTypeBitsCreateClient)},
+		}, {
+			name:   "test 33",
+			values: []MsgType{MsgUpdateClient},
+			want:   MsgTypeBits{Bits(MsgTypeBitsUpdateClient)},
+		}, {
+			name:   "test 34",
+			values: []MsgType{MsgUpgradeClient},
+			want:   MsgTypeBits{Bits(MsgTypeBitsUpgradeClient)},
+		}, {
+			name:   "test 35",
+			values: []MsgType{MsgSubmitMisbehaviour},
+			want:   MsgTypeBits{Bits(MsgTypeBitsSubmitMisbehaviour)},
+		}, {
+			name:   "test 36",
+			values: []MsgType{MsgConnectionOpenInit},
+			want:   MsgTypeBits{Bits(MsgTypeBitsConnectionOpenInit)},
+		}, {
+			name:   "test 37",
+			values: []MsgType{MsgConnectionOpenTry},
+			want:   MsgTypeBits{Bits(MsgTypeBitsConnectionOpenTry)},
+		}, {
+			name:   "test 38",
+			values: []MsgType{MsgConnectionOpenAck},
+			want:   MsgTypeBits{Bits(MsgTypeBitsConnectionOpenAck)},
+		}, {
+			name:   "test 39",
+			values: []MsgType{MsgConnectionOpenConfirm},
+			want:   MsgTypeBits{Bits(MsgTypeBitsConnectionOpenConfirm)},
+		}, {
+			name:   "test 40",
+			values: []MsgType{MsgChannelOpenInit},
+			want:   MsgTypeBits{Bits(MsgTypeBitsChannelOpenInit)},
+		}, {
+			name:   "test 41",
+			values: []MsgType{MsgChannelOpenTry},
+			want:   MsgTypeBits{Bits(MsgTypeBitsChannelOpenTry)},
+		}, {
+			name:   "test 42",
+			values: []MsgType{MsgChannelOpenAck},
+			want:   MsgTypeBits{Bits(MsgTypeBitsChannelOpenAck)},
+		}, {
+			name:   "test 43",
+			values: []MsgType{MsgChannelOpenConfirm},
+			want:   MsgTypeBits{Bits(MsgTypeBitsChannelOpenConfirm)},
+		}, {
+			name:   "test 44",
+			values: []MsgType{MsgChannelCloseInit},
+			want:   MsgTypeBits{Bits(MsgTypeBitsChannelCloseInit)},
+		}, {
+			name:   "test 45",
+			values: []MsgType{MsgChannelCloseConfirm},
+			want:   MsgTypeBits{Bits(MsgTypeBitsChannelCloseConfirm)},
+		}, {
+			name:   "test 46",
+			values: []MsgType{MsgRecvPacket},
+			want:   MsgTypeBits{Bits(MsgTypeBitsRecvPacket)},
+		}, {
+			name:   "test 47",
+			values: []MsgType{MsgTimeout},
+			want:   MsgTypeBits{Bits(MsgTypeBitsTimeout)},
+		}, {
+			name:   "test 48",
+			values: []MsgType{MsgTimeoutOnClose},
+			want:   MsgTypeBits{Bits(MsgTypeBitsTimeoutOnClose)},
+		}, {
+			name:   "test 49",
+			values: []MsgType{MsgAcknowledgement},
+			want:   MsgTypeBits{Bits(MsgTypeBitsAcknowledgement)},
 		},
 	}
 	for _, tt := range tests {
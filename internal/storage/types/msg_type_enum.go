@@ -73,6 +73,42 @@ const (
 	MsgDeposit MsgType = "MsgDeposit"
 	// IBCTransfer is a MsgType of type IBCTransfer.
 	IBCTransfer MsgType = "IBCTransfer"
+	// MsgCreateClient is a MsgType of type MsgCreateClient.
+	MsgCreateClient MsgType = "MsgCreateClient"
+	// MsgUpdateClient is a MsgType of type MsgUpdateClient.
+	MsgUpdateClient MsgType = "MsgUpdateClient"
+	// MsgUpgradeClient is a MsgType of type MsgUpgradeClient.
+	MsgUpgradeClient MsgType = "MsgUpgradeClient"
+	// MsgSubmitMisbehaviour is a MsgType of type MsgSubmitMisbehaviour.
+	MsgSubmitMisbehaviour MsgType = "MsgSubmitMisbehaviour"
+	// MsgConnectionOpenInit is a MsgType of type MsgConnectionOpenInit.
+	MsgConnectionOpenInit MsgType = "MsgConnectionOpenInit"
+	// MsgConnectionOpenTry is a MsgType of type MsgConnectionOpenTry.
+	MsgConnectionOpenTry MsgType = "MsgConnectionOpenTry"
+	// MsgConnectionOpenAck is a MsgType of type MsgConnectionOpenAck.
+	MsgConnectionOpenAck MsgType = "MsgConnectionOpenAck"
+	// MsgConnectionOpenConfirm is a MsgType of type MsgConnectionOpenConfirm.
+	MsgConnectionOpenConfirm MsgType = "MsgConnectionOpenConfirm"
+	// MsgChannelOpenInit is a MsgType of type MsgChannelOpenInit.
+	MsgChannelOpenInit MsgType = "MsgChannelOpenInit"
+	// MsgChannelOpenTry is a MsgType of type MsgChannelOpenTry.
+	MsgChannelOpenTry MsgType = "MsgChannelOpenTry"
+	// MsgChannelOpenAck is a MsgType of type MsgChannelOpenAck.
+	MsgChannelOpenAck MsgType = "MsgChannelOpenAck"
+	// MsgChannelOpenConfirm is a MsgType of type MsgChannelOpenConfirm.
+	MsgChannelOpenConfirm MsgType = "MsgChannelOpenConfirm"
+	// MsgChannelCloseInit is a MsgType of type MsgChannelCloseInit.
+	MsgChannelCloseInit MsgType = "MsgChannelCloseInit"
+	// MsgChannelCloseConfirm is a MsgType of type MsgChannelCloseConfirm.
+	MsgChannelCloseConfirm MsgType = "MsgChannelCloseConfirm"
+	// MsgRecvPacket is a MsgType of type MsgRecvPacket.
+	MsgRecvPacket MsgType = "MsgRecvPacket"
+	// MsgTimeout is a MsgType of type MsgTimeout.
+	MsgTimeout MsgType = "MsgTimeout"
+	// MsgTimeoutOnClose is a MsgType of type MsgTimeoutOnClose.
+	MsgTimeoutOnClose MsgType = "MsgTimeoutOnClose"
+	// MsgAcknowledgement is a MsgType of type MsgAcknowledgement.
+	MsgAcknowledgement MsgType = "MsgAcknowledgement"
 )
 
 var ErrInvalidMsgType = errors.New("not a valid MsgType")
@@ -110,6 +146,24 @@ func MsgTypeValues() []MsgType {
 		MsgVoteWeighted,
 		MsgDeposit,
 		IBCTransfer,
+		MsgCreateClient,
+		MsgUpdateClient,
+		MsgUpgradeClient,
+		MsgSubmitMisbehaviour,
+		MsgConnectionOpenInit,
+		MsgConnectionOpenTry,
+		MsgConnectionOpenAck,
+		MsgConnectionOpenConfirm,
+		MsgChannelOpenInit,
+		MsgChannelOpenTry,
+		MsgChannelOpenAck,
+		MsgChannelOpenConfirm,
+		MsgChannelCloseInit,
+		MsgChannelCloseConfirm,
+		MsgRecvPacket,
+		MsgTimeout,
+		MsgTimeoutOnClose,
+		MsgAcknowledgement,
 	}
 }
 
@@ -156,6 +210,24 @@ var _MsgTypeValue = map[string]MsgType{
 	"MsgVoteWeighted":                 MsgVoteWeighted,
 	"MsgDeposit":                      MsgDeposit,
 	"IBCTransfer":                     IBCTransfer,
+	"MsgCreateClient":                 MsgCreateClient,
+	"MsgUpdateClient":                 MsgUpdateClient,
+	"MsgUpgradeClient":                MsgUpgradeClient,
+	"MsgSubmitMisbehaviour":           MsgSubmitMisbehaviour,
+	"MsgConnectionOpenInit":           MsgConnectionOpenInit,
+	"MsgConnectionOpenTry":            MsgConnectionOpenTry,
+	"MsgConnectionOpenAck":            MsgConnectionOpenAck,
+	"MsgConnectionOpenConfirm":        MsgConnectionOpenConfirm,
+	"MsgChannelOpenInit":              MsgChannelOpenInit,
+	"MsgChannelOpenTry":               MsgChannelOpenTry,
+	"MsgChannelOpenAck":               MsgChannelOpenAck,
+	"MsgChannelOpenConfirm":           MsgChannelOpenConfirm,
+	"MsgChannelCloseInit":             MsgChannelCloseInit,
+	"MsgChannelCloseConfirm":          MsgChannelCloseConfirm,
+	"MsgRecvPacket":                   MsgRecvPacket,
+	"MsgTimeout":                      MsgTimeout,
+	"MsgTimeoutOnClose":               MsgTimeoutOnClose,
+	"MsgAcknowledgement":              MsgAcknowledgement,
 }
 
 // ParseMsgType attempts to convert a string to a MsgType.
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum ProposalStatus
+/*
+	ENUM(
+		deposit_period,
+		voting_period,
+		passed,
+		rejected,
+		failed,
+		removed
+	)
+*/
+//go:generate go-enum --marshal --sql --values
+type ProposalStatus string
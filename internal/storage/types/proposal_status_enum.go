@@ -0,0 +1,126 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+const (
+	// ProposalStatusDepositPeriod is a ProposalStatus of type deposit_period.
+	ProposalStatusDepositPeriod ProposalStatus = "deposit_period"
+	// ProposalStatusVotingPeriod is a ProposalStatus of type voting_period.
+	ProposalStatusVotingPeriod ProposalStatus = "voting_period"
+	// ProposalStatusPassed is a ProposalStatus of type passed.
+	ProposalStatusPassed ProposalStatus = "passed"
+	// ProposalStatusRejected is a ProposalStatus of type rejected.
+	ProposalStatusRejected ProposalStatus = "rejected"
+	// ProposalStatusFailed is a ProposalStatus of type failed.
+	ProposalStatusFailed ProposalStatus = "failed"
+	// ProposalStatusRemoved is a ProposalStatus of type removed.
+	ProposalStatusRemoved ProposalStatus = "removed"
+)
+
+var ErrInvalidProposalStatus = errors.New("not a valid ProposalStatus")
+
+// ProposalStatusValues returns a list of the values for ProposalStatus
+func ProposalStatusValues() []ProposalStatus {
+	return []ProposalStatus{
+		ProposalStatusDepositPeriod,
+		ProposalStatusVotingPeriod,
+		ProposalStatusPassed,
+		ProposalStatusRejected,
+		ProposalStatusFailed,
+		ProposalStatusRemoved,
+	}
+}
+
+// String implements the Stringer interface.
+func (x ProposalStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ProposalStatus) IsValid() bool {
+	_, err := ParseProposalStatus(string(x))
+	return err == nil
+}
+
+var _ProposalStatusValue = map[string]ProposalStatus{
+	"deposit_period": ProposalStatusDepositPeriod,
+	"voting_period":  ProposalStatusVotingPeriod,
+	"passed":         ProposalStatusPassed,
+	"rejected":       ProposalStatusRejected,
+	"failed":         ProposalStatusFailed,
+	"removed":        ProposalStatusRemoved,
+}
+
+// ParseProposalStatus attempts to convert a string to a ProposalStatus.
+func ParseProposalStatus(name string) (ProposalStatus, error) {
+	if x, ok := _ProposalStatusValue[name]; ok {
+		return x, nil
+	}
+	return ProposalStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidProposalStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ProposalStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ProposalStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseProposalStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errProposalStatusNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *ProposalStatus) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = ProposalStatus("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseProposalStatus(v)
+	case []byte:
+		*x, err = ParseProposalStatus(string(v))
+	case ProposalStatus:
+		*x = v
+	case *ProposalStatus:
+		if v == nil {
+			return errProposalStatusNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errProposalStatusNilPtr
+		}
+		*x, err = ParseProposalStatus(*v)
+	default:
+		return errors.New("invalid type for ProposalStatus")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x ProposalStatus) Value() (driver.Value, error) {
+	return x.String(), nil
+}
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum ProposalType
+/*
+	ENUM(
+		text,
+		param_changed,
+		community_pool_spend,
+		client_update,
+		unknown
+	)
+*/
+//go:generate go-enum --marshal --sql --values
+type ProposalType string
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum ValidatorHistoryType
+/*
+	ENUM(
+		power_change,
+		slash,
+		jail
+	)
+*/
+//go:generate go-enum --marshal --sql --values
+type ValidatorHistoryType string
@@ -0,0 +1,114 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+const (
+	// ValidatorHistoryTypePowerChange is a ValidatorHistoryType of type power_change.
+	ValidatorHistoryTypePowerChange ValidatorHistoryType = "power_change"
+	// ValidatorHistoryTypeSlash is a ValidatorHistoryType of type slash.
+	ValidatorHistoryTypeSlash ValidatorHistoryType = "slash"
+	// ValidatorHistoryTypeJail is a ValidatorHistoryType of type jail.
+	ValidatorHistoryTypeJail ValidatorHistoryType = "jail"
+)
+
+var ErrInvalidValidatorHistoryType = errors.New("not a valid ValidatorHistoryType")
+
+// ValidatorHistoryTypeValues returns a list of the values for ValidatorHistoryType
+func ValidatorHistoryTypeValues() []ValidatorHistoryType {
+	return []ValidatorHistoryType{
+		ValidatorHistoryTypePowerChange,
+		ValidatorHistoryTypeSlash,
+		ValidatorHistoryTypeJail,
+	}
+}
+
+// String implements the Stringer interface.
+func (x ValidatorHistoryType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ValidatorHistoryType) IsValid() bool {
+	_, err := ParseValidatorHistoryType(string(x))
+	return err == nil
+}
+
+var _ValidatorHistoryTypeValue = map[string]ValidatorHistoryType{
+	"power_change": ValidatorHistoryTypePowerChange,
+	"slash":        ValidatorHistoryTypeSlash,
+	"jail":         ValidatorHistoryTypeJail,
+}
+
+// ParseValidatorHistoryType attempts to convert a string to a ValidatorHistoryType.
+func ParseValidatorHistoryType(name string) (ValidatorHistoryType, error) {
+	if x, ok := _ValidatorHistoryTypeValue[name]; ok {
+		return x, nil
+	}
+	return ValidatorHistoryType(""), fmt.Errorf("%s is %w", name, ErrInvalidValidatorHistoryType)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ValidatorHistoryType) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ValidatorHistoryType) UnmarshalText(text []byte) error {
+	tmp, err := ParseValidatorHistoryType(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errValidatorHistoryTypeNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *ValidatorHistoryType) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = ValidatorHistoryType("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseValidatorHistoryType(v)
+	case []byte:
+		*x, err = ParseValidatorHistoryType(string(v))
+	case ValidatorHistoryType:
+		*x = v
+	case *ValidatorHistoryType:
+		if v == nil {
+			return errValidatorHistoryTypeNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errValidatorHistoryTypeNilPtr
+		}
+		*x, err = ParseValidatorHistoryType(*v)
+	default:
+		return errors.New("invalid type for ValidatorHistoryType")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x ValidatorHistoryType) Value() (driver.Value, error) {
+	return x.String(), nil
+}
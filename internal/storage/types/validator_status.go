@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum ValidatorStatus
+/*
+	ENUM(
+		unbonded,
+		unbonding,
+		bonded,
+		jailed,
+		tombstoned
+	)
+*/
+//go:generate go-enum --marshal --sql --values
+type ValidatorStatus string
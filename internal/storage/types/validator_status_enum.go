@@ -0,0 +1,122 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+const (
+	// ValidatorStatusUnbonded is a ValidatorStatus of type unbonded.
+	ValidatorStatusUnbonded ValidatorStatus = "unbonded"
+	// ValidatorStatusUnbonding is a ValidatorStatus of type unbonding.
+	ValidatorStatusUnbonding ValidatorStatus = "unbonding"
+	// ValidatorStatusBonded is a ValidatorStatus of type bonded.
+	ValidatorStatusBonded ValidatorStatus = "bonded"
+	// ValidatorStatusJailed is a ValidatorStatus of type jailed.
+	ValidatorStatusJailed ValidatorStatus = "jailed"
+	// ValidatorStatusTombstoned is a ValidatorStatus of type tombstoned.
+	ValidatorStatusTombstoned ValidatorStatus = "tombstoned"
+)
+
+var ErrInvalidValidatorStatus = errors.New("not a valid ValidatorStatus")
+
+// ValidatorStatusValues returns a list of the values for ValidatorStatus
+func ValidatorStatusValues() []ValidatorStatus {
+	return []ValidatorStatus{
+		ValidatorStatusUnbonded,
+		ValidatorStatusUnbonding,
+		ValidatorStatusBonded,
+		ValidatorStatusJailed,
+		ValidatorStatusTombstoned,
+	}
+}
+
+// String implements the Stringer interface.
+func (x ValidatorStatus) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ValidatorStatus) IsValid() bool {
+	_, err := ParseValidatorStatus(string(x))
+	return err == nil
+}
+
+var _ValidatorStatusValue = map[string]ValidatorStatus{
+	"unbonded":   ValidatorStatusUnbonded,
+	"unbonding":  ValidatorStatusUnbonding,
+	"bonded":     ValidatorStatusBonded,
+	"jailed":     ValidatorStatusJailed,
+	"tombstoned": ValidatorStatusTombstoned,
+}
+
+// ParseValidatorStatus attempts to convert a string to a ValidatorStatus.
+func ParseValidatorStatus(name string) (ValidatorStatus, error) {
+	if x, ok := _ValidatorStatusValue[name]; ok {
+		return x, nil
+	}
+	return ValidatorStatus(""), fmt.Errorf("%s is %w", name, ErrInvalidValidatorStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x ValidatorStatus) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ValidatorStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseValidatorStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errValidatorStatusNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *ValidatorStatus) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = ValidatorStatus("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseValidatorStatus(v)
+	case []byte:
+		*x, err = ParseValidatorStatus(string(v))
+	case ValidatorStatus:
+		*x = v
+	case *ValidatorStatus:
+		if v == nil {
+			return errValidatorStatusNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errValidatorStatusNilPtr
+		}
+		*x, err = ParseValidatorStatus(*v)
+	default:
+		return errors.New("invalid type for ValidatorStatus")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x ValidatorStatus) Value() (driver.Value, error) {
+	return x.String(), nil
+}
@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// swagger:enum VoteOption
+/*
+	ENUM(
+		yes,
+		abstain,
+		no,
+		no_with_veto
+	)
+*/
+//go:generate go-enum --marshal --sql --values
+type VoteOption string
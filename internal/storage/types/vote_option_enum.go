@@ -0,0 +1,118 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: 0.5.7
+// Revision: bf63e108589bbd2327b13ec2c5da532aad234029
+// Build Date: 2023-07-25T23:27:55Z
+// Built By: goreleaser
+
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+const (
+	// VoteOptionYes is a VoteOption of type yes.
+	VoteOptionYes VoteOption = "yes"
+	// VoteOptionAbstain is a VoteOption of type abstain.
+	VoteOptionAbstain VoteOption = "abstain"
+	// VoteOptionNo is a VoteOption of type no.
+	VoteOptionNo VoteOption = "no"
+	// VoteOptionNoWithVeto is a VoteOption of type no_with_veto.
+	VoteOptionNoWithVeto VoteOption = "no_with_veto"
+)
+
+var ErrInvalidVoteOption = errors.New("not a valid VoteOption")
+
+// VoteOptionValues returns a list of the values for VoteOption
+func VoteOptionValues() []VoteOption {
+	return []VoteOption{
+		VoteOptionYes,
+		VoteOptionAbstain,
+		VoteOptionNo,
+		VoteOptionNoWithVeto,
+	}
+}
+
+// String implements the Stringer interface.
+func (x VoteOption) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x VoteOption) IsValid() bool {
+	_, err := ParseVoteOption(string(x))
+	return err == nil
+}
+
+var _VoteOptionValue = map[string]VoteOption{
+	"yes":          VoteOptionYes,
+	"abstain":      VoteOptionAbstain,
+	"no":           VoteOptionNo,
+	"no_with_veto": VoteOptionNoWithVeto,
+}
+
+// ParseVoteOption attempts to convert a string to a VoteOption.
+func ParseVoteOption(name string) (VoteOption, error) {
+	if x, ok := _VoteOptionValue[name]; ok {
+		return x, nil
+	}
+	return VoteOption(""), fmt.Errorf("%s is %w", name, ErrInvalidVoteOption)
+}
+
+// MarshalText implements the text marshaller method.
+func (x VoteOption) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *VoteOption) UnmarshalText(text []byte) error {
+	tmp, err := ParseVoteOption(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+var errVoteOptionNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *VoteOption) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = VoteOption("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseVoteOption(v)
+	case []byte:
+		*x, err = ParseVoteOption(string(v))
+	case VoteOption:
+		*x = v
+	case *VoteOption:
+		if v == nil {
+			return errVoteOptionNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errVoteOptionNilPtr
+		}
+		*x, err = ParseVoteOption(*v)
+	default:
+		return errors.New("invalid type for VoteOption")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x VoteOption) Value() (driver.Value, error) {
+	return x.String(), nil
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IUnbonding interface {
+	storage.Table[*Unbonding]
+
+	ByAddress(ctx context.Context, addressId uint64, limit, offset int) ([]Unbonding, error)
+}
+
+// Unbonding - unbonding delegation entry created by `MsgUndelegate`
+type Unbonding struct {
+	bun.BaseModel `bun:"unbonding" comment:"Table with unbonding delegations."`
+
+	Id             uint64          `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height         pkgTypes.Level  `bun:"height,notnull"              comment:"The number (height) of block where unbonding was started"`
+	Time           time.Time       `bun:"time,pk,notnull"             comment:"The time of block"`
+	AddressId      uint64          `bun:"address_id,notnull"          comment:"Delegator internal identity"`
+	Validator      string          `bun:"validator,type:text"         comment:"Validator operator address"`
+	Amount         decimal.Decimal `bun:"amount,type:numeric"         comment:"Unbonding amount. It's decreased by cancellation of unbonding."`
+	CompletionTime time.Time       `bun:"completion_time"             comment:"The time when unbonding will be completed"`
+	TxId           uint64          `bun:"tx_id"                       comment:"Transaction id"`
+	MsgId          uint64          `bun:"msg_id"                      comment:"Message id"`
+
+	Address *Address `bun:"rel:belongs-to,join:address_id=id"`
+}
+
+// TableName -
+func (Unbonding) TableName() string {
+	return "unbonding"
+}
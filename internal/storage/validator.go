@@ -5,6 +5,8 @@ package storage
 
 import (
 	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
 	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
 
 	"github.com/dipdup-net/indexer-sdk/pkg/storage"
@@ -12,11 +14,21 @@ import (
 	"github.com/uptrace/bun"
 )
 
+type ValidatorFilter struct {
+	Limit     int
+	Offset    int
+	Sort      storage.SortOrder
+	SortField string
+}
+
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
 type IValidator interface {
 	storage.Table[*Validator]
 
 	ByAddress(ctx context.Context, address string) (Validator, error)
+	Filter(ctx context.Context, fltrs ValidatorFilter) ([]Validator, error)
+	Messages(ctx context.Context, address string, limit, offset int) ([]ValidatorMessage, error)
+	History(ctx context.Context, validatorId uint64, limit, offset int) ([]ValidatorHistory, error)
 }
 
 type Validator struct {
@@ -26,6 +38,10 @@ type Validator struct {
 	Delegator string `bun:"delegator,type:text"                        comment:"Delegator address"`
 	Address   string `bun:"address,unique:address_validator,type:text" comment:"Validator address"`
 
+	ConsAddress pkgTypes.Hex          `bun:"cons_address"                 comment:"Consensus address"`
+	Status      types.ValidatorStatus `bun:"status,type:validator_status" comment:"Validator status"`
+	Power       int64                 `bun:"power"                        comment:"Current voting power"`
+
 	Moniker  string `bun:"moniker,type:text"  comment:"Human-readable name for the validator"`
 	Website  string `bun:"website,type:text"  comment:"Website link"`
 	Identity string `bun:"identity,type:text" comment:"Optional identity signature"`
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+type ValidatorHistory struct {
+	bun.BaseModel `bun:"validator_history" comment:"Table with history of validator power and status changes"`
+
+	Id          uint64                     `bun:"id,pk,notnull,autoincrement"       comment:"Unique internal identity"`
+	Height      pkgTypes.Level             `bun:"height,notnull"                    comment:"Block height"`
+	Time        time.Time                  `bun:"time,pk,notnull"                   comment:"Block time"`
+	ValidatorId uint64                     `bun:"validator_id"                      comment:"Validator internal id"`
+	Type        types.ValidatorHistoryType `bun:"type,type:validator_history_type"  comment:"Type of the change"`
+	Power       int64                      `bun:"power"                             comment:"Voting power after the change"`
+	PrevPower   int64                      `bun:"prev_power"                        comment:"Voting power before the change"`
+	Status      types.ValidatorStatus      `bun:"status,type:validator_status"      comment:"Validator status after the change"`
+	PrevStatus  types.ValidatorStatus      `bun:"prev_status,type:validator_status" comment:"Validator status before the change"`
+	Reason      string                     `bun:"reason,type:text"                  comment:"Slashing reason"`
+	SlashPower  int64                      `bun:"slash_power"                       comment:"Voting power at the moment of infraction"`
+	BurnedCoins decimal.Decimal            `bun:"burned_coins,type:numeric"         comment:"Amount of burned coins by slashing"`
+
+	ConsAddress pkgTypes.Hex `bun:"-"` // internal field for matching with validator
+
+	Validator *Validator `bun:"rel:belongs-to,join:validator_id=id"`
+}
+
+func (ValidatorHistory) TableName() string {
+	return "validator_history"
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/uptrace/bun"
+)
+
+type ValidatorMessage struct {
+	bun.BaseModel `bun:"validator_message" comment:"Table with relation messages to validator"`
+
+	Validator string               `bun:"validator,pk,type:text"    comment:"Validator operator address"`
+	MsgId     uint64               `bun:"msg_id,pk"                 comment:"Message internal id"`
+	Type      types.MsgAddressType `bun:",pk,type:msg_address_type" comment:"The reason why validator link to message"`
+
+	Time   time.Time      `bun:"time,notnull" comment:"Message time"`
+	Height pkgTypes.Level `bun:"height"       comment:"Message block height"`
+
+	Msg *Message `bun:"rel:belongs-to,join:msg_id=id"`
+}
+
+func (ValidatorMessage) TableName() string {
+	return "validator_message"
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type IVote interface {
+	storage.Table[*Vote]
+
+	ByProposal(ctx context.Context, proposalId uint64, limit, offset int) ([]Vote, error)
+	ByVoter(ctx context.Context, voterId uint64, limit, offset int) ([]Vote, error)
+	VoteCounts(ctx context.Context, proposalId uint64) ([]VoteCount, error)
+}
+
+// Vote - option chosen by voter. Weighted vote is stored as one entry per option. Only the last vote of the voter is counted in vote counts.
+type Vote struct {
+	bun.BaseModel `bun:"vote" comment:"Table with governance votes."`
+
+	Id         uint64           `bun:"id,pk,notnull,autoincrement" comment:"Unique internal identity"`
+	Height     pkgTypes.Level   `bun:"height,notnull"              comment:"The number (height) of block where vote was cast"`
+	Time       time.Time        `bun:"time,pk,notnull"             comment:"The time of block"`
+	ProposalId uint64           `bun:"proposal_id"                 comment:"Proposal id"`
+	VoterId    uint64           `bun:"voter_id"                    comment:"Voter internal identity"`
+	Option     types.VoteOption `bun:"option,type:vote_option"     comment:"Vote option"`
+	Weight     decimal.Decimal  `bun:"weight,type:numeric"         comment:"Weight of the option"`
+	TxId       uint64           `bun:"tx_id"                       comment:"Transaction id"`
+	MsgId      uint64           `bun:"msg_id"                      comment:"Message id"`
+
+	Voter *Address `bun:"rel:belongs-to,join:voter_id=id"`
+}
+
+// TableName -
+func (Vote) TableName() string {
+	return "vote"
+}
+
+// VoteCount - count of voters which chose the option in their last vote and summary weight of the option in these votes.
+// Weight is a sum of option weights of voters, it isn't weighted by stake.
+type VoteCount struct {
+	Option types.VoteOption `bun:"option"`
+	Weight decimal.Decimal  `bun:"weight"`
+	Count  int64            `bun:"count"`
+}
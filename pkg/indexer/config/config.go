@@ -16,10 +16,34 @@ type Config struct {
 }
 
 type Indexer struct {
-	Name         string `validate:"omitempty"       yaml:"name"`
-	ThreadsCount uint32 `validate:"omitempty,min=1" yaml:"threads_count"`
-	StartLevel   int64  `validate:"omitempty"       yaml:"start_level"`
-	BlockPeriod  int64  `validate:"omitempty"       yaml:"block_period"`
+	Name               string   `validate:"omitempty"               yaml:"name"`
+	ThreadsCount       uint32   `validate:"omitempty,min=1"         yaml:"threads_count"`
+	ParserThreadsCount uint32   `validate:"omitempty,min=1"         yaml:"parser_threads_count"`
+	StartLevel         int64    `validate:"omitempty"               yaml:"start_level"`
+	EndLevel           int64    `validate:"omitempty"               yaml:"end_level"`
+	BlockPeriod        int64    `validate:"omitempty"               yaml:"block_period"`
+	Subscribe          bool     `validate:"omitempty"               yaml:"subscribe"`
+	FetchBatchSize     uint32   `validate:"omitempty,min=1,max=100" yaml:"fetch_batch_size"`
+	Datasources        []string `validate:"omitempty"               yaml:"datasources"`
+	Retry              Retry    `validate:"omitempty"               yaml:"retry"`
+	Batch              Batch    `validate:"omitempty"               yaml:"batch"`
+	MaxRollbackDepth   uint64   `validate:"omitempty,min=1"         yaml:"max_rollback_depth"`
+	RecordDir          string   `validate:"omitempty"               yaml:"record_dir"`
+}
+
+// Retry - policy of retrying failed node requests. Delays are in milliseconds.
+type Retry struct {
+	Count    uint32 `validate:"omitempty,min=1" yaml:"count"`
+	Delay    int64  `validate:"omitempty,min=1" yaml:"delay"`
+	MaxDelay int64  `validate:"omitempty,min=1" yaml:"max_delay"`
+}
+
+// Batch - saving of several blocks in one transaction while indexer is catching up head.
+// Timeout is in milliseconds, HeadLag is in seconds: blocks which are younger are saved one by one.
+type Batch struct {
+	Size    uint32 `validate:"omitempty,min=1" yaml:"size"`
+	Timeout int64  `validate:"omitempty,min=1" yaml:"timeout"`
+	HeadLag int64  `validate:"omitempty,min=1" yaml:"head_lag"`
 }
 
 // Substitute -
@@ -9,7 +9,7 @@ import (
 )
 
 type CoinReceived struct {
-	Amount   *types.Coin
+	Amount   types.Coins
 	Receiver string
 }
 
@@ -19,12 +19,12 @@ func NewCoinReceived(m map[string]any) (body CoinReceived, err error) {
 		err = errors.Errorf("receiver key not found in %##v", m)
 		return
 	}
-	body.Amount, err = BalanceFromMap(m, "amount")
+	body.Amount, err = CoinsFromMap(m, "amount")
 	return
 }
 
 type CoinSpent struct {
-	Amount  *types.Coin
+	Amount  types.Coins
 	Spender string
 }
 
@@ -34,6 +34,6 @@ func NewCoinSpent(m map[string]any) (body CoinSpent, err error) {
 		err = errors.Errorf("spender key not found in %##v", m)
 		return
 	}
-	body.Amount, err = BalanceFromMap(m, "amount")
+	body.Amount, err = CoinsFromMap(m, "amount")
 	return
 }
@@ -7,7 +7,6 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/types"
-	testsuite "github.com/dipdup-io/celestia-indexer/internal/test_suite"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,7 +25,20 @@ func TestNewCoinSpent(t *testing.T) {
 			},
 			wantBody: CoinSpent{
 				Spender: "spender",
-				Amount:  testsuite.Ptr(types.NewCoin("utia", types.OneInt())),
+				Amount:  types.NewCoins(types.NewCoin("utia", types.OneInt())),
+			},
+		}, {
+			name: "multiple coins",
+			m: map[string]any{
+				"spender": "spender",
+				"amount":  "5ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9,1utia",
+			},
+			wantBody: CoinSpent{
+				Spender: "spender",
+				Amount: types.NewCoins(
+					types.NewCoin("utia", types.OneInt()),
+					types.NewCoin("ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9", types.NewInt(5)),
+				),
 			},
 		}, {
 			name: "test 2",
@@ -72,7 +84,7 @@ func TestNewCoinReceived(t *testing.T) {
 			},
 			wantBody: CoinReceived{
 				Receiver: "receiver",
-				Amount:   testsuite.Ptr(types.NewCoin("utia", types.NewInt(42))),
+				Amount:   types.NewCoins(types.NewCoin("utia", types.NewInt(42))),
 			},
 		}, {
 			name: "test 2",
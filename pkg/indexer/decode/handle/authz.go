@@ -5,20 +5,58 @@ package handle
 
 import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	cosmosBankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	cosmosStakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/dipdup-io/celestia-indexer/internal/consts"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/shopspring/decimal"
 )
 
 // MsgGrant is a request type for Grant method. It declares authorization to the grantee
 // on behalf of the granter with the provided expiration time.
-func MsgGrant(level types.Level, m *authz.MsgGrant) (storageTypes.MsgType, []storage.AddressWithType, error) {
+//
+// Grant is returned only for successful message with known authorization.
+func MsgGrant(level types.Level, status storageTypes.Status, m *authz.MsgGrant) (storageTypes.MsgType, []storage.AddressWithType, *storage.Grant, error) {
 	msgType := storageTypes.MsgGrant
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeGranter, address: m.Granter},
 		{t: storageTypes.MsgAddressTypeGrantee, address: m.Grantee},
 	}, level)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	authorization, err := m.GetAuthorization()
+	if err != nil {
+		return msgType, addresses, nil, nil
+	}
+
+	grant := storage.Grant{
+		Height:        level,
+		Authorization: authorizationType(authorization),
+		MsgType:       authorization.MsgTypeURL(),
+		SpendLimit:    decimal.Zero,
+		Granter:       &addresses[0].Address,
+		Grantee:       &addresses[1].Address,
+	}
+	if m.Grant.Expiration != nil {
+		grant.Expiration = *m.Grant.Expiration
+	}
+
+	// Spend limit is stored as it was granted. Authz keeper decreases it on MsgExec without any event,
+	// so the stored value isn't updated after usage.
+	switch typed := authorization.(type) {
+	case *cosmosBankTypes.SendAuthorization:
+		grant.SpendLimit = amountOf(typed.SpendLimit)
+	case *cosmosStakingTypes.StakeAuthorization:
+		if typed.MaxTokens != nil && typed.MaxTokens.Denom == consts.DefaultCurrency && !typed.MaxTokens.Amount.IsNil() {
+			grant.SpendLimit = decimal.RequireFromString(typed.MaxTokens.Amount.String())
+		}
+	}
+
+	return msgType, addresses, &grant, nil
 }
 
 // MsgExec attempts to execute the provided messages using
@@ -30,10 +68,9 @@ func MsgExec(level types.Level, m *authz.MsgExec) (storageTypes.MsgType, []stora
 		{t: storageTypes.MsgAddressTypeGrantee, address: m.Grantee},
 	}, level)
 
-	// MsgExecute also has Msgs field, where also can be addresses.
-	// Authorization Msg requests to execute. Each msg must implement Authorization interface
+	// Executed messages are decoded by `decode.Message` as internal messages of MsgExec.
 	// The x/authz will try to find a grant matching (msg.signers[0], grantee, MsgTypeURL(msg))
-	// triple and validate it.
+	// triple and validate it, so addresses of executed messages belong to the granter.
 
 	return msgType, addresses, err
 }
@@ -48,3 +85,16 @@ func MsgRevoke(level types.Level, m *authz.MsgRevoke) (storageTypes.MsgType, []s
 	}, level)
 	return msgType, addresses, err
 }
+
+func authorizationType(authorization authz.Authorization) storageTypes.AuthorizationType {
+	switch authorization.(type) {
+	case *authz.GenericAuthorization:
+		return storageTypes.AuthorizationTypeGeneric
+	case *cosmosBankTypes.SendAuthorization:
+		return storageTypes.AuthorizationTypeSend
+	case *cosmosStakingTypes.StakeAuthorization:
+		return storageTypes.AuthorizationTypeStake
+	default:
+		return storageTypes.AuthorizationTypeUnknown
+	}
+}
@@ -30,7 +30,6 @@ func createAddresses(data addressesData, level types.Level) ([]storage.AddressWi
 				Height:     level,
 				LastHeight: level,
 				Address:    d.address,
-				Balance:    storage.EmptyBalance(),
 			},
 		}
 	}
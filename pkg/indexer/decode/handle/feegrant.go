@@ -4,21 +4,75 @@
 package handle
 
 import (
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/dipdup-io/celestia-indexer/internal/consts"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/shopspring/decimal"
 )
 
 // MsgGrantAllowance adds permission for Grantee to spend up to Allowance
 // of fees from the account of Granter.
-func MsgGrantAllowance(level types.Level, m *feegrant.MsgGrantAllowance) (storageTypes.MsgType, []storage.AddressWithType, error) {
+//
+// Allowance is returned only for successful message with known allowance.
+func MsgGrantAllowance(level types.Level, status storageTypes.Status, m *feegrant.MsgGrantAllowance) (storageTypes.MsgType, []storage.AddressWithType, *storage.FeeAllowance, error) {
 	msgType := storageTypes.MsgGrantAllowance
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeGranter, address: m.Granter},
 		{t: storageTypes.MsgAddressTypeGrantee, address: m.Grantee},
 	}, level)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	allowance, err := m.GetFeeAllowanceI()
+	if err != nil {
+		return msgType, addresses, nil, nil
+	}
+
+	feeAllowance := storage.FeeAllowance{
+		Height:           level,
+		Type:             storageTypes.FeeAllowanceTypeUnknown,
+		SpendLimit:       decimal.Zero,
+		Spent:            decimal.Zero,
+		PeriodSpendLimit: decimal.Zero,
+		Granter:          &addresses[0].Address,
+		Grantee:          &addresses[1].Address,
+	}
+	if filtered, ok := allowance.(*feegrant.AllowedMsgAllowance); ok {
+		feeAllowance.Type = storageTypes.FeeAllowanceTypeAllowedMsg
+		feeAllowance.AllowedMessages = filtered.AllowedMessages
+		if allowance, err = filtered.GetAllowance(); err != nil {
+			return msgType, addresses, &feeAllowance, nil
+		}
+	}
+
+	var basic *feegrant.BasicAllowance
+	switch typed := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		basic = typed
+		if feeAllowance.Type != storageTypes.FeeAllowanceTypeAllowedMsg {
+			feeAllowance.Type = storageTypes.FeeAllowanceTypeBasic
+		}
+	case *feegrant.PeriodicAllowance:
+		basic = &typed.Basic
+		feeAllowance.Period = int64(typed.Period.Seconds())
+		feeAllowance.PeriodSpendLimit = amountOf(typed.PeriodSpendLimit)
+		if feeAllowance.Type != storageTypes.FeeAllowanceTypeAllowedMsg {
+			feeAllowance.Type = storageTypes.FeeAllowanceTypePeriodic
+		}
+	}
+
+	if basic != nil {
+		feeAllowance.SpendLimit = amountOf(basic.SpendLimit)
+		if basic.Expiration != nil {
+			feeAllowance.Expiration = *basic.Expiration
+		}
+	}
+
+	return msgType, addresses, &feeAllowance, nil
 }
 
 // MsgRevokeAllowance removes any existing Allowance from Granter to Grantee.
@@ -30,3 +84,12 @@ func MsgRevokeAllowance(level types.Level, m *feegrant.MsgRevokeAllowance) (stor
 	}, level)
 	return msgType, addresses, err
 }
+
+// amountOf - returns amount in default currency. Zero is returned if coins don't contain default currency.
+func amountOf(coins cosmosTypes.Coins) decimal.Decimal {
+	value := coins.AmountOf(consts.DefaultCurrency)
+	if value.IsNil() {
+		return decimal.Zero
+	}
+	return decimal.RequireFromString(value.String())
+}
@@ -4,20 +4,77 @@
 package handle
 
 import (
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
+	cosmosDistributionTypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	cosmosGovTypesV1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	cosmosGovTypesV1Beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	cosmosParamsProposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	ibcClientTypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/dipdup-io/celestia-indexer/internal/consts"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/shopspring/decimal"
 )
 
+// WeightedVoteOption - vote option with weight which is common for v1 and v1beta1 gov messages
+type WeightedVoteOption struct {
+	Option int32
+	Weight string
+}
+
 // MsgSubmitProposal defines a sdk.Msg type that supports submitting arbitrary
 // proposal Content.
-func MsgSubmitProposal(level types.Level, proposerAddress string) (storageTypes.MsgType, []storage.AddressWithType, error) {
+//
+// Proposal id is unknown at the moment. It's set by `submit_proposal` event. Initial deposit is returned as deposit of proposer.
+func MsgSubmitProposal(level types.Level, status storageTypes.Status, proposerAddress string, initialDeposit cosmosTypes.Coins, content cosmosGovTypesV1Beta1.Content) (storageTypes.MsgType, []storage.AddressWithType, *storage.Proposal, *storage.Deposit, error) {
 	msgType := storageTypes.MsgSubmitProposal
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeProposer, address: proposerAddress},
 	}, level)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, nil, err
+	}
+
+	proposer := &addresses[0].Address
+	proposal := storage.Proposal{
+		Height:   level,
+		Type:     proposalType(content),
+		Status:   storageTypes.ProposalStatusDepositPeriod,
+		Deposit:  decimal.Zero,
+		Proposer: proposer,
+	}
+	if content != nil {
+		proposal.Title = content.GetTitle()
+		proposal.Description = content.GetDescription()
+	}
+
+	return msgType, addresses, &proposal, newDeposit(level, proposer, 0, initialDeposit), nil
+}
+
+// LegacyContent - returns content of the first legacy proposal message. Returns nil if proposal doesn't contain legacy content.
+func LegacyContent(m *cosmosGovTypesV1.MsgSubmitProposal) cosmosGovTypesV1Beta1.Content {
+	for _, msg := range m.Messages {
+		if msg == nil {
+			continue
+		}
+		exec, ok := msg.GetCachedValue().(*cosmosGovTypesV1.MsgExecLegacyContent)
+		if !ok || exec.Content == nil {
+			continue
+		}
+		if content, ok := exec.Content.GetCachedValue().(cosmosGovTypesV1Beta1.Content); ok {
+			return content
+		}
+	}
+	return nil
+}
+
+// ContentV1Beta1 - returns content of v1beta1 proposal
+func ContentV1Beta1(m *cosmosGovTypesV1Beta1.MsgSubmitProposal) cosmosGovTypesV1Beta1.Content {
+	if m.Content == nil {
+		return nil
+	}
+	return m.GetContent()
 }
 
 // MsgExecLegacyContent is used to wrap the legacy content field into a message.
@@ -32,28 +89,140 @@ func MsgExecLegacyContent(level types.Level, m *cosmosGovTypesV1.MsgExecLegacyCo
 }
 
 // MsgVote defines a message to cast a vote.
-func MsgVote(level types.Level, voterAddress string) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgVote(level types.Level, status storageTypes.Status, voterAddress string, proposalId uint64, option int32) (storageTypes.MsgType, []storage.AddressWithType, []storage.Vote, error) {
 	msgType := storageTypes.MsgVote
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeVoter, address: voterAddress},
 	}, level)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	votes := newVotes(level, &addresses[0].Address, proposalId, []WeightedVoteOption{
+		{Option: option, Weight: "1"},
+	})
+	return msgType, addresses, votes, nil
 }
 
 // MsgVoteWeighted defines a message to cast a vote.
-func MsgVoteWeighted(level types.Level, voterAddress string) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgVoteWeighted(level types.Level, status storageTypes.Status, voterAddress string, proposalId uint64, options []WeightedVoteOption) (storageTypes.MsgType, []storage.AddressWithType, []storage.Vote, error) {
 	msgType := storageTypes.MsgVoteWeighted
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeVoter, address: voterAddress},
 	}, level)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	return msgType, addresses, newVotes(level, &addresses[0].Address, proposalId, options), nil
+}
+
+// WeightedOptionsV1 - converts options of v1.MsgVoteWeighted
+func WeightedOptionsV1(options []*cosmosGovTypesV1.WeightedVoteOption) []WeightedVoteOption {
+	result := make([]WeightedVoteOption, 0, len(options))
+	for i := range options {
+		if options[i] == nil {
+			continue
+		}
+		result = append(result, WeightedVoteOption{
+			Option: int32(options[i].Option),
+			Weight: options[i].Weight,
+		})
+	}
+	return result
+}
+
+// WeightedOptionsV1Beta1 - converts options of v1beta1.MsgVoteWeighted
+func WeightedOptionsV1Beta1(options []cosmosGovTypesV1Beta1.WeightedVoteOption) []WeightedVoteOption {
+	result := make([]WeightedVoteOption, 0, len(options))
+	for i := range options {
+		weight := "0"
+		if !options[i].Weight.IsNil() {
+			weight = options[i].Weight.String()
+		}
+		result = append(result, WeightedVoteOption{
+			Option: int32(options[i].Option),
+			Weight: weight,
+		})
+	}
+	return result
 }
 
 // MsgDeposit defines a message to submit a deposit to an existing proposal.
-func MsgDeposit(level types.Level, depositorAddress string) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgDeposit(level types.Level, status storageTypes.Status, depositorAddress string, proposalId uint64, amount cosmosTypes.Coins) (storageTypes.MsgType, []storage.AddressWithType, *storage.Deposit, error) {
 	msgType := storageTypes.MsgDeposit
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeDepositor, address: depositorAddress},
 	}, level)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	return msgType, addresses, newDeposit(level, &addresses[0].Address, proposalId, amount), nil
+}
+
+func proposalType(content cosmosGovTypesV1Beta1.Content) storageTypes.ProposalType {
+	switch content.(type) {
+	case *cosmosGovTypesV1Beta1.TextProposal:
+		return storageTypes.ProposalTypeText
+	case *cosmosParamsProposal.ParameterChangeProposal:
+		return storageTypes.ProposalTypeParamChanged
+	case *cosmosDistributionTypes.CommunityPoolSpendProposal:
+		return storageTypes.ProposalTypeCommunityPoolSpend
+	case *ibcClientTypes.ClientUpdateProposal:
+		return storageTypes.ProposalTypeClientUpdate
+	default:
+		return storageTypes.ProposalTypeUnknown
+	}
+}
+
+func voteOption(option int32) (storageTypes.VoteOption, bool) {
+	switch cosmosGovTypesV1.VoteOption(option) {
+	case cosmosGovTypesV1.OptionYes:
+		return storageTypes.VoteOptionYes, true
+	case cosmosGovTypesV1.OptionAbstain:
+		return storageTypes.VoteOptionAbstain, true
+	case cosmosGovTypesV1.OptionNo:
+		return storageTypes.VoteOptionNo, true
+	case cosmosGovTypesV1.OptionNoWithVeto:
+		return storageTypes.VoteOptionNoWithVeto, true
+	default:
+		return "", false
+	}
+}
+
+func newVotes(level types.Level, voter *storage.Address, proposalId uint64, options []WeightedVoteOption) []storage.Vote {
+	votes := make([]storage.Vote, 0, len(options))
+	for i := range options {
+		option, ok := voteOption(options[i].Option)
+		if !ok {
+			continue
+		}
+		weight, err := decimal.NewFromString(options[i].Weight)
+		if err != nil {
+			continue
+		}
+		votes = append(votes, storage.Vote{
+			Height:     level,
+			ProposalId: proposalId,
+			Option:     option,
+			Weight:     weight,
+			Voter:      voter,
+		})
+	}
+	return votes
+}
+
+// newDeposit - returns deposit in default currency. Returns nil if nothing is deposited.
+func newDeposit(level types.Level, depositor *storage.Address, proposalId uint64, amount cosmosTypes.Coins) *storage.Deposit {
+	value := amount.AmountOf(consts.DefaultCurrency)
+	if value.IsNil() || !value.IsPositive() {
+		return nil
+	}
+	return &storage.Deposit{
+		Height:     level,
+		ProposalId: proposalId,
+		Amount:     decimal.RequireFromString(value.String()),
+		Depositor:  depositor,
+	}
 }
@@ -5,20 +5,237 @@ package handle
 
 import (
 	ibcTypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibcClientTypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	ibcConnectionTypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	ibcChannelTypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/shopspring/decimal"
 )
 
 // IBCTransfer defines a msg to transfer fungible tokens (i.e., Coins) between
 // ICS20 enabled chains. See ICS Spec here:
 // https://github.com/cosmos/ibc/tree/master/spec/app/ics-020-fungible-token-transfer#data-structures
-func IBCTransfer(level types.Level, m *ibcTypes.MsgTransfer) (storageTypes.MsgType, []storage.AddressWithType, error) {
+//
+// Receiver is an address on counterparty chain, so it's saved only to the returned transfer. Packet sequence is unknown at the moment. It's set by `send_packet` event.
+func IBCTransfer(level types.Level, status storageTypes.Status, m *ibcTypes.MsgTransfer) (storageTypes.MsgType, []storage.AddressWithType, *storage.IbcTransfer, error) {
 	msgType := storageTypes.IBCTransfer
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeSender, address: m.Sender},
-		// {t: storageTypes.MsgAddressTypeReceiver,
-		// address: m.Receiver}, // TODO: is it data to do IBC Transfer on cosmos network?
+	}, level)
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	transfer := storage.IbcTransfer{
+		Height:        level,
+		Status:        storageTypes.IbcTransferStatusPending,
+		SourcePort:    m.SourcePort,
+		SourceChannel: m.SourceChannel,
+		Sender:        m.Sender,
+		Receiver:      m.Receiver,
+		Amount:        decimal.Zero,
+		Denom:         m.Token.Denom,
+		Memo:          m.Memo,
+	}
+	if !m.Token.Amount.IsNil() {
+		transfer.Amount = decimal.NewFromBigInt(m.Token.Amount.BigInt(), 0)
+	}
+	return msgType, addresses, &transfer, nil
+}
+
+// MsgCreateClient defines a message to create an IBC client. Initial client state is provided by the signer.
+func MsgCreateClient(level types.Level, m *ibcClientTypes.MsgCreateClient) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgCreateClient, storageTypes.MsgAddressTypeSigner, m.Signer)
+}
+
+// MsgUpdateClient defines an sdk.Msg to update a IBC client state using
+// the given client message. It's submitted by relayer.
+func MsgUpdateClient(level types.Level, m *ibcClientTypes.MsgUpdateClient) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgUpdateClient, storageTypes.MsgAddressTypeRelayer, m.Signer)
+}
+
+// MsgUpgradeClient defines an sdk.Msg to upgrade an IBC client to a new client state. It's submitted by relayer.
+func MsgUpgradeClient(level types.Level, m *ibcClientTypes.MsgUpgradeClient) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgUpgradeClient, storageTypes.MsgAddressTypeRelayer, m.Signer)
+}
+
+// MsgSubmitMisbehaviour defines an sdk.Msg type that submits Evidence for
+// light client misbehaviour. It's submitted by relayer.
+func MsgSubmitMisbehaviour(level types.Level, m *ibcClientTypes.MsgSubmitMisbehaviour) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgSubmitMisbehaviour, storageTypes.MsgAddressTypeRelayer, m.Signer)
+}
+
+// MsgConnectionOpenInit defines the msg sent by an account on Chain A to
+// initialize a connection with Chain B.
+func MsgConnectionOpenInit(level types.Level, m *ibcConnectionTypes.MsgConnectionOpenInit) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgConnectionOpenInit, storageTypes.MsgAddressTypeSigner, m.Signer)
+}
+
+// MsgConnectionOpenTry defines a msg sent by a Relayer to try to open a
+// connection on Chain B.
+func MsgConnectionOpenTry(level types.Level, m *ibcConnectionTypes.MsgConnectionOpenTry) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgConnectionOpenTry, storageTypes.MsgAddressTypeRelayer, m.Signer)
+}
+
+// MsgConnectionOpenAck defines a msg sent by a Relayer to Chain A to
+// acknowledge the change of connection state to TRYOPEN on Chain B.
+func MsgConnectionOpenAck(level types.Level, m *ibcConnectionTypes.MsgConnectionOpenAck) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgConnectionOpenAck, storageTypes.MsgAddressTypeRelayer, m.Signer)
+}
+
+// MsgConnectionOpenConfirm defines a msg sent by a Relayer to Chain B to
+// acknowledge the change of connection state to OPEN on Chain A.
+func MsgConnectionOpenConfirm(level types.Level, m *ibcConnectionTypes.MsgConnectionOpenConfirm) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgConnectionOpenConfirm, storageTypes.MsgAddressTypeRelayer, m.Signer)
+}
+
+// MsgChannelOpenInit defines an sdk.Msg to initialize a channel handshake.
+// Handshake is initiated on this chain, so the signer is not a relayer of counterparty data.
+func MsgChannelOpenInit(level types.Level, m *ibcChannelTypes.MsgChannelOpenInit) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgChannelOpenInit, storageTypes.MsgAddressTypeSigner, m.Signer)
+}
+
+// MsgChannelOpenTry defines a msg sent by a Relayer to try to open a channel
+// on Chain B.
+func MsgChannelOpenTry(level types.Level, m *ibcChannelTypes.MsgChannelOpenTry) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgChannelOpenTry, storageTypes.MsgAddressTypeRelayer, m.Signer)
+}
+
+// MsgChannelOpenAck defines a msg sent by a Relayer to Chain A to acknowledge
+// the change of channel state to TRYOPEN on Chain B.
+func MsgChannelOpenAck(level types.Level, m *ibcChannelTypes.MsgChannelOpenAck) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgChannelOpenAck, storageTypes.MsgAddressTypeRelayer, m.Signer)
+}
+
+// MsgChannelOpenConfirm defines a msg sent by a Relayer to Chain B to
+// acknowledge the change of channel state to OPEN on Chain A.
+func MsgChannelOpenConfirm(level types.Level, m *ibcChannelTypes.MsgChannelOpenConfirm) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgChannelOpenConfirm, storageTypes.MsgAddressTypeRelayer, m.Signer)
+}
+
+// MsgChannelCloseInit defines a msg sent by an account on Chain A
+// to close a channel with Chain B.
+func MsgChannelCloseInit(level types.Level, m *ibcChannelTypes.MsgChannelCloseInit) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgChannelCloseInit, storageTypes.MsgAddressTypeSigner, m.Signer)
+}
+
+// MsgChannelCloseConfirm defines a msg sent by a Relayer to Chain B
+// to acknowledge the change of channel state to CLOSED on Chain A.
+func MsgChannelCloseConfirm(level types.Level, m *ibcChannelTypes.MsgChannelCloseConfirm) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	return ibcMessage(level, storageTypes.MsgChannelCloseConfirm, storageTypes.MsgAddressTypeRelayer, m.Signer)
+}
+
+// MsgRecvPacket receives incoming IBC packet. Incoming ICS-20 transfer is returned if the packet is sent to transfer port.
+// Receiver of the transfer is celestia address, so it's attributed to the message.
+func MsgRecvPacket(level types.Level, status storageTypes.Status, m *ibcChannelTypes.MsgRecvPacket) (storageTypes.MsgType, []storage.AddressWithType, *storage.IbcTransfer, error) {
+	msgType, addresses, err := ibcMessage(level, storageTypes.MsgRecvPacket, storageTypes.MsgAddressTypeRelayer, m.Signer)
+	if err != nil || status == storageTypes.StatusFailed || m.Packet.DestinationPort != ibcTypes.PortID {
+		return msgType, addresses, nil, err
+	}
+
+	var data ibcTypes.FungibleTokenPacketData
+	if err := ibcTypes.ModuleCdc.UnmarshalJSON(m.Packet.Data, &data); err != nil {
+		return msgType, addresses, nil, nil
+	}
+	amount, err := decimal.NewFromString(data.Amount)
+	if err != nil {
+		return msgType, addresses, nil, nil
+	}
+
+	if prefix, _, err := types.Address(data.Receiver).Decode(); err == nil && prefix == types.AddressPrefixCelestia {
+		receiver, err := createAddresses(addressesData{
+			{t: storageTypes.MsgAddressTypeReceiver, address: data.Receiver},
+		}, level)
+		if err != nil {
+			return msgType, addresses, nil, err
+		}
+		addresses = append(addresses, receiver...)
+	}
+
+	return msgType, addresses, &storage.IbcTransfer{
+		Height:             level,
+		Incoming:           true,
+		Status:             storageTypes.IbcTransferStatusSuccess,
+		SourcePort:         m.Packet.SourcePort,
+		SourceChannel:      m.Packet.SourceChannel,
+		DestinationPort:    m.Packet.DestinationPort,
+		DestinationChannel: m.Packet.DestinationChannel,
+		Sequence:           m.Packet.Sequence,
+		Sender:             data.Sender,
+		Receiver:           data.Receiver,
+		Amount:             amount,
+		Denom:              receivedDenom(m.Packet, data.Denom),
+		Memo:               data.Memo,
+	}, nil
+}
+
+// MsgTimeout receives timed-out packet. Timeout of outgoing ICS-20 transfer is returned if the packet is sent from transfer port.
+func MsgTimeout(level types.Level, status storageTypes.Status, m *ibcChannelTypes.MsgTimeout) (storageTypes.MsgType, []storage.AddressWithType, *storage.IbcTransfer, error) {
+	msgType, addresses, err := ibcMessage(level, storageTypes.MsgTimeout, storageTypes.MsgAddressTypeRelayer, m.Signer)
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+	return msgType, addresses, transferResolution(level, m.Packet, storageTypes.IbcTransferStatusTimeout), nil
+}
+
+// MsgTimeoutOnClose timed-out packet upon counterparty channel closure. Timeout of outgoing ICS-20 transfer is returned if the packet is sent from transfer port.
+func MsgTimeoutOnClose(level types.Level, status storageTypes.Status, m *ibcChannelTypes.MsgTimeoutOnClose) (storageTypes.MsgType, []storage.AddressWithType, *storage.IbcTransfer, error) {
+	msgType, addresses, err := ibcMessage(level, storageTypes.MsgTimeoutOnClose, storageTypes.MsgAddressTypeRelayer, m.Signer)
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+	return msgType, addresses, transferResolution(level, m.Packet, storageTypes.IbcTransferStatusTimeout), nil
+}
+
+// MsgAcknowledgement receives incoming IBC acknowledgement. Acknowledgement of outgoing ICS-20 transfer is returned if the packet is sent from transfer port.
+// Error acknowledgement means the transfer is failed and tokens are refunded to sender.
+func MsgAcknowledgement(level types.Level, status storageTypes.Status, m *ibcChannelTypes.MsgAcknowledgement) (storageTypes.MsgType, []storage.AddressWithType, *storage.IbcTransfer, error) {
+	msgType, addresses, err := ibcMessage(level, storageTypes.MsgAcknowledgement, storageTypes.MsgAddressTypeRelayer, m.Signer)
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	var ack ibcChannelTypes.Acknowledgement
+	if err := ibcTypes.ModuleCdc.UnmarshalJSON(m.Acknowledgement, &ack); err != nil {
+		return msgType, addresses, nil, nil
+	}
+	transferStatus := storageTypes.IbcTransferStatusSuccess
+	if !ack.Success() {
+		transferStatus = storageTypes.IbcTransferStatusFailed
+	}
+	return msgType, addresses, transferResolution(level, m.Packet, transferStatus), nil
+}
+
+// ibcMessage - IBC core messages have the only address: the signer of message.
+// Messages which relay data from counterparty chain are signed by relayer.
+func ibcMessage(level types.Level, msgType storageTypes.MsgType, addressType storageTypes.MsgAddressType, signer string) (storageTypes.MsgType, []storage.AddressWithType, error) {
+	addresses, err := createAddresses(addressesData{
+		{t: addressType, address: signer},
 	}, level)
 	return msgType, addresses, err
 }
+
+// transferResolution - returns resolution of outgoing ICS-20 transfer with the packet. Returns nil if the packet isn't sent from transfer port.
+func transferResolution(level types.Level, packet ibcChannelTypes.Packet, status storageTypes.IbcTransferStatus) *storage.IbcTransfer {
+	if packet.SourcePort != ibcTypes.PortID {
+		return nil
+	}
+	return &storage.IbcTransfer{
+		Status:         status,
+		SourcePort:     packet.SourcePort,
+		SourceChannel:  packet.SourceChannel,
+		Sequence:       packet.Sequence,
+		ResolvedHeight: level,
+	}
+}
+
+// receivedDenom - returns denom trace of tokens received with the packet. Tokens which were sent from celestia are returned with unprefixed denom,
+// other ones are prefixed with destination port and channel.
+func receivedDenom(packet ibcChannelTypes.Packet, denom string) string {
+	if ibcTypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, denom) {
+		return denom[len(ibcTypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)):]
+	}
+	return ibcTypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, denom)
+}
@@ -4,6 +4,8 @@
 package handle
 
 import (
+	cryptoTypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
 	cosmosStakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
@@ -12,14 +14,14 @@ import (
 )
 
 // MsgCreateValidator defines an SDK message for creating a new validator.
-func MsgCreateValidator(level types.Level, status storageTypes.Status, m *cosmosStakingTypes.MsgCreateValidator) (storageTypes.MsgType, []storage.AddressWithType, *storage.Validator, error) {
+func MsgCreateValidator(level types.Level, status storageTypes.Status, m *cosmosStakingTypes.MsgCreateValidator) (storageTypes.MsgType, []storage.AddressWithType, *storage.Validator, []storage.DelegationLog, error) {
 	msgType := storageTypes.MsgCreateValidator
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeDelegator, address: m.DelegatorAddress},
 		{t: storageTypes.MsgAddressTypeValidator, address: m.ValidatorAddress},
 	}, level)
-	if status == storageTypes.StatusFailed {
-		return msgType, addresses, nil, nil
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, nil, err
 	}
 
 	validator := storage.Validator{
@@ -31,12 +33,19 @@ func MsgCreateValidator(level types.Level, status storageTypes.Status, m *cosmos
 		Details:           m.Description.Details,
 		Contacts:          m.Description.SecurityContact,
 		Height:            level,
+		Status:            storageTypes.ValidatorStatusUnbonded,
 		Rate:              decimal.Zero,
 		MaxRate:           decimal.Zero,
 		MaxChangeRate:     decimal.Zero,
 		MinSelfDelegation: decimal.Zero,
 	}
 
+	if m.Pubkey != nil {
+		if pubKey, ok := m.Pubkey.GetCachedValue().(cryptoTypes.PubKey); ok {
+			validator.ConsAddress = types.Hex(pubKey.Address())
+		}
+	}
+
 	if !m.Commission.Rate.IsNil() {
 		validator.Rate = decimal.RequireFromString(m.Commission.Rate.String())
 	}
@@ -53,7 +62,12 @@ func MsgCreateValidator(level types.Level, status storageTypes.Status, m *cosmos
 		validator.MinSelfDelegation = decimal.RequireFromString(m.MinSelfDelegation.String())
 	}
 
-	return msgType, addresses, &validator, err
+	var delegations []storage.DelegationLog
+	if amount := coinAmount(m.Value); !amount.IsZero() {
+		delegations = append(delegations, newDelegationLog(level, &addresses[0].Address, m.ValidatorAddress, amount))
+	}
+
+	return msgType, addresses, &validator, delegations, nil
 }
 
 // MsgEditValidator defines a SDK message for editing an existing validator.
@@ -74,6 +88,7 @@ func MsgEditValidator(level types.Level, status storageTypes.Status, m *cosmosSt
 		Details:           m.Description.Details,
 		Contacts:          m.Description.SecurityContact,
 		Height:            level,
+		Status:            storageTypes.ValidatorStatusUnbonded,
 		Rate:              decimal.Zero,
 		MinSelfDelegation: decimal.Zero,
 	}
@@ -89,46 +104,107 @@ func MsgEditValidator(level types.Level, status storageTypes.Status, m *cosmosSt
 
 // MsgDelegate defines a SDK message for performing a delegation of coins
 // from a delegator to a validator.
-func MsgDelegate(level types.Level, m *cosmosStakingTypes.MsgDelegate) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgDelegate(level types.Level, status storageTypes.Status, m *cosmosStakingTypes.MsgDelegate) (storageTypes.MsgType, []storage.AddressWithType, []storage.DelegationLog, error) {
 	msgType := storageTypes.MsgDelegate
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeDelegator, address: m.DelegatorAddress},
 		{t: storageTypes.MsgAddressTypeValidator, address: m.ValidatorAddress},
 	}, level)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	delegations := []storage.DelegationLog{
+		newDelegationLog(level, &addresses[0].Address, m.ValidatorAddress, coinAmount(m.Amount)),
+	}
+	return msgType, addresses, delegations, nil
 }
 
 // MsgBeginRedelegate defines an SDK message for performing a redelegation
 // of coins from a delegator and source validator to a destination validator.
-func MsgBeginRedelegate(level types.Level, m *cosmosStakingTypes.MsgBeginRedelegate) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgBeginRedelegate(level types.Level, status storageTypes.Status, m *cosmosStakingTypes.MsgBeginRedelegate) (storageTypes.MsgType, []storage.AddressWithType, []storage.DelegationLog, *storage.Redelegation, error) {
 	msgType := storageTypes.MsgBeginRedelegate
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeDelegator, address: m.DelegatorAddress},
 		{t: storageTypes.MsgAddressTypeValidatorSrc, address: m.ValidatorSrcAddress},
 		{t: storageTypes.MsgAddressTypeValidatorDst, address: m.ValidatorDstAddress},
 	}, level)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, nil, err
+	}
+
+	amount := coinAmount(m.Amount)
+	delegator := &addresses[0].Address
+	delegations := []storage.DelegationLog{
+		newDelegationLog(level, delegator, m.ValidatorSrcAddress, amount.Neg()),
+		newDelegationLog(level, delegator, m.ValidatorDstAddress, amount),
+	}
+	redelegation := storage.Redelegation{
+		Height:      level,
+		Source:      m.ValidatorSrcAddress,
+		Destination: m.ValidatorDstAddress,
+		Amount:      amount,
+		Address:     delegator,
+	}
+	return msgType, addresses, delegations, &redelegation, nil
 }
 
 // MsgUndelegate defines a SDK message for performing an undelegation from a
 // delegate and a validator.
-func MsgUndelegate(level types.Level, m *cosmosStakingTypes.MsgUndelegate) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgUndelegate(level types.Level, status storageTypes.Status, m *cosmosStakingTypes.MsgUndelegate) (storageTypes.MsgType, []storage.AddressWithType, []storage.DelegationLog, *storage.Unbonding, error) {
 	msgType := storageTypes.MsgUndelegate
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeDelegator, address: m.DelegatorAddress},
 		{t: storageTypes.MsgAddressTypeValidator, address: m.ValidatorAddress},
 	}, level)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, nil, err
+	}
+
+	amount := coinAmount(m.Amount)
+	delegator := &addresses[0].Address
+	delegations := []storage.DelegationLog{
+		newDelegationLog(level, delegator, m.ValidatorAddress, amount.Neg()),
+	}
+	unbonding := storage.Unbonding{
+		Height:    level,
+		Validator: m.ValidatorAddress,
+		Amount:    amount,
+		Address:   delegator,
+	}
+	return msgType, addresses, delegations, &unbonding, nil
 }
 
 // MsgCancelUnbondingDelegation defines the SDK message for performing a cancel unbonding delegation for delegator
 //
 // Since: cosmos-sdk 0.46
-func MsgCancelUnbondingDelegation(level types.Level, m *cosmosStakingTypes.MsgCancelUnbondingDelegation) (storageTypes.MsgType, []storage.AddressWithType, error) {
+func MsgCancelUnbondingDelegation(level types.Level, status storageTypes.Status, m *cosmosStakingTypes.MsgCancelUnbondingDelegation) (storageTypes.MsgType, []storage.AddressWithType, []storage.DelegationLog, error) {
 	msgType := storageTypes.MsgCancelUnbondingDelegation
 	addresses, err := createAddresses(addressesData{
 		{t: storageTypes.MsgAddressTypeDelegator, address: m.DelegatorAddress},
 		{t: storageTypes.MsgAddressTypeValidator, address: m.ValidatorAddress},
 	}, level)
-	return msgType, addresses, err
+	if err != nil || status == storageTypes.StatusFailed {
+		return msgType, addresses, nil, err
+	}
+
+	delegation := newDelegationLog(level, &addresses[0].Address, m.ValidatorAddress, coinAmount(m.Amount))
+	delegation.CreationHeight = types.Level(m.CreationHeight)
+	return msgType, addresses, []storage.DelegationLog{delegation}, nil
+}
+
+func newDelegationLog(level types.Level, delegator *storage.Address, validator string, amount decimal.Decimal) storage.DelegationLog {
+	return storage.DelegationLog{
+		Height:    level,
+		Validator: validator,
+		Amount:    amount,
+		Address:   delegator,
+	}
+}
+
+func coinAmount(coin cosmosTypes.Coin) decimal.Decimal {
+	if coin.Amount.IsNil() {
+		return decimal.Zero
+	}
+	return decimal.RequireFromString(coin.Amount.String())
 }
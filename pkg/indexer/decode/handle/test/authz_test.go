@@ -7,14 +7,16 @@ import (
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	cosmosBankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	cosmosStakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
 	"github.com/dipdup-io/celestia-indexer/internal/test_suite"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
 	"github.com/fatih/structs"
-	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 // MsgGrant
@@ -48,10 +50,6 @@ func TestDecodeMsg_SuccessOnMsgGrant(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv",
 				Hash:       []byte{0x38, 0xf5, 0xc9, 0x8, 0x56, 0x46, 0xad, 0xc2, 0xc0, 0x71, 0x2c, 0xcc, 0x4a, 0x9e, 0xbe, 0x5, 0x41, 0x9e, 0xd2, 0xc8},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -62,10 +60,6 @@ func TestDecodeMsg_SuccessOnMsgGrant(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
 				Hash:       []byte{0x64, 0xd3, 0xfc, 0x6a, 0x2a, 0x52, 0x4e, 0x2f, 0x60, 0x3f, 0x51, 0xc7, 0xee, 0x4e, 0x8d, 0x35, 0xf7, 0x23, 0x22, 0xf8},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -88,6 +82,39 @@ func TestDecodeMsg_SuccessOnMsgGrant(t *testing.T) {
 	assert.Equal(t, addressesExpected, dm.Addresses)
 }
 
+func TestDecodeMsg_SuccessOnMsgGrant_WithSendAuthorization(t *testing.T) {
+	expiration := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	authorization, err := codecTypes.NewAnyWithValue(cosmosBankTypes.NewSendAuthorization(
+		types.NewCoins(types.NewInt64Coin("utia", 1000)),
+	))
+	assert.NoError(t, err)
+
+	m := &authz.MsgGrant{
+		Granter: "celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv",
+		Grantee: "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+		Grant: authz.Grant{
+			Authorization: authorization,
+			Expiration:    &expiration,
+		},
+	}
+	blob, _ := testsuite.EmptyBlock()
+
+	dm, err := decode.Message(m, blob.Height, blob.Block.Time, 0, storageTypes.StatusSuccess)
+	assert.NoError(t, err)
+	assert.NotNil(t, dm.Msg.Grant)
+	assert.Equal(t, blob.Height, dm.Msg.Grant.Height)
+	assert.Equal(t, storageTypes.AuthorizationTypeSend, dm.Msg.Grant.Authorization)
+	assert.Equal(t, "/cosmos.bank.v1beta1.MsgSend", dm.Msg.Grant.MsgType)
+	assert.Equal(t, "1000", dm.Msg.Grant.SpendLimit.String())
+	assert.Equal(t, expiration, dm.Msg.Grant.Expiration)
+	assert.Equal(t, "celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv", dm.Msg.Grant.Granter.Address)
+	assert.Equal(t, "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq", dm.Msg.Grant.Grantee.Address)
+
+	dm, err = decode.Message(m, blob.Height, blob.Block.Time, 0, storageTypes.StatusFailed)
+	assert.NoError(t, err)
+	assert.Nil(t, dm.Msg.Grant)
+}
+
 // MsgExec
 
 func createMsgExec() types.Msg {
@@ -115,10 +142,6 @@ func TestDecodeMsg_SuccessOnMsgExec(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
 				Hash:       []byte{0x64, 0xd3, 0xfc, 0x6a, 0x2a, 0x52, 0x4e, 0x2f, 0x60, 0x3f, 0x51, 0xc7, 0xee, 0x4e, 0x8d, 0x35, 0xf7, 0x23, 0x22, 0xf8},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -141,6 +164,48 @@ func TestDecodeMsg_SuccessOnMsgExec(t *testing.T) {
 	assert.Equal(t, addressesExpected, dm.Addresses)
 }
 
+func TestDecodeMsg_SuccessOnMsgExec_WithMsgs(t *testing.T) {
+	send, err := codecTypes.NewAnyWithValue(&cosmosBankTypes.MsgSend{
+		FromAddress: "celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv",
+		ToAddress:   "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+		Amount:      types.NewCoins(types.NewInt64Coin("utia", 1000)),
+	})
+	assert.NoError(t, err)
+	delegate, err := codecTypes.NewAnyWithValue(&cosmosStakingTypes.MsgDelegate{
+		DelegatorAddress: "celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv",
+		ValidatorAddress: "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
+		Amount:           types.NewInt64Coin("utia", 500),
+	})
+	assert.NoError(t, err)
+
+	m := &authz.MsgExec{
+		Grantee: "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
+		Msgs:    []*codecTypes.Any{send, delegate},
+	}
+	blob, _ := testsuite.EmptyBlock()
+
+	dm, err := decode.Message(m, blob.Height, blob.Block.Time, 2, storageTypes.StatusSuccess)
+	assert.NoError(t, err)
+	assert.Equal(t, storageTypes.MsgExec, dm.Msg.Type)
+	assert.Len(t, dm.Msg.Addresses, 1)
+	assert.Len(t, dm.Msg.InternalMsgs, 2)
+
+	assert.Equal(t, storageTypes.MsgSend, dm.Msg.InternalMsgs[0].Type)
+	assert.EqualValues(t, 0, dm.Msg.InternalMsgs[0].Position)
+	assert.Equal(t, blob.Height, dm.Msg.InternalMsgs[0].Height)
+	assert.Len(t, dm.Msg.InternalMsgs[0].Addresses, 2)
+	assert.Equal(t, storageTypes.MsgAddressTypeFromAddress, dm.Msg.InternalMsgs[0].Addresses[0].Type)
+	assert.Equal(t, "celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv", dm.Msg.InternalMsgs[0].Addresses[0].String())
+
+	assert.Equal(t, storageTypes.MsgDelegate, dm.Msg.InternalMsgs[1].Type)
+	assert.EqualValues(t, 1, dm.Msg.InternalMsgs[1].Position)
+	assert.Len(t, dm.Msg.InternalMsgs[1].Delegations, 1)
+	assert.Equal(t, "500", dm.Msg.InternalMsgs[1].Delegations[0].Amount.String())
+
+	assert.Len(t, dm.Addresses, 1+2+len(dm.Msg.InternalMsgs[1].Addresses))
+	assert.Equal(t, storageTypes.MsgAddressTypeGrantee, dm.Addresses[0].Type)
+}
+
 // MsgRevoke
 
 func createMsgRevoke() types.Msg {
@@ -169,10 +234,6 @@ func TestDecodeMsg_SuccessOnMsgRevoke(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv",
 				Hash:       []byte{0x38, 0xf5, 0xc9, 0x8, 0x56, 0x46, 0xad, 0xc2, 0xc0, 0x71, 0x2c, 0xcc, 0x4a, 0x9e, 0xbe, 0x5, 0x41, 0x9e, 0xd2, 0xc8},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -183,10 +244,6 @@ func TestDecodeMsg_SuccessOnMsgRevoke(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
 				Hash:       []byte{0x64, 0xd3, 0xfc, 0x6a, 0x2a, 0x52, 0x4e, 0x2f, 0x60, 0x3f, 0x51, 0xc7, 0xee, 0x4e, 0x8d, 0x35, 0xf7, 0x23, 0x22, 0xf8},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -12,7 +12,6 @@ import (
 	"github.com/dipdup-io/celestia-indexer/internal/test_suite"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
 	"github.com/fatih/structs"
-	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -50,10 +49,6 @@ func TestDecodeMsg_SuccessOnMsgSend(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
 				Hash:       []byte{0x94, 0x63, 0x42, 0xc7, 0x73, 0x2f, 0x6, 0x22, 0x37, 0xb6, 0x73, 0xf5, 0x26, 0xf2, 0x67, 0x4c, 0x7d, 0xb, 0xff, 0x98},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -64,10 +59,6 @@ func TestDecodeMsg_SuccessOnMsgSend(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l",
 				Hash:       []byte{0x64, 0x18, 0x63, 0xcf, 0xc9, 0x3b, 0x6f, 0x4e, 0x37, 0x30, 0x0, 0x2e, 0x81, 0xcb, 0x8b, 0x27, 0x27, 0xe1, 0x0, 0x68},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -122,10 +113,6 @@ func TestDecodeMsg_SuccessOnMsgMultiSend(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
 				Hash:       []byte{0x94, 0x63, 0x42, 0xc7, 0x73, 0x2f, 0x6, 0x22, 0x37, 0xb6, 0x73, 0xf5, 0x26, 0xf2, 0x67, 0x4c, 0x7d, 0xb, 0xff, 0x98},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -136,10 +123,6 @@ func TestDecodeMsg_SuccessOnMsgMultiSend(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1prxtghtsjrdwdtkt82kye3a7yukmcay6x9uyts",
 				Hash:       []byte{8, 204, 180, 93, 112, 144, 218, 230, 174, 203, 58, 172, 76, 199, 190, 39, 45, 188, 116, 154},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -150,10 +133,6 @@ func TestDecodeMsg_SuccessOnMsgMultiSend(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l",
 				Hash:       []byte{0x64, 0x18, 0x63, 0xcf, 0xc9, 0x3b, 0x6f, 0x4e, 0x37, 0x30, 0x0, 0x2e, 0x81, 0xcb, 0x8b, 0x27, 0x27, 0xe1, 0x0, 0x68},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -11,7 +11,6 @@ import (
 	"github.com/dipdup-io/celestia-indexer/internal/test_suite"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
 	"github.com/fatih/structs"
-	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -45,10 +44,6 @@ func TestDecodeMsg_SuccessOnPayForBlob(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1zefjxuq43xmjq9x4hhw23wkvvz6st5uhv40tys",
 				Hash:       []byte{0x16, 0x53, 0x23, 0x70, 0x15, 0x89, 0xb7, 0x20, 0x14, 0xd5, 0xbd, 0xdc, 0xa8, 0xba, 0xcc, 0x60, 0xb5, 0x5, 0xd3, 0x97},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -11,7 +11,6 @@ import (
 	"github.com/dipdup-io/celestia-indexer/internal/test_suite"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
 	"github.com/fatih/structs"
-	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -43,9 +42,6 @@ func TestDecodeMsg_SuccessOnMsgSetWithdrawAddress(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1u5pshtqpexjmuudrvq6q335qym2zggzhp7kq0p",
 				Hash:       []byte{0xe5, 0x3, 0xb, 0xac, 0x1, 0xc9, 0xa5, 0xbe, 0x71, 0xa3, 0x60, 0x34, 0x8, 0xc6, 0x80, 0x26, 0xd4, 0x24, 0x20, 0x57},
-				Balance: storage.Balance{
-					Total: decimal.Zero,
-				},
 			},
 		}, {
 			Type: storageTypes.MsgAddressTypeWithdraw,
@@ -55,9 +51,6 @@ func TestDecodeMsg_SuccessOnMsgSetWithdrawAddress(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1nasjhf82cjuk3mxyhzw6ntpc66exzfe7qhl256",
 				Hash:       []byte{0x9f, 0x61, 0x2b, 0xa4, 0xea, 0xc4, 0xb9, 0x68, 0xec, 0xc4, 0xb8, 0x9d, 0xa9, 0xac, 0x38, 0xd6, 0xb2, 0x61, 0x27, 0x3e},
-				Balance: storage.Balance{
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -107,10 +100,6 @@ func TestDecodeMsg_SuccessOnMsgWithdrawDelegatorReward(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1ws4hfsl8hlylt38ptk5cn9ura20slu2fnkre76",
 				Hash:       []byte{0x74, 0x2b, 0x74, 0xc3, 0xe7, 0xbf, 0xc9, 0xf5, 0xc4, 0xe1, 0x5d, 0xa9, 0x89, 0x97, 0x83, 0xea, 0x9f, 0xf, 0xf1, 0x49},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -121,10 +110,6 @@ func TestDecodeMsg_SuccessOnMsgWithdrawDelegatorReward(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
 				Hash:       []byte{0x4a, 0xb, 0xf8, 0x99, 0x89, 0xe7, 0xa, 0xe3, 0xf, 0x3b, 0x62, 0x94, 0xa2, 0x97, 0x5a, 0x17, 0x5c, 0x48, 0x22, 0xa3},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -173,10 +158,6 @@ func TestDecodeMsg_SuccessOnMsgWithdrawValidatorCommission(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
 				Hash:       []byte{0x4a, 0xb, 0xf8, 0x99, 0x89, 0xe7, 0xa, 0xe3, 0xf, 0x3b, 0x62, 0x94, 0xa2, 0x97, 0x5a, 0x17, 0x5c, 0x48, 0x22, 0xa3},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -226,10 +207,6 @@ func TestDecodeMsg_SuccessOnMsgFundCommunityPool(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1ws4hfsl8hlylt38ptk5cn9ura20slu2fnkre76",
 				Hash:       []byte{0x74, 0x2b, 0x74, 0xc3, 0xe7, 0xbf, 0xc9, 0xf5, 0xc4, 0xe1, 0x5d, 0xa9, 0x89, 0x97, 0x83, 0xea, 0x9f, 0xf, 0xf1, 0x49},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -12,9 +12,9 @@ import (
 	"github.com/dipdup-io/celestia-indexer/internal/test_suite"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
 	"github.com/fatih/structs"
-	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 // MsgGrantAllowance
@@ -45,10 +45,6 @@ func TestDecodeMsg_SuccessOnMsgGrantAllowance(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv",
 				Hash:       []byte{0x38, 0xf5, 0xc9, 0x8, 0x56, 0x46, 0xad, 0xc2, 0xc0, 0x71, 0x2c, 0xcc, 0x4a, 0x9e, 0xbe, 0x5, 0x41, 0x9e, 0xd2, 0xc8},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -59,10 +55,6 @@ func TestDecodeMsg_SuccessOnMsgGrantAllowance(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
 				Hash:       []byte{0x64, 0xd3, 0xfc, 0x6a, 0x2a, 0x52, 0x4e, 0x2f, 0x60, 0x3f, 0x51, 0xc7, 0xee, 0x4e, 0x8d, 0x35, 0xf7, 0x23, 0x22, 0xf8},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -85,6 +77,38 @@ func TestDecodeMsg_SuccessOnMsgGrantAllowance(t *testing.T) {
 	assert.Equal(t, addressesExpected, dm.Addresses)
 }
 
+func TestDecodeMsg_SuccessOnMsgGrantAllowance_WithAllowedMsgAllowance(t *testing.T) {
+	periodic := &feegrant.PeriodicAllowance{
+		Basic: feegrant.BasicAllowance{
+			SpendLimit: types.NewCoins(types.NewInt64Coin("utia", 1000)),
+		},
+		Period:           time.Hour,
+		PeriodSpendLimit: types.NewCoins(types.NewInt64Coin("utia", 100)),
+	}
+	allowance, err := feegrant.NewAllowedMsgAllowance(periodic, []string{"/cosmos.bank.v1beta1.MsgSend"})
+	assert.NoError(t, err)
+	m, err := feegrant.NewMsgGrantAllowance(
+		allowance,
+		types.MustAccAddressFromBech32("celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv"),
+		types.MustAccAddressFromBech32("celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq"),
+	)
+	assert.NoError(t, err)
+	blob, _ := testsuite.EmptyBlock()
+
+	dm, err := decode.Message(m, blob.Height, blob.Block.Time, 0, storageTypes.StatusSuccess)
+	assert.NoError(t, err)
+	assert.NotNil(t, dm.Msg.FeeAllowance)
+	assert.Equal(t, blob.Height, dm.Msg.FeeAllowance.Height)
+	assert.Equal(t, storageTypes.FeeAllowanceTypeAllowedMsg, dm.Msg.FeeAllowance.Type)
+	assert.Equal(t, "1000", dm.Msg.FeeAllowance.SpendLimit.String())
+	assert.Equal(t, "100", dm.Msg.FeeAllowance.PeriodSpendLimit.String())
+	assert.EqualValues(t, 3600, dm.Msg.FeeAllowance.Period)
+	assert.Equal(t, []string{"/cosmos.bank.v1beta1.MsgSend"}, dm.Msg.FeeAllowance.AllowedMessages)
+	assert.True(t, dm.Msg.FeeAllowance.Expiration.IsZero())
+	assert.Equal(t, "celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv", dm.Msg.FeeAllowance.Granter.Address)
+	assert.Equal(t, "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq", dm.Msg.FeeAllowance.Grantee.Address)
+}
+
 // MsgRevokeAllowance
 
 func createMsgRevokeAllowance() types.Msg {
@@ -112,10 +136,6 @@ func TestDecodeMsg_SuccessOnMsgRevokeAllowance(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv",
 				Hash:       []byte{0x38, 0xf5, 0xc9, 0x8, 0x56, 0x46, 0xad, 0xc2, 0xc0, 0x71, 0x2c, 0xcc, 0x4a, 0x9e, 0xbe, 0x5, 0x41, 0x9e, 0xd2, 0xc8},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -126,10 +146,6 @@ func TestDecodeMsg_SuccessOnMsgRevokeAllowance(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq",
 				Hash:       []byte{0x64, 0xd3, 0xfc, 0x6a, 0x2a, 0x52, 0x4e, 0x2f, 0x60, 0x3f, 0x51, 0xc7, 0xee, 0x4e, 0x8d, 0x35, 0xf7, 0x23, 0x22, 0xf8},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
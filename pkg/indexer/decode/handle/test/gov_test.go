@@ -39,9 +39,6 @@ func createExpectations(
 				LastHeight: blob.Height,
 				Address:    address,
 				Hash:       hash,
-				Balance: storage.Balance{
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -88,6 +85,13 @@ func TestDecodeMsg_SuccessOnMsgSubmitProposal_V1(t *testing.T) {
 		[]byte{123, 95, 226, 43, 84, 70, 247, 198, 46, 162, 123, 139, 215, 28, 239, 148, 224, 63, 61, 242},
 		storageTypes.MsgSubmitProposal,
 	)
+	msgExpected.Proposal = &storage.Proposal{
+		Height:   blob.Height,
+		Type:     storageTypes.ProposalTypeUnknown,
+		Status:   storageTypes.ProposalStatusDepositPeriod,
+		Deposit:  decimal.Zero,
+		Proposer: &addressesExpected[0].Address,
+	}
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), dm.BlobsSize)
@@ -122,6 +126,13 @@ func TestDecodeMsg_SuccessOnMsgSubmitProposal_V1Beta1(t *testing.T) {
 		[]byte{123, 95, 226, 43, 84, 70, 247, 198, 46, 162, 123, 139, 215, 28, 239, 148, 224, 63, 61, 242},
 		storageTypes.MsgSubmitProposal,
 	)
+	msgExpected.Proposal = &storage.Proposal{
+		Height:   blob.Height,
+		Type:     storageTypes.ProposalTypeUnknown,
+		Status:   storageTypes.ProposalStatusDepositPeriod,
+		Deposit:  decimal.Zero,
+		Proposer: &addressesExpected[0].Address,
+	}
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), dm.BlobsSize)
@@ -189,6 +200,15 @@ func TestDecodeMsg_SuccessOnMsgVote_V1(t *testing.T) {
 		[]byte{8, 204, 180, 93, 112, 144, 218, 230, 174, 203, 58, 172, 76, 199, 190, 39, 45, 188, 116, 154},
 		storageTypes.MsgVote,
 	)
+	msgExpected.Votes = []storage.Vote{
+		{
+			Height:     blob.Height,
+			ProposalId: 1,
+			Option:     storageTypes.VoteOptionYes,
+			Weight:     decimal.RequireFromString("1"),
+			Voter:      &addressesExpected[0].Address,
+		},
+	}
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), dm.BlobsSize)
@@ -223,6 +243,16 @@ func TestDecodeMsg_SuccessOnMsgVote_V1Beta1(t *testing.T) {
 		[]byte{8, 204, 180, 93, 112, 144, 218, 230, 174, 203, 58, 172, 76, 199, 190, 39, 45, 188, 116, 154},
 		storageTypes.MsgVote,
 	)
+	msgExpected.Votes = []storage.Vote{
+		{
+			Height:     blob.Height,
+			ProposalId: 1,
+			Option:     storageTypes.VoteOptionYes,
+			Weight:     decimal.RequireFromString("1"),
+			Voter:      &addressesExpected[0].Address,
+		},
+	}
+
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), dm.BlobsSize)
 	assert.Equal(t, msgExpected, dm.Msg)
@@ -256,6 +286,8 @@ func TestDecodeMsg_SuccessOnMsgVoteWeighted_V1(t *testing.T) {
 		[]byte{8, 204, 180, 93, 112, 144, 218, 230, 174, 203, 58, 172, 76, 199, 190, 39, 45, 188, 116, 154},
 		storageTypes.MsgVoteWeighted,
 	)
+	msgExpected.Votes = []storage.Vote{}
+
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), dm.BlobsSize)
 	assert.Equal(t, msgExpected, dm.Msg)
@@ -288,6 +320,7 @@ func TestDecodeMsg_SuccessOnMsgVoteWeighted_V1Beta1(t *testing.T) {
 		[]byte{8, 204, 180, 93, 112, 144, 218, 230, 174, 203, 58, 172, 76, 199, 190, 39, 45, 188, 116, 154},
 		storageTypes.MsgVoteWeighted,
 	)
+	msgExpected.Votes = []storage.Vote{}
 
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), dm.BlobsSize)
@@ -359,3 +392,79 @@ func TestDecodeMsg_SuccessOnMsgDeposit_V1Beta1(t *testing.T) {
 	assert.Equal(t, msgExpected, dm.Msg)
 	assert.Equal(t, addressesExpected, dm.Addresses)
 }
+
+func TestDecodeMsg_SuccessOnMsgSubmitProposal_WithContent(t *testing.T) {
+	content, err := codecTypes.NewAnyWithValue(&cosmosGovTypesV1Beta1.TextProposal{
+		Title:       "Title",
+		Description: "Description",
+	})
+	assert.NoError(t, err)
+
+	m := &cosmosGovTypesV1Beta1.MsgSubmitProposal{
+		Content:        content,
+		InitialDeposit: types.NewCoins(types.NewInt64Coin("utia", 1000), types.NewInt64Coin("stake", 10)),
+		Proposer:       "celestia10d07y265gmmuvt4z0w9aw880jnsr700jtgz4v7",
+	}
+	blob, _ := testsuite.EmptyBlock()
+
+	dm, err := decode.Message(m, blob.Height, blob.Block.Time, 0, storageTypes.StatusSuccess)
+	assert.NoError(t, err)
+	assert.NotNil(t, dm.Msg.Proposal)
+	assert.Equal(t, "Title", dm.Msg.Proposal.Title)
+	assert.Equal(t, "Description", dm.Msg.Proposal.Description)
+	assert.Equal(t, storageTypes.ProposalTypeText, dm.Msg.Proposal.Type)
+	assert.Equal(t, storageTypes.ProposalStatusDepositPeriod, dm.Msg.Proposal.Status)
+	assert.Equal(t, "celestia10d07y265gmmuvt4z0w9aw880jnsr700jtgz4v7", dm.Msg.Proposal.Proposer.Address)
+
+	assert.NotNil(t, dm.Msg.Deposit)
+	assert.Equal(t, "1000", dm.Msg.Deposit.Amount.String())
+	assert.EqualValues(t, 0, dm.Msg.Deposit.ProposalId)
+	assert.Equal(t, "celestia10d07y265gmmuvt4z0w9aw880jnsr700jtgz4v7", dm.Msg.Deposit.Depositor.Address)
+}
+
+func TestDecodeMsg_SuccessOnMsgVoteWeighted_WithOptions(t *testing.T) {
+	m := &cosmosGovTypesV1.MsgVoteWeighted{
+		ProposalId: 2,
+		Voter:      "celestia1prxtghtsjrdwdtkt82kye3a7yukmcay6x9uyts",
+		Options: []*cosmosGovTypesV1.WeightedVoteOption{
+			{Option: cosmosGovTypesV1.OptionYes, Weight: "0.7"},
+			{Option: cosmosGovTypesV1.OptionNoWithVeto, Weight: "0.3"},
+		},
+	}
+	blob, _ := testsuite.EmptyBlock()
+
+	dm, err := decode.Message(m, blob.Height, blob.Block.Time, 0, storageTypes.StatusSuccess)
+	assert.NoError(t, err)
+	assert.Len(t, dm.Msg.Votes, 2)
+	assert.Equal(t, storageTypes.VoteOptionYes, dm.Msg.Votes[0].Option)
+	assert.Equal(t, "0.7", dm.Msg.Votes[0].Weight.String())
+	assert.Equal(t, storageTypes.VoteOptionNoWithVeto, dm.Msg.Votes[1].Option)
+	assert.Equal(t, "0.3", dm.Msg.Votes[1].Weight.String())
+	assert.EqualValues(t, 2, dm.Msg.Votes[1].ProposalId)
+}
+
+func TestDecodeMsg_SuccessOnMsgDeposit_WithAmount(t *testing.T) {
+	m := &cosmosGovTypesV1.MsgDeposit{
+		ProposalId: 3,
+		Depositor:  "celestia1prxtghtsjrdwdtkt82kye3a7yukmcay6x9uyts",
+		Amount:     types.NewCoins(types.NewInt64Coin("utia", 500)),
+	}
+	blob, _ := testsuite.EmptyBlock()
+
+	dm, err := decode.Message(m, blob.Height, blob.Block.Time, 0, storageTypes.StatusSuccess)
+	assert.NoError(t, err)
+	assert.NotNil(t, dm.Msg.Deposit)
+	assert.EqualValues(t, 3, dm.Msg.Deposit.ProposalId)
+	assert.Equal(t, "500", dm.Msg.Deposit.Amount.String())
+	assert.Equal(t, "celestia1prxtghtsjrdwdtkt82kye3a7yukmcay6x9uyts", dm.Msg.Deposit.Depositor.Address)
+}
+
+func TestDecodeMsg_FailedMsgVote(t *testing.T) {
+	m := createMsgVoteV1()
+	blob, _ := testsuite.EmptyBlock()
+
+	dm, err := decode.Message(m, blob.Height, blob.Block.Time, 0, storageTypes.StatusFailed)
+	assert.NoError(t, err)
+	assert.Nil(t, dm.Msg.Votes)
+	assert.Len(t, dm.Msg.Addresses, 1)
+}
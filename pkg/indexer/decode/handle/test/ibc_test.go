@@ -7,6 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types"
 	ibcTypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	ibcCoreClientTypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	ibcCoreConnectionTypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	ibcCoreChannelTypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
 	testsuite "github.com/dipdup-io/celestia-indexer/internal/test_suite"
@@ -21,14 +23,14 @@ import (
 
 func createIBCMsgTransfer() types.Msg {
 	m := ibcTypes.MsgTransfer{
-		SourcePort:       "",
-		SourceChannel:    "",
-		Token:            types.Coin{},
+		SourcePort:       "transfer",
+		SourceChannel:    "channel-2",
+		Token:            types.NewInt64Coin("utia", 1000),
 		Sender:           "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
 		Receiver:         "celestia1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l",
 		TimeoutHeight:    ibcCoreClientTypes.Height{},
 		TimeoutTimestamp: 0,
-		Memo:             "",
+		Memo:             "memo",
 	}
 
 	return &m
@@ -50,10 +52,6 @@ func TestDecodeMsg_SuccessOnIBCMsgTransfer(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
 				Hash:       []byte{0x94, 0x63, 0x42, 0xc7, 0x73, 0x2f, 0x6, 0x22, 0x37, 0xb6, 0x73, 0xf5, 0x26, 0xf2, 0x67, 0x4c, 0x7d, 0xb, 0xff, 0x98},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -68,6 +66,17 @@ func TestDecodeMsg_SuccessOnIBCMsgTransfer(t *testing.T) {
 		Data:      structs.Map(msgSend),
 		Namespace: nil,
 		Addresses: addressesExpected,
+		IbcTransfer: &storage.IbcTransfer{
+			Height:        blob.Height,
+			Status:        storageTypes.IbcTransferStatusPending,
+			SourcePort:    "transfer",
+			SourceChannel: "channel-2",
+			Sender:        "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+			Receiver:      "celestia1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l",
+			Amount:        decimal.NewFromInt(1000),
+			Denom:         "utia",
+			Memo:          "memo",
+		},
 	}
 
 	assert.NoError(t, err)
@@ -75,3 +84,182 @@ func TestDecodeMsg_SuccessOnIBCMsgTransfer(t *testing.T) {
 	assert.Equal(t, msgExpected, dm.Msg)
 	assert.Equal(t, addressesExpected, dm.Addresses)
 }
+
+// MsgRecvPacket
+
+func createIBCMsgRecvPacket() types.Msg {
+	m := ibcCoreChannelTypes.MsgRecvPacket{
+		Packet: ibcCoreChannelTypes.Packet{
+			Sequence:           1,
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-0",
+			DestinationPort:    "transfer",
+			DestinationChannel: "channel-2",
+			Data:               []byte(`{"amount":"100","denom":"uosmo","receiver":"celestia1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l","sender":"osmo1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l"}`),
+		},
+		ProofHeight: ibcCoreClientTypes.NewHeight(1, 100),
+		Signer:      "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+	}
+
+	return &m
+}
+
+func TestDecodeMsg_SuccessOnIBCMsgRecvPacket(t *testing.T) {
+	msg := createIBCMsgRecvPacket()
+	blob, now := testsuite.EmptyBlock()
+	position := 0
+
+	dm, err := decode.Message(msg, blob.Height, blob.Block.Time, position, storageTypes.StatusSuccess)
+
+	addressesExpected := []storage.AddressWithType{
+		{
+			Type: storageTypes.MsgAddressTypeRelayer,
+			Address: storage.Address{
+				Id:         0,
+				Height:     blob.Height,
+				LastHeight: blob.Height,
+				Address:    "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6",
+				Hash:       []byte{0x94, 0x63, 0x42, 0xc7, 0x73, 0x2f, 0x6, 0x22, 0x37, 0xb6, 0x73, 0xf5, 0x26, 0xf2, 0x67, 0x4c, 0x7d, 0xb, 0xff, 0x98},
+			},
+		}, {
+			Type: storageTypes.MsgAddressTypeReceiver,
+			Address: storage.Address{
+				Id:         0,
+				Height:     blob.Height,
+				LastHeight: blob.Height,
+				Address:    "celestia1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l",
+				Hash:       []byte{0x64, 0x18, 0x63, 0xcf, 0xc9, 0x3b, 0x6f, 0x4e, 0x37, 0x30, 0x0, 0x2e, 0x81, 0xcb, 0x8b, 0x27, 0x27, 0xe1, 0x0, 0x68},
+			},
+		},
+	}
+
+	msgExpected := storage.Message{
+		Id:        0,
+		Height:    blob.Height,
+		Time:      now,
+		Position:  0,
+		Type:      storageTypes.MsgRecvPacket,
+		TxId:      0,
+		Data:      structs.Map(msg),
+		Namespace: nil,
+		Addresses: addressesExpected,
+		IbcTransfer: &storage.IbcTransfer{
+			Height:             blob.Height,
+			Incoming:           true,
+			Status:             storageTypes.IbcTransferStatusSuccess,
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-0",
+			DestinationPort:    "transfer",
+			DestinationChannel: "channel-2",
+			Sequence:           1,
+			Sender:             "osmo1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l",
+			Receiver:           "celestia1vsvx8n7f8dh5udesqqhgrjutyun7zqrgehdq2l",
+			Amount:             decimal.NewFromInt(100),
+			Denom:              "transfer/channel-2/uosmo",
+		},
+	}
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), dm.BlobsSize)
+	assert.Equal(t, msgExpected, dm.Msg)
+	assert.Equal(t, addressesExpected, dm.Addresses)
+}
+
+func TestDecodeMsg_SuccessOnIBCCoreMessages(t *testing.T) {
+	const signer = "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6"
+
+	tests := []struct {
+		msg         types.Msg
+		msgType     storageTypes.MsgType
+		addressType storageTypes.MsgAddressType
+	}{
+		{&ibcCoreClientTypes.MsgCreateClient{Signer: signer}, storageTypes.MsgCreateClient, storageTypes.MsgAddressTypeSigner},
+		{&ibcCoreClientTypes.MsgUpdateClient{ClientId: "07-tendermint-0", Signer: signer}, storageTypes.MsgUpdateClient, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreClientTypes.MsgUpgradeClient{ClientId: "07-tendermint-0", Signer: signer}, storageTypes.MsgUpgradeClient, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreClientTypes.MsgSubmitMisbehaviour{ClientId: "07-tendermint-0", Signer: signer}, storageTypes.MsgSubmitMisbehaviour, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreConnectionTypes.MsgConnectionOpenInit{ClientId: "07-tendermint-0", Signer: signer}, storageTypes.MsgConnectionOpenInit, storageTypes.MsgAddressTypeSigner},
+		{&ibcCoreConnectionTypes.MsgConnectionOpenTry{ClientId: "07-tendermint-0", Signer: signer}, storageTypes.MsgConnectionOpenTry, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreConnectionTypes.MsgConnectionOpenAck{ConnectionId: "connection-0", Signer: signer}, storageTypes.MsgConnectionOpenAck, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreConnectionTypes.MsgConnectionOpenConfirm{ConnectionId: "connection-0", Signer: signer}, storageTypes.MsgConnectionOpenConfirm, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreChannelTypes.MsgChannelOpenInit{PortId: "transfer", Signer: signer}, storageTypes.MsgChannelOpenInit, storageTypes.MsgAddressTypeSigner},
+		{&ibcCoreChannelTypes.MsgChannelOpenTry{PortId: "transfer", Signer: signer}, storageTypes.MsgChannelOpenTry, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreChannelTypes.MsgChannelOpenAck{PortId: "transfer", ChannelId: "channel-0", Signer: signer}, storageTypes.MsgChannelOpenAck, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreChannelTypes.MsgChannelOpenConfirm{PortId: "transfer", ChannelId: "channel-0", Signer: signer}, storageTypes.MsgChannelOpenConfirm, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreChannelTypes.MsgChannelCloseInit{PortId: "transfer", ChannelId: "channel-0", Signer: signer}, storageTypes.MsgChannelCloseInit, storageTypes.MsgAddressTypeSigner},
+		{&ibcCoreChannelTypes.MsgChannelCloseConfirm{PortId: "transfer", ChannelId: "channel-0", Signer: signer}, storageTypes.MsgChannelCloseConfirm, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreChannelTypes.MsgTimeout{NextSequenceRecv: 2, Signer: signer}, storageTypes.MsgTimeout, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreChannelTypes.MsgTimeoutOnClose{NextSequenceRecv: 2, Signer: signer}, storageTypes.MsgTimeoutOnClose, storageTypes.MsgAddressTypeRelayer},
+		{&ibcCoreChannelTypes.MsgAcknowledgement{Acknowledgement: []byte(`{"result":"AQ=="}`), Signer: signer}, storageTypes.MsgAcknowledgement, storageTypes.MsgAddressTypeRelayer},
+	}
+
+	blob, _ := testsuite.EmptyBlock()
+	for _, tt := range tests {
+		t.Run(string(tt.msgType), func(t *testing.T) {
+			dm, err := decode.Message(tt.msg, blob.Height, blob.Block.Time, 0, storageTypes.StatusSuccess)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.msgType, dm.Msg.Type)
+			assert.Len(t, dm.Addresses, 1)
+			assert.Equal(t, tt.addressType, dm.Addresses[0].Type)
+			assert.Equal(t, signer, dm.Addresses[0].Address.Address)
+		})
+	}
+}
+
+func TestDecodeMsg_IBCTransferResolution(t *testing.T) {
+	const signer = "celestia1j33593mn9urzydakw06jdun8f37shlucmhr8p6"
+	packet := ibcCoreChannelTypes.Packet{
+		Sequence:           5,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-2",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-6994",
+	}
+	icaPacket := packet
+	icaPacket.SourcePort = "icacontroller-1"
+
+	tests := []struct {
+		name string
+		msg  types.Msg
+		want storageTypes.IbcTransferStatus
+	}{
+		{
+			name: "success acknowledgement",
+			msg:  &ibcCoreChannelTypes.MsgAcknowledgement{Packet: packet, Acknowledgement: []byte(`{"result":"AQ=="}`), Signer: signer},
+			want: storageTypes.IbcTransferStatusSuccess,
+		}, {
+			name: "error acknowledgement",
+			msg:  &ibcCoreChannelTypes.MsgAcknowledgement{Packet: packet, Acknowledgement: []byte(`{"error":"ABCI code: 1: error handling packet: see events for details"}`), Signer: signer},
+			want: storageTypes.IbcTransferStatusFailed,
+		}, {
+			name: "timeout",
+			msg:  &ibcCoreChannelTypes.MsgTimeout{Packet: packet, NextSequenceRecv: 5, Signer: signer},
+			want: storageTypes.IbcTransferStatusTimeout,
+		}, {
+			name: "timeout on close",
+			msg:  &ibcCoreChannelTypes.MsgTimeoutOnClose{Packet: packet, NextSequenceRecv: 5, Signer: signer},
+			want: storageTypes.IbcTransferStatusTimeout,
+		}, {
+			name: "not transfer port",
+			msg:  &ibcCoreChannelTypes.MsgTimeout{Packet: icaPacket, NextSequenceRecv: 5, Signer: signer},
+		},
+	}
+
+	blob, _ := testsuite.EmptyBlock()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm, err := decode.Message(tt.msg, blob.Height, blob.Block.Time, 0, storageTypes.StatusSuccess)
+			assert.NoError(t, err)
+			if tt.want == "" {
+				assert.Nil(t, dm.Msg.IbcTransfer)
+				return
+			}
+			assert.NotNil(t, dm.Msg.IbcTransfer)
+			assert.True(t, dm.Msg.IbcTransfer.IsResolution())
+			assert.Equal(t, tt.want, dm.Msg.IbcTransfer.Status)
+			assert.Equal(t, "transfer", dm.Msg.IbcTransfer.SourcePort)
+			assert.Equal(t, "channel-2", dm.Msg.IbcTransfer.SourceChannel)
+			assert.EqualValues(t, 5, dm.Msg.IbcTransfer.Sequence)
+			assert.Equal(t, blob.Height, dm.Msg.IbcTransfer.ResolvedHeight)
+		})
+	}
+}
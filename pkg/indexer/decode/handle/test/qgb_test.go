@@ -11,7 +11,6 @@ import (
 	"github.com/dipdup-io/celestia-indexer/internal/test_suite"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
 	"github.com/fatih/structs"
-	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -43,9 +42,6 @@ func TestDecodeMsg_SuccessOnMsgRegisterEvmAddress(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestiavaloper1f5crra7r5m9kd6saw077u76x0n7dyjkkzk0qup",
 				Hash:       []byte{0x4d, 0x30, 0x31, 0xf7, 0xc3, 0xa6, 0xcb, 0x66, 0xea, 0x1d, 0x73, 0xfd, 0xee, 0x7b, 0x46, 0x7c, 0xfc, 0xd2, 0x4a, 0xd6},
-				Balance: storage.Balance{
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
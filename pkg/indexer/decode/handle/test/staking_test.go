@@ -5,6 +5,8 @@ package handle_test
 
 import (
 	"cosmossdk.io/math"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/types"
 	cosmosStakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
@@ -52,10 +54,6 @@ func TestDecodeMsg_SuccessOnMsgEditValidator(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
 				Hash:       []byte{0x4a, 0xb, 0xf8, 0x99, 0x89, 0xe7, 0xa, 0xe3, 0xf, 0x3b, 0x62, 0x94, 0xa2, 0x97, 0x5a, 0x17, 0x5c, 0x48, 0x22, 0xa3},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -77,6 +75,7 @@ func TestDecodeMsg_SuccessOnMsgEditValidator(t *testing.T) {
 			Website:           "https://google.com",
 			Contacts:          "tryme@gmail.com",
 			Details:           "trust",
+			Status:            storageTypes.ValidatorStatusUnbonded,
 			Rate:              decimal.Zero,
 			MinSelfDelegation: decimal.Zero,
 		},
@@ -95,6 +94,10 @@ func createMsgBeginRedelegate() types.Msg {
 		DelegatorAddress:    "celestia1ws4hfsl8hlylt38ptk5cn9ura20slu2fnkre76",
 		ValidatorSrcAddress: "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
 		ValidatorDstAddress: "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y",
+		Amount: types.Coin{
+			Denom:  "utia",
+			Amount: math.NewInt(1000),
+		},
 	}
 
 	return &m
@@ -116,10 +119,6 @@ func TestDecodeMsg_SuccessOnMsgBeginRedelegate(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1ws4hfsl8hlylt38ptk5cn9ura20slu2fnkre76",
 				Hash:       []byte{0x74, 0x2b, 0x74, 0xc3, 0xe7, 0xbf, 0xc9, 0xf5, 0xc4, 0xe1, 0x5d, 0xa9, 0x89, 0x97, 0x83, 0xea, 0x9f, 0xf, 0xf1, 0x49},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -130,10 +129,6 @@ func TestDecodeMsg_SuccessOnMsgBeginRedelegate(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
 				Hash:       []byte{0x4a, 0xb, 0xf8, 0x99, 0x89, 0xe7, 0xa, 0xe3, 0xf, 0x3b, 0x62, 0x94, 0xa2, 0x97, 0x5a, 0x17, 0x5c, 0x48, 0x22, 0xa3},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -144,10 +139,6 @@ func TestDecodeMsg_SuccessOnMsgBeginRedelegate(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y",
 				Hash:       []byte{0x56, 0x35, 0x87, 0x35, 0xf6, 0x7, 0xd1, 0x53, 0xc1, 0xb7, 0x94, 0xe6, 0x54, 0xbc, 0x47, 0x52, 0x1f, 0x83, 0xa9, 0x3b},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -162,6 +153,26 @@ func TestDecodeMsg_SuccessOnMsgBeginRedelegate(t *testing.T) {
 		Data:      structs.Map(m),
 		Namespace: nil,
 		Addresses: addressesExpected,
+		Delegations: []storage.DelegationLog{
+			{
+				Height:    blob.Height,
+				Validator: "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
+				Amount:    decimal.RequireFromString("1000").Neg(),
+				Address:   &addressesExpected[0].Address,
+			}, {
+				Height:    blob.Height,
+				Validator: "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y",
+				Amount:    decimal.RequireFromString("1000"),
+				Address:   &addressesExpected[0].Address,
+			},
+		},
+		Redelegation: &storage.Redelegation{
+			Height:      blob.Height,
+			Source:      "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
+			Destination: "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y",
+			Amount:      decimal.RequireFromString("1000"),
+			Address:     &addressesExpected[0].Address,
+		},
 	}
 
 	assert.NoError(t, err)
@@ -202,10 +213,6 @@ func TestDecodeMsg_SuccessOnMsgCreateValidator(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1ws4hfsl8hlylt38ptk5cn9ura20slu2fnkre76",
 				Hash:       []byte{0x74, 0x2b, 0x74, 0xc3, 0xe7, 0xbf, 0xc9, 0xf5, 0xc4, 0xe1, 0x5d, 0xa9, 0x89, 0x97, 0x83, 0xea, 0x9f, 0xf, 0xf1, 0x49},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -216,10 +223,6 @@ func TestDecodeMsg_SuccessOnMsgCreateValidator(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
 				Hash:       []byte{0x4a, 0xb, 0xf8, 0x99, 0x89, 0xe7, 0xa, 0xe3, 0xf, 0x3b, 0x62, 0x94, 0xa2, 0x97, 0x5a, 0x17, 0x5c, 0x48, 0x22, 0xa3},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -242,6 +245,7 @@ func TestDecodeMsg_SuccessOnMsgCreateValidator(t *testing.T) {
 			MaxChangeRate:     decimal.Zero,
 			MinSelfDelegation: decimal.RequireFromString("1"),
 			Height:            blob.Height,
+			Status:            storageTypes.ValidatorStatusUnbonded,
 		},
 	}
 	assert.NoError(t, err)
@@ -250,6 +254,27 @@ func TestDecodeMsg_SuccessOnMsgCreateValidator(t *testing.T) {
 	assert.Equal(t, addressesExpected, dm.Addresses)
 }
 
+func TestDecodeMsg_MsgCreateValidatorConsAddress(t *testing.T) {
+	pubKey := &ed25519.PubKey{
+		Key: []byte{
+			0x3a, 0x0c, 0x5b, 0x2e, 0x5f, 0x17, 0x9f, 0x71, 0x3b, 0x2f, 0x3b, 0x54, 0x9e, 0x3a, 0x1d, 0x6f,
+			0x0a, 0x74, 0x8a, 0xb5, 0x35, 0x33, 0x52, 0x8d, 0xa0, 0x12, 0x54, 0x8b, 0x5a, 0x2e, 0xe4, 0x21,
+		},
+	}
+	anyPubKey, err := codecTypes.NewAnyWithValue(pubKey)
+	assert.NoError(t, err)
+
+	m := createMsgCreateValidator().(*cosmosStakingTypes.MsgCreateValidator)
+	m.Pubkey = anyPubKey
+	blob, _ := testsuite.EmptyBlock()
+
+	dm, err := decode.Message(m, blob.Height, blob.Block.Time, 0, storageTypes.StatusSuccess)
+	assert.NoError(t, err)
+	assert.NotNil(t, dm.Msg.Validator)
+	assert.Len(t, dm.Msg.Validator.ConsAddress, 20)
+	assert.EqualValues(t, pubKey.Address(), dm.Msg.Validator.ConsAddress)
+}
+
 // MsgDelegate
 
 func createMsgDelegate() types.Msg {
@@ -282,10 +307,6 @@ func TestDecodeMsg_SuccessOnMsgDelegate(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1vysgwc9mykfz5249g9thjlffx6nha0kkwsvs37",
 				Hash:       []byte{0x61, 0x20, 0x87, 0x60, 0xbb, 0x25, 0x92, 0x2a, 0x2a, 0xa5, 0x41, 0x57, 0x79, 0x7d, 0x29, 0x36, 0xa7, 0x7e, 0xbe, 0xd6},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -296,10 +317,6 @@ func TestDecodeMsg_SuccessOnMsgDelegate(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y",
 				Hash:       []byte{0x56, 0x35, 0x87, 0x35, 0xf6, 0x7, 0xd1, 0x53, 0xc1, 0xb7, 0x94, 0xe6, 0x54, 0xbc, 0x47, 0x52, 0x1f, 0x83, 0xa9, 0x3b},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -314,6 +331,14 @@ func TestDecodeMsg_SuccessOnMsgDelegate(t *testing.T) {
 		Data:      structs.Map(msgDelegate),
 		Namespace: nil,
 		Addresses: addressesExpected,
+		Delegations: []storage.DelegationLog{
+			{
+				Height:    blob.Height,
+				Validator: "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y",
+				Amount:    decimal.RequireFromString("1000"),
+				Address:   &addressesExpected[0].Address,
+			},
+		},
 	}
 
 	assert.NoError(t, err)
@@ -352,10 +377,6 @@ func TestDecodeMsg_SuccessOnMsgUndelegate(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1vysgwc9mykfz5249g9thjlffx6nha0kkwsvs37",
 				Hash:       []byte{0x61, 0x20, 0x87, 0x60, 0xbb, 0x25, 0x92, 0x2a, 0x2a, 0xa5, 0x41, 0x57, 0x79, 0x7d, 0x29, 0x36, 0xa7, 0x7e, 0xbe, 0xd6},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -366,10 +387,6 @@ func TestDecodeMsg_SuccessOnMsgUndelegate(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestiavaloper170qq26qenw420ufd5py0r59kpg3tj2m7dqkpym",
 				Hash:       []byte{0xf3, 0xc0, 0x5, 0x68, 0x19, 0x9b, 0xaa, 0xa7, 0xf1, 0x2d, 0xa0, 0x48, 0xf1, 0xd0, 0xb6, 0xa, 0x22, 0xb9, 0x2b, 0x7e},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -384,6 +401,20 @@ func TestDecodeMsg_SuccessOnMsgUndelegate(t *testing.T) {
 		Data:      structs.Map(m),
 		Namespace: nil,
 		Addresses: addressesExpected,
+		Delegations: []storage.DelegationLog{
+			{
+				Height:    blob.Height,
+				Validator: "celestiavaloper170qq26qenw420ufd5py0r59kpg3tj2m7dqkpym",
+				Amount:    decimal.RequireFromString("1001").Neg(),
+				Address:   &addressesExpected[0].Address,
+			},
+		},
+		Unbonding: &storage.Unbonding{
+			Height:    blob.Height,
+			Validator: "celestiavaloper170qq26qenw420ufd5py0r59kpg3tj2m7dqkpym",
+			Amount:    decimal.RequireFromString("1001"),
+			Address:   &addressesExpected[0].Address,
+		},
 	}
 
 	assert.NoError(t, err)
@@ -423,10 +454,6 @@ func TestDecodeMsg_SuccessOnMsgCancelUnbondingDelegation(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestia1vysgwc9mykfz5249g9thjlffx6nha0kkwsvs37",
 				Hash:       []byte{0x61, 0x20, 0x87, 0x60, 0xbb, 0x25, 0x92, 0x2a, 0x2a, 0xa5, 0x41, 0x57, 0x79, 0x7d, 0x29, 0x36, 0xa7, 0x7e, 0xbe, 0xd6},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 		{
@@ -437,10 +464,6 @@ func TestDecodeMsg_SuccessOnMsgCancelUnbondingDelegation(t *testing.T) {
 				LastHeight: blob.Height,
 				Address:    "celestiavaloper170qq26qenw420ufd5py0r59kpg3tj2m7dqkpym",
 				Hash:       []byte{0xf3, 0xc0, 0x5, 0x68, 0x19, 0x9b, 0xaa, 0xa7, 0xf1, 0x2d, 0xa0, 0x48, 0xf1, 0xd0, 0xb6, 0xa, 0x22, 0xb9, 0x2b, 0x7e},
-				Balance: storage.Balance{
-					Id:    0,
-					Total: decimal.Zero,
-				},
 			},
 		},
 	}
@@ -455,6 +478,15 @@ func TestDecodeMsg_SuccessOnMsgCancelUnbondingDelegation(t *testing.T) {
 		Data:      structs.Map(m),
 		Namespace: nil,
 		Addresses: addressesExpected,
+		Delegations: []storage.DelegationLog{
+			{
+				Height:         blob.Height,
+				Validator:      "celestiavaloper170qq26qenw420ufd5py0r59kpg3tj2m7dqkpym",
+				Amount:         decimal.RequireFromString("1001"),
+				CreationHeight: 100,
+				Address:        &addressesExpected[0].Address,
+			},
+		},
 	}
 
 	assert.NoError(t, err)
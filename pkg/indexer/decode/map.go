@@ -5,6 +5,7 @@ package decode
 
 import (
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/shopspring/decimal"
@@ -41,14 +42,22 @@ func StringFromMap(m map[string]any, key string) string {
 	return str
 }
 
-func BalanceFromMap(m map[string]any, key string) (*types.Coin, error) {
+func CoinsFromMap(m map[string]any, key string) (types.Coins, error) {
 	str := StringFromMap(m, key)
 	if str == "" {
 		return nil, nil
 	}
-	coin, err := types.ParseCoinNormalized(str)
+	coins, err := types.ParseCoinsNormalized(str)
 	if err != nil {
 		return nil, err
 	}
-	return &coin, nil
+	return coins, nil
+}
+
+func TimeFromMap(m map[string]any, key string) (time.Time, error) {
+	str := StringFromMap(m, key)
+	if str == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, str)
 }
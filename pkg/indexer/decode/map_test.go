@@ -5,6 +5,7 @@ package decode
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -67,3 +68,47 @@ func TestDecimalFromMap(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeFromMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       map[string]any
+		key     string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "test 1",
+			m: map[string]any{
+				"completion_time": "2023-11-08T12:30:05Z",
+			},
+			key:  "completion_time",
+			want: time.Date(2023, 11, 8, 12, 30, 5, 0, time.UTC),
+		}, {
+			name: "test 2",
+			m: map[string]any{
+				"completion_time": "2023-11-08T12:30:05Z",
+			},
+			key:  "invalid",
+			want: time.Time{},
+		}, {
+			name: "test 3",
+			m: map[string]any{
+				"completion_time": "invalid",
+			},
+			key:     "completion_time",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TimeFromMap(tt.m, tt.key)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tt.want.Equal(got), tt.name)
+		})
+	}
+}
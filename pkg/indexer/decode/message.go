@@ -6,6 +6,9 @@ package decode
 import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	ibcTypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibcClientTypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	ibcConnectionTypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	ibcChannelTypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode/handle"
 	"time"
 
@@ -47,6 +50,8 @@ func Message(
 	d.Msg.Position = int64(position)
 	d.Msg.Data = structs.Map(msg)
 
+	var internalAddresses []storage.AddressWithType
+
 	switch typedMsg := msg.(type) {
 
 	// distribution module
@@ -61,17 +66,17 @@ func Message(
 
 	// staking module
 	case *cosmosStakingTypes.MsgCreateValidator:
-		d.Msg.Type, d.Msg.Addresses, d.Msg.Validator, err = handle.MsgCreateValidator(height, status, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Validator, d.Msg.Delegations, err = handle.MsgCreateValidator(height, status, typedMsg)
 	case *cosmosStakingTypes.MsgEditValidator:
 		d.Msg.Type, d.Msg.Addresses, d.Msg.Validator, err = handle.MsgEditValidator(height, status, typedMsg)
 	case *cosmosStakingTypes.MsgDelegate:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgDelegate(height, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Delegations, err = handle.MsgDelegate(height, status, typedMsg)
 	case *cosmosStakingTypes.MsgBeginRedelegate:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgBeginRedelegate(height, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Delegations, d.Msg.Redelegation, err = handle.MsgBeginRedelegate(height, status, typedMsg)
 	case *cosmosStakingTypes.MsgUndelegate:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUndelegate(height, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Delegations, d.Msg.Unbonding, err = handle.MsgUndelegate(height, status, typedMsg)
 	case *cosmosStakingTypes.MsgCancelUnbondingDelegation:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgCancelUnbondingDelegation(height, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Delegations, err = handle.MsgCancelUnbondingDelegation(height, status, typedMsg)
 
 	// slashing module
 	case *cosmosSlashingTypes.MsgUnjail:
@@ -98,7 +103,7 @@ func Message(
 
 	// feegrant module
 	case *cosmosFeegrant.MsgGrantAllowance:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgGrantAllowance(height, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.FeeAllowance, err = handle.MsgGrantAllowance(height, status, typedMsg)
 	case *cosmosFeegrant.MsgRevokeAllowance:
 		d.Msg.Type, d.Msg.Addresses, err = handle.MsgRevokeAllowance(height, typedMsg)
 
@@ -108,35 +113,82 @@ func Message(
 
 	// authz module
 	case *authz.MsgGrant:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgGrant(height, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Grant, err = handle.MsgGrant(height, status, typedMsg)
 	case *authz.MsgExec:
 		d.Msg.Type, d.Msg.Addresses, err = handle.MsgExec(height, typedMsg)
+		if err == nil {
+			d.Msg.InternalMsgs, d.BlobsSize, internalAddresses, err = execMessages(typedMsg, height, time, status)
+		}
 	case *authz.MsgRevoke:
 		d.Msg.Type, d.Msg.Addresses, err = handle.MsgRevoke(height, typedMsg)
 
 	// gov module
 	case *cosmosGovTypesV1.MsgSubmitProposal:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgSubmitProposal(height, typedMsg.Proposer)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Proposal, d.Msg.Deposit, err = handle.MsgSubmitProposal(height, status, typedMsg.Proposer, typedMsg.InitialDeposit, handle.LegacyContent(typedMsg))
 	case *cosmosGovTypesV1Beta1.MsgSubmitProposal:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgSubmitProposal(height, typedMsg.Proposer)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Proposal, d.Msg.Deposit, err = handle.MsgSubmitProposal(height, status, typedMsg.Proposer, typedMsg.InitialDeposit, handle.ContentV1Beta1(typedMsg))
 	case *cosmosGovTypesV1.MsgExecLegacyContent:
 		d.Msg.Type, d.Msg.Addresses, err = handle.MsgExecLegacyContent(height, typedMsg)
 	case *cosmosGovTypesV1.MsgVote:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgVote(height, typedMsg.Voter)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Votes, err = handle.MsgVote(height, status, typedMsg.Voter, typedMsg.ProposalId, int32(typedMsg.Option))
 	case *cosmosGovTypesV1Beta1.MsgVote:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgVote(height, typedMsg.Voter)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Votes, err = handle.MsgVote(height, status, typedMsg.Voter, typedMsg.ProposalId, int32(typedMsg.Option))
 	case *cosmosGovTypesV1.MsgVoteWeighted:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgVoteWeighted(height, typedMsg.Voter)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Votes, err = handle.MsgVoteWeighted(height, status, typedMsg.Voter, typedMsg.ProposalId, handle.WeightedOptionsV1(typedMsg.Options))
 	case *cosmosGovTypesV1Beta1.MsgVoteWeighted:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgVoteWeighted(height, typedMsg.Voter)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Votes, err = handle.MsgVoteWeighted(height, status, typedMsg.Voter, typedMsg.ProposalId, handle.WeightedOptionsV1Beta1(typedMsg.Options))
 	case *cosmosGovTypesV1.MsgDeposit:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgDeposit(height, typedMsg.Depositor)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Deposit, err = handle.MsgDeposit(height, status, typedMsg.Depositor, typedMsg.ProposalId, typedMsg.Amount)
 	case *cosmosGovTypesV1Beta1.MsgDeposit:
-		d.Msg.Type, d.Msg.Addresses, err = handle.MsgDeposit(height, typedMsg.Depositor)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.Deposit, err = handle.MsgDeposit(height, status, typedMsg.Depositor, typedMsg.ProposalId, typedMsg.Amount)
 
 	// ibc module
 	case *ibcTypes.MsgTransfer:
-		d.Msg.Type, d.Msg.Addresses, err = handle.IBCTransfer(height, typedMsg)
+		d.Msg.Type, d.Msg.Addresses, d.Msg.IbcTransfer, err = handle.IBCTransfer(height, status, typedMsg)
+
+	// ibc client
+	case *ibcClientTypes.MsgCreateClient:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgCreateClient(height, typedMsg)
+	case *ibcClientTypes.MsgUpdateClient:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpdateClient(height, typedMsg)
+	case *ibcClientTypes.MsgUpgradeClient:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgUpgradeClient(height, typedMsg)
+	case *ibcClientTypes.MsgSubmitMisbehaviour:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgSubmitMisbehaviour(height, typedMsg)
+
+	// ibc connection
+	case *ibcConnectionTypes.MsgConnectionOpenInit:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgConnectionOpenInit(height, typedMsg)
+	case *ibcConnectionTypes.MsgConnectionOpenTry:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgConnectionOpenTry(height, typedMsg)
+	case *ibcConnectionTypes.MsgConnectionOpenAck:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgConnectionOpenAck(height, typedMsg)
+	case *ibcConnectionTypes.MsgConnectionOpenConfirm:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgConnectionOpenConfirm(height, typedMsg)
+
+	// ibc channel
+	case *ibcChannelTypes.MsgChannelOpenInit:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgChannelOpenInit(height, typedMsg)
+	case *ibcChannelTypes.MsgChannelOpenTry:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgChannelOpenTry(height, typedMsg)
+	case *ibcChannelTypes.MsgChannelOpenAck:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgChannelOpenAck(height, typedMsg)
+	case *ibcChannelTypes.MsgChannelOpenConfirm:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgChannelOpenConfirm(height, typedMsg)
+	case *ibcChannelTypes.MsgChannelCloseInit:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgChannelCloseInit(height, typedMsg)
+	case *ibcChannelTypes.MsgChannelCloseConfirm:
+		d.Msg.Type, d.Msg.Addresses, err = handle.MsgChannelCloseConfirm(height, typedMsg)
+
+	// ibc packet
+	case *ibcChannelTypes.MsgRecvPacket:
+		d.Msg.Type, d.Msg.Addresses, d.Msg.IbcTransfer, err = handle.MsgRecvPacket(height, status, typedMsg)
+	case *ibcChannelTypes.MsgTimeout:
+		d.Msg.Type, d.Msg.Addresses, d.Msg.IbcTransfer, err = handle.MsgTimeout(height, status, typedMsg)
+	case *ibcChannelTypes.MsgTimeoutOnClose:
+		d.Msg.Type, d.Msg.Addresses, d.Msg.IbcTransfer, err = handle.MsgTimeoutOnClose(height, status, typedMsg)
+	case *ibcChannelTypes.MsgAcknowledgement:
+		d.Msg.Type, d.Msg.Addresses, d.Msg.IbcTransfer, err = handle.MsgAcknowledgement(height, status, typedMsg)
 
 	default:
 		log.Err(errors.New("unknown message type")).Msgf("got type %T", msg)
@@ -148,5 +200,35 @@ func Message(
 	}
 
 	d.Addresses = append(d.Addresses, d.Msg.Addresses...)
+	d.Addresses = append(d.Addresses, internalAddresses...)
 	return
 }
+
+// execMessages - decodes messages executed by authz MsgExec on behalf of granter. Returns decoded messages, their blobs size and addresses.
+func execMessages(
+	m *authz.MsgExec,
+	height types.Level,
+	time time.Time,
+	status storageTypes.Status,
+) ([]storage.Message, int64, []storage.AddressWithType, error) {
+	msgs, err := m.GetMessages()
+	if err != nil {
+		return nil, 0, nil, errors.Wrap(err, "unpack executed messages")
+	}
+
+	var (
+		internal  []storage.Message
+		blobsSize int64
+		addresses []storage.AddressWithType
+	)
+	for i := range msgs {
+		d, err := Message(msgs[i], height, time, i, status)
+		if err != nil {
+			return nil, 0, nil, errors.Wrap(err, "executed message")
+		}
+		internal = append(internal, d.Msg)
+		blobsSize += d.BlobsSize
+		addresses = append(addresses, d.Addresses...)
+	}
+	return internal, blobsSize, addresses, nil
+}
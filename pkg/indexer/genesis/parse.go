@@ -125,11 +125,8 @@ func (module *Module) parseAccounts(accounts []types.Accounts, height pkgTypes.L
 		address := storage.Address{
 			Height:     height,
 			LastHeight: height,
-			Balance: storage.Balance{
-				Total:    decimal.Zero,
-				Currency: data.denomMetadata[0].Base,
-			},
 		}
+		address.AddBalance(data.denomMetadata[0].Base, decimal.Zero)
 
 		var readableAddress string
 
@@ -179,17 +176,15 @@ func (module *Module) parseBalances(balances []types.Balances, height pkgTypes.L
 			Address:    balances[i].Address,
 			Height:     height,
 			LastHeight: height,
-			Balance: storage.Balance{
-				Total:    decimal.Zero,
-				Currency: balances[i].Coins[0].Denom,
-			},
 		}
-		if balance, err := decimal.NewFromString(balances[i].Coins[0].Amount); err == nil {
-			address.Balance.Total = address.Balance.Total.Add(balance)
+		for _, coin := range balances[i].Coins {
+			if balance, err := decimal.NewFromString(coin.Amount); err == nil {
+				address.AddBalance(coin.Denom, balance)
+			}
 		}
 
 		if addr, ok := data.addresses[address.String()]; ok {
-			addr.Balance.Total = addr.Balance.Total.Add(address.Balance.Total)
+			addr.MergeBalances(address)
 		} else {
 			data.addresses[address.String()] = &address
 		}
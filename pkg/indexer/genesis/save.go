@@ -7,10 +7,15 @@ import (
 	"context"
 	"time"
 
+	cosmosTypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	"github.com/dipdup-io/celestia-indexer/internal/storage/postgres"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/shopspring/decimal"
 )
 
+var powerReduction = decimal.NewFromBigInt(cosmosTypes.DefaultPowerReduction.BigInt(), 0)
+
 func (module *Module) save(ctx context.Context, data parsedData) error {
 	start := time.Now()
 	module.Log.Info().Uint64("height", uint64(data.block.Height)).Msg("saving block...")
@@ -76,7 +81,7 @@ func (module *Module) save(ctx context.Context, data parsedData) error {
 			if addr, ok := data.addresses[key]; !ok {
 				data.addresses[key] = &data.block.Txs[i].Signers[j]
 			} else {
-				addr.Balance.Total = addr.Balance.Total.Add(data.block.Txs[i].Signers[j].Balance.Total)
+				addr.MergeBalances(data.block.Txs[i].Signers[j])
 			}
 		}
 	}
@@ -93,9 +98,12 @@ func (module *Module) save(ctx context.Context, data parsedData) error {
 			return tx.HandleError(ctx, err)
 		}
 
-		balances := make([]storage.Balance, len(entities))
+		balances := make([]storage.Balance, 0, len(entities))
 		for i := range entities {
-			balances[i] = entities[i].Balance
+			for j := range entities[i].Balances {
+				entities[i].Balances[j].Id = entities[i].Id
+				balances = append(balances, entities[i].Balances[j])
+			}
 		}
 		if err := tx.SaveBalances(ctx, balances...); err != nil {
 			return tx.HandleError(ctx, err)
@@ -158,6 +166,64 @@ func (module *Module) save(ctx context.Context, data parsedData) error {
 		return tx.HandleError(ctx, err)
 	}
 
+	var (
+		validators    = make([]*storage.Validator, 0)
+		validatorMsgs []storage.ValidatorMessage
+	)
+	for i := range messages {
+		if messages[i].Validator != nil {
+			messages[i].Validator.MsgId = messages[i].Id
+			setGenesisPower(messages[i].Validator, messages[i].Delegations)
+			validators = append(validators, messages[i].Validator)
+		}
+		for _, address := range messages[i].Addresses {
+			if !address.Type.IsValidator() {
+				continue
+			}
+			validatorMsgs = append(validatorMsgs, storage.ValidatorMessage{
+				Validator: address.String(),
+				MsgId:     messages[i].Id,
+				Type:      address.Type,
+				Time:      messages[i].Time,
+				Height:    messages[i].Height,
+			})
+		}
+	}
+	if err := tx.SaveValidators(ctx, validators...); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.SaveValidatorMessages(ctx, validatorMsgs...); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	var (
+		delegationLogs []storage.DelegationLog
+		delegations    []storage.Delegation
+	)
+	for i := range messages {
+		for _, entry := range messages[i].Delegations {
+			address, ok := data.addresses[entry.Address.String()]
+			if !ok {
+				continue
+			}
+			entry.AddressId = address.Id
+			entry.Time = messages[i].Time
+			entry.MsgId = messages[i].Id
+			delegationLogs = append(delegationLogs, entry)
+			delegations = append(delegations, storage.Delegation{
+				AddressId: address.Id,
+				Validator: entry.Validator,
+				Amount:    entry.Amount,
+			})
+		}
+	}
+	if err := tx.SaveDelegationLogs(ctx, delegationLogs...); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+	if err := tx.SaveDelegations(ctx, delegations...); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
 	if err := tx.Add(ctx, &storage.State{
 		Name:            module.indexerName,
 		LastHeight:      data.block.Height,
@@ -185,3 +251,15 @@ func (module *Module) save(ctx context.Context, data parsedData) error {
 		Msg("block saved")
 	return nil
 }
+
+// setGenesisPower - validators created by genesis transactions are bonded with power of their self-delegation
+func setGenesisPower(validator *storage.Validator, delegations []storage.DelegationLog) {
+	tokens := decimal.Zero
+	for i := range delegations {
+		tokens = tokens.Add(delegations[i].Amount)
+	}
+	validator.Power = tokens.Div(powerReduction).IntPart()
+	if validator.Power > 0 {
+		validator.Status = storageTypes.ValidatorStatusBonded
+	}
+}
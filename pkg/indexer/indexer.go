@@ -5,6 +5,7 @@ package indexer
 
 import (
 	"context"
+	"net/url"
 	"sync"
 
 	"github.com/dipdup-net/indexer-sdk/pkg/modules/stopper"
@@ -13,11 +14,16 @@ import (
 
 	internalStorage "github.com/dipdup-io/celestia-indexer/internal/storage"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/genesis"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/metrics"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/parser"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/rollback"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/storage"
 	"github.com/dipdup-io/celestia-indexer/pkg/node"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/blockstore"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/failover"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/file"
 	"github.com/dipdup-io/celestia-indexer/pkg/node/rpc"
+	goLibConfig "github.com/dipdup-net/go-lib/config"
 	"github.com/pkg/errors"
 
 	"github.com/dipdup-io/celestia-indexer/internal/storage/postgres"
@@ -27,6 +33,14 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	defaultDatasource = "node_rpc"
+	// datasource kind of directory with chain data recorded by file.Recorder, url is `file:///path/to/dir`
+	fileDatasourceKind = "celestia_node_file"
+	// datasource kind of stopped node databases `blockstore.db` and `state.db`, url is `file:///path/to/node/data`
+	blockstoreDatasourceKind = "celestia_node_blockstore"
+)
+
 type Indexer struct {
 	cfg      config.Config
 	api      node.Api
@@ -36,6 +50,7 @@ type Indexer struct {
 	rollback *rollback.Module
 	genesis  *genesis.Module
 	stopper  modules.Module
+	metrics  *metrics.Metrics
 	wg       *sync.WaitGroup
 	log      zerolog.Logger
 }
@@ -46,25 +61,33 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 		return Indexer{}, errors.Wrap(err, "while creating pg context")
 	}
 
-	api, r, err := createReceiver(ctx, cfg, pg)
+	var m *metrics.Metrics
+	if cfg.Prometheus != nil {
+		m = metrics.New(cfg.Prometheus)
+	}
+
+	api, r, err := createReceiver(ctx, cfg, pg, m)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating receiver module")
 	}
 
-	rb, err := createRollback(r, pg, &api, cfg.Indexer)
+	rb, err := createRollback(r, pg, api, cfg.Indexer)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating rollback module")
 	}
+	rb.WithMetrics(m)
 
-	p, err := createParser(r)
+	p, err := createParser(r, cfg.Indexer)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating parser module")
 	}
+	p.WithMetrics(m)
 
 	s, err := createStorage(pg, cfg, p)
 	if err != nil {
 		return Indexer{}, errors.Wrap(err, "while creating storage module")
 	}
+	s.WithMetrics(m)
 
 	genesisModule, err := createGenesis(pg, cfg, r)
 	if err != nil {
@@ -78,13 +101,14 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 
 	return Indexer{
 		cfg:      cfg,
-		api:      &api,
+		api:      api,
 		receiver: r,
 		parser:   p,
 		storage:  s,
 		rollback: rb,
 		genesis:  genesisModule,
 		stopper:  stopperModule,
+		metrics:  m,
 		wg:       new(sync.WaitGroup),
 		log:      log.With().Str("module", "indexer").Logger(),
 	}, nil
@@ -93,6 +117,10 @@ func New(ctx context.Context, cfg config.Config, stopperModule modules.Module) (
 func (i *Indexer) Start(ctx context.Context) {
 	i.log.Info().Msg("starting...")
 
+	if i.metrics != nil {
+		i.metrics.Start()
+	}
+
 	i.genesis.Start(ctx)
 	i.storage.Start(ctx)
 	i.parser.Start(ctx)
@@ -118,21 +146,92 @@ func (i *Indexer) Close() error {
 	if err := i.rollback.Close(); err != nil {
 		log.Err(err).Msg("closing rollback")
 	}
+	if i.metrics != nil {
+		if err := i.metrics.Close(); err != nil {
+			log.Err(err).Msg("closing metrics")
+		}
+	}
 
 	return nil
 }
 
-func createReceiver(ctx context.Context, cfg config.Config, pg postgres.Storage) (rpc.API, *receiver.Module, error) {
+func createReceiver(ctx context.Context, cfg config.Config, pg postgres.Storage, m *metrics.Metrics) (node.Api, *receiver.Module, error) {
 	state, err := loadState(pg, ctx, cfg.Indexer.Name)
 	if err != nil {
-		return rpc.API{}, nil, errors.Wrap(err, "while loading state")
+		return nil, nil, errors.Wrap(err, "while loading state")
 	}
 
-	api := rpc.NewAPI(cfg.DataSources["node_rpc"])
-	receiverModule := receiver.NewModule(cfg.Indexer, &api, state)
+	api, err := createApi(cfg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while creating node api")
+	}
+	if m != nil {
+		api = metrics.NewApi(api, m)
+	}
+
+	receiverModule := receiver.NewModule(cfg.Indexer, api, state)
+	receiverModule.WithMetrics(m)
+	if cfg.Indexer.Subscribe {
+		receiverModule.WithSubscriber(rpc.NewWebsocket(cfg.DataSources[datasourceNames(cfg.Indexer)[0]]))
+	}
 	return api, &receiverModule, nil
 }
 
+func createApi(cfg config.Config) (node.Api, error) {
+	names := datasourceNames(cfg.Indexer)
+	apis := make(map[string]node.Api, len(names))
+	for _, name := range names {
+		ds, ok := cfg.DataSources[name]
+		if !ok {
+			return nil, errors.Errorf("unknown datasource: %s", name)
+		}
+		api, err := createDatasourceApi(ds)
+		if err != nil {
+			return nil, errors.Wrapf(err, "datasource %s", name)
+		}
+		apis[name] = api
+	}
+
+	var api node.Api
+	if len(apis) == 1 {
+		api = apis[names[0]]
+	} else {
+		api = failover.New(apis)
+	}
+
+	if cfg.Indexer.RecordDir != "" {
+		return file.NewRecorder(api, cfg.Indexer.RecordDir)
+	}
+	return api, nil
+}
+
+func createDatasourceApi(ds goLibConfig.DataSource) (node.Api, error) {
+	switch ds.Kind {
+	case fileDatasourceKind:
+		u, err := url.Parse(ds.URL)
+		if err != nil {
+			return nil, err
+		}
+		return file.NewAPI(u.Path)
+	case blockstoreDatasourceKind:
+		u, err := url.Parse(ds.URL)
+		if err != nil {
+			return nil, err
+		}
+		return blockstore.New(u.Path)
+	default:
+		api := rpc.NewAPI(ds)
+		return &api, nil
+	}
+}
+
+func datasourceNames(cfg config.Indexer) []string {
+	if len(cfg.Datasources) == 0 {
+		return []string{defaultDatasource}
+	}
+	return cfg.Datasources
+}
+
 func createRollback(receiverModule modules.Module, pg postgres.Storage, api node.Api, cfg config.Indexer) (*rollback.Module, error) {
 	rollbackModule := rollback.NewModule(pg.Transactable, pg.State, pg.Blocks, api, cfg)
 
@@ -149,8 +248,8 @@ func createRollback(receiverModule modules.Module, pg postgres.Storage, api node
 	return &rollbackModule, nil
 }
 
-func createParser(receiverModule modules.Module) (*parser.Module, error) {
-	parserModule := parser.NewModule()
+func createParser(receiverModule modules.Module, cfg config.Indexer) (*parser.Module, error) {
+	parserModule := parser.NewModule(cfg)
 
 	if err := parserModule.AttachTo(receiverModule, receiver.BlocksOutput, parser.InputName); err != nil {
 		return nil, errors.Wrap(err, "while attaching parser to receiver")
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/node"
+	nodeTypes "github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// Api - node.Api wrapper which counts failed requests by method and tracks node head
+type Api struct {
+	api     node.Api
+	metrics *Metrics
+}
+
+var _ node.Api = (*Api)(nil)
+
+func NewApi(api node.Api, metrics *Metrics) *Api {
+	return &Api{
+		api:     api,
+		metrics: metrics,
+	}
+}
+
+func (a *Api) track(method string, err error) {
+	if err != nil && !errors.Is(err, context.Canceled) {
+		a.metrics.NodeError(method)
+	}
+}
+
+func (a *Api) Status(ctx context.Context) (nodeTypes.Status, error) {
+	status, err := a.api.Status(ctx)
+	a.track("status", err)
+	if err == nil {
+		a.metrics.SetNodeHeight(status.SyncInfo.LatestBlockHeight)
+	}
+	return status, err
+}
+
+func (a *Api) Head(ctx context.Context) (types.ResultBlock, error) {
+	block, err := a.api.Head(ctx)
+	a.track("head", err)
+	return block, err
+}
+
+func (a *Api) Block(ctx context.Context, level types.Level) (types.ResultBlock, error) {
+	block, err := a.api.Block(ctx, level)
+	a.track("block", err)
+	return block, err
+}
+
+func (a *Api) BlockResults(ctx context.Context, level types.Level) (types.ResultBlockResults, error) {
+	results, err := a.api.BlockResults(ctx, level)
+	a.track("block_results", err)
+	return results, err
+}
+
+func (a *Api) Genesis(ctx context.Context) (nodeTypes.Genesis, error) {
+	genesis, err := a.api.Genesis(ctx)
+	a.track("genesis", err)
+	return genesis, err
+}
+
+func (a *Api) BlockData(ctx context.Context, level types.Level) (types.BlockData, error) {
+	block, err := a.api.BlockData(ctx, level)
+	a.track("block_data", err)
+	return block, err
+}
+
+func (a *Api) BlockDataRange(ctx context.Context, from, to types.Level) ([]types.BlockData, error) {
+	blocks, err := a.api.BlockDataRange(ctx, from, to)
+	a.track("block_data_range", err)
+	return blocks, err
+}
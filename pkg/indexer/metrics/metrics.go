@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package metrics
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/config"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
+)
+
+// metric names
+const (
+	IndexedHeight        = "celestia_indexer_indexed_height"
+	NodeHeight           = "celestia_indexer_node_height"
+	Lag                  = "celestia_indexer_lag"
+	Blocks               = "celestia_indexer_blocks_total"
+	StageDuration        = "celestia_indexer_stage_duration_seconds"
+	ReceiverTaskQueue    = "celestia_indexer_receiver_task_queue"
+	ReceiverBlocksBuffer = "celestia_indexer_receiver_blocks_buffer"
+	Rollbacks            = "celestia_indexer_rollbacks_total"
+	RollbackDepth        = "celestia_indexer_rollback_depth"
+	NodeErrors           = "celestia_indexer_node_errors_total"
+)
+
+// pipeline stages
+const (
+	StageReceived = "received"
+	StageParsed   = "parsed"
+	StageSaved    = "saved"
+)
+
+const (
+	labelStage  = "stage"
+	labelMethod = "method"
+)
+
+const readHeaderTimeout = 10 * time.Second
+
+// Metrics - indexer metrics exported to Prometheus. Metrics are registered in the registry owned by the collector,
+// so several collectors may live in one process. All methods are safe to call on nil receiver,
+// so modules don't need to check if metrics are enabled.
+type Metrics struct {
+	registry *prometheus.Registry
+	server   *http.Server
+	wg       *sync.WaitGroup
+
+	indexedHeight prometheus.Gauge
+	nodeHeight    prometheus.Gauge
+	lag           prometheus.Gauge
+	blocks        *prometheus.CounterVec
+	stageDuration *prometheus.HistogramVec
+	taskQueue     prometheus.Gauge
+	blocksBuffer  prometheus.Gauge
+	rollbacks     prometheus.Counter
+	rollbackDepth prometheus.Histogram
+	nodeErrors    *prometheus.CounterVec
+
+	indexed *atomic.Int64
+	head    *atomic.Int64
+}
+
+// New - creates indexer metrics. If `cfg` is set metrics are served by HTTP on `cfg.URL` at `/metrics` path.
+func New(cfg *config.Prometheus) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		wg:       new(sync.WaitGroup),
+
+		indexedHeight: prometheus.NewGauge(prometheus.GaugeOpts{Name: IndexedHeight, Help: "Height of the last saved block"}),
+		nodeHeight:    prometheus.NewGauge(prometheus.GaugeOpts{Name: NodeHeight, Help: "Head height reported by the node"}),
+		lag:           prometheus.NewGauge(prometheus.GaugeOpts{Name: Lag, Help: "Count of blocks between node head and the last saved block"}),
+		blocks:        prometheus.NewCounterVec(prometheus.CounterOpts{Name: Blocks, Help: "Count of blocks processed by pipeline stage"}, []string{labelStage}),
+		stageDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: StageDuration, Help: "Duration of processing block by pipeline stage"}, []string{labelStage}),
+		taskQueue:     prometheus.NewGauge(prometheus.GaugeOpts{Name: ReceiverTaskQueue, Help: "Count of levels scheduled for receiving"}),
+		blocksBuffer:  prometheus.NewGauge(prometheus.GaugeOpts{Name: ReceiverBlocksBuffer, Help: "Count of received blocks waiting for ordering"}),
+		rollbacks:     prometheus.NewCounter(prometheus.CounterOpts{Name: Rollbacks, Help: "Count of rollbacks"}),
+		rollbackDepth: prometheus.NewHistogram(prometheus.HistogramOpts{Name: RollbackDepth, Help: "Count of blocks removed by rollback"}),
+		nodeErrors:    prometheus.NewCounterVec(prometheus.CounterOpts{Name: NodeErrors, Help: "Count of failed node requests"}, []string{labelMethod}),
+
+		indexed: new(atomic.Int64),
+		head:    new(atomic.Int64),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewBuildInfoCollector(),
+		collectors.NewGoCollector(),
+		m.indexedHeight,
+		m.nodeHeight,
+		m.lag,
+		m.blocks,
+		m.stageDuration,
+		m.taskQueue,
+		m.blocksBuffer,
+		m.rollbacks,
+		m.rollbackDepth,
+		m.nodeErrors,
+	)
+
+	if cfg != nil && cfg.URL != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+		m.server = &http.Server{Addr: cfg.URL, Handler: mux, ReadHeaderTimeout: readHeaderTimeout}
+	}
+
+	return m
+}
+
+// Start - starts HTTP server of metrics if it's configured
+func (m *Metrics) Start() {
+	if m == nil || m.server == nil {
+		return
+	}
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		if err := m.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Err(err).Msg("metrics server")
+		}
+	}()
+}
+
+// Close - stops HTTP server of metrics
+func (m *Metrics) Close() error {
+	if m == nil || m.server == nil {
+		return nil
+	}
+	if err := m.server.Close(); err != nil {
+		return err
+	}
+	m.wg.Wait()
+	return nil
+}
+
+func (m *Metrics) SetIndexedHeight(level types.Level) {
+	if m == nil {
+		return
+	}
+	m.indexed.Store(int64(level))
+	m.indexedHeight.Set(float64(level))
+	m.updateLag()
+}
+
+func (m *Metrics) SetNodeHeight(level types.Level) {
+	if m == nil {
+		return
+	}
+	m.head.Store(int64(level))
+	m.nodeHeight.Set(float64(level))
+	m.updateLag()
+}
+
+func (m *Metrics) updateLag() {
+	lag := m.head.Load() - m.indexed.Load()
+	if lag < 0 {
+		lag = 0
+	}
+	m.lag.Set(float64(lag))
+}
+
+// Processed - counts block processed by stage and observes the stage duration
+func (m *Metrics) Processed(stage string, count int, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.blocks.WithLabelValues(stage).Add(float64(count))
+	m.stageDuration.WithLabelValues(stage).Observe(duration.Seconds())
+}
+
+func (m *Metrics) SetReceiverQueues(taskQueue, blocksBuffer int) {
+	if m == nil {
+		return
+	}
+	m.taskQueue.Set(float64(taskQueue))
+	m.blocksBuffer.Set(float64(blocksBuffer))
+}
+
+func (m *Metrics) Rollback(depth int) {
+	if m == nil {
+		return
+	}
+	m.rollbacks.Inc()
+	m.rollbackDepth.Observe(float64(depth))
+}
+
+func (m *Metrics) NodeError(method string) {
+	if m == nil {
+		return
+	}
+	m.nodeErrors.WithLabelValues(method).Inc()
+}
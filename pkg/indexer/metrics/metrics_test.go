@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetrics(t *testing.T) {
+	m := New(nil)
+
+	m.SetNodeHeight(100)
+	m.SetIndexedHeight(90)
+	require.EqualValues(t, 10, testutil.ToFloat64(m.lag))
+	require.EqualValues(t, 100, testutil.ToFloat64(m.nodeHeight))
+	require.EqualValues(t, 90, testutil.ToFloat64(m.indexedHeight))
+
+	m.SetIndexedHeight(101)
+	require.EqualValues(t, 0, testutil.ToFloat64(m.lag))
+
+	m.Processed(StageSaved, 3, time.Millisecond)
+	m.Processed(StageSaved, 2, time.Millisecond)
+	require.EqualValues(t, 5, testutil.ToFloat64(m.blocks.WithLabelValues(StageSaved)))
+
+	m.NodeError("block_data")
+	require.EqualValues(t, 1, testutil.ToFloat64(m.nodeErrors.WithLabelValues("block_data")))
+
+	m.Rollback(2)
+	require.EqualValues(t, 1, testutil.ToFloat64(m.rollbacks))
+}
+
+func TestMetrics_Nil(t *testing.T) {
+	var m *Metrics
+	require.NotPanics(t, func() {
+		m.SetNodeHeight(100)
+		m.SetIndexedHeight(90)
+		m.Processed(StageReceived, 1, time.Second)
+		m.SetReceiverQueues(1, 1)
+		m.Rollback(1)
+		m.NodeError("status")
+	})
+}
+
+func TestMetrics_NewTwice(t *testing.T) {
+	require.NotPanics(t, func() {
+		New(nil).SetIndexedHeight(1)
+		New(nil).SetIndexedHeight(2)
+	})
+}
@@ -4,7 +4,6 @@
 package parser
 
 import (
-	"github.com/dipdup-io/celestia-indexer/internal/consts"
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
 	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
@@ -31,15 +30,10 @@ func parseCoinSpent(data map[string]any, height pkgTypes.Level) (*storage.Addres
 		Hash:       hash,
 		Height:     height,
 		LastHeight: height,
-		Balance: storage.Balance{
-			Currency: consts.DefaultCurrency,
-			Total:    decimal.Zero,
-		},
 	}
 
-	if coinSpent.Amount != nil {
-		address.Balance.Currency = coinSpent.Amount.Denom
-		address.Balance.Total = decimal.NewFromBigInt(coinSpent.Amount.Amount.Neg().BigInt(), 0)
+	for _, coin := range coinSpent.Amount {
+		address.AddBalance(coin.Denom, decimal.NewFromBigInt(coin.Amount.Neg().BigInt(), 0))
 	}
 
 	return address, nil
@@ -64,15 +58,10 @@ func parseCoinReceived(data map[string]any, height pkgTypes.Level) (*storage.Add
 		Hash:       hash,
 		Height:     height,
 		LastHeight: height,
-		Balance: storage.Balance{
-			Currency: consts.DefaultCurrency,
-			Total:    decimal.Zero,
-		},
 	}
 
-	if coinReceived.Amount != nil {
-		address.Balance.Currency = coinReceived.Amount.Denom
-		address.Balance.Total = decimal.NewFromBigInt(coinReceived.Amount.Amount.BigInt(), 0) // TODO: unit test
+	for _, coin := range coinReceived.Amount {
+		address.AddBalance(coin.Denom, decimal.NewFromBigInt(coin.Amount.BigInt(), 0))
 	}
 
 	return address, nil
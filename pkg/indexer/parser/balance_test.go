@@ -38,9 +38,11 @@ func Test_parseCoinSpent(t *testing.T) {
 				LastHeight: pkgTypes.Level(58000),
 				Address:    testAddress,
 				Hash:       testHashAddress,
-				Balance: storage.Balance{
-					Currency: consts.DefaultCurrency,
-					Total:    decimal.RequireFromString("-123"),
+				Balances: []storage.Balance{
+					{
+						Currency: consts.DefaultCurrency,
+						Total:    decimal.RequireFromString("-123"),
+					},
 				},
 			},
 		}, {
@@ -55,9 +57,27 @@ func Test_parseCoinSpent(t *testing.T) {
 				LastHeight: pkgTypes.Level(58000),
 				Address:    testAddress,
 				Hash:       testHashAddress,
-				Balance: storage.Balance{
-					Currency: consts.DefaultCurrency,
-					Total:    decimal.Zero,
+			},
+		}, {
+			name: "test 3",
+			data: map[string]any{
+				"spender": testAddress,
+				"amount":  "123utia,10ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+			},
+			height: pkgTypes.Level(58000),
+			want: &storage.Address{
+				Height:     pkgTypes.Level(58000),
+				LastHeight: pkgTypes.Level(58000),
+				Address:    testAddress,
+				Hash:       testHashAddress,
+				Balances: []storage.Balance{
+					{
+						Currency: "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+						Total:    decimal.RequireFromString("-10"),
+					}, {
+						Currency: consts.DefaultCurrency,
+						Total:    decimal.RequireFromString("-123"),
+					},
 				},
 			},
 		},
@@ -18,10 +18,19 @@ type eventsResult struct {
 	Addresses []storage.Address
 }
 
-func (er *eventsResult) Fill(events []storage.Event) error {
-	er.Addresses = make([]storage.Address, 0)
+// Fill - collects supply changes and balances from events. Returned balance updates should be linked to transaction by caller if events are transaction's ones.
+func (er *eventsResult) Fill(events []storage.Event) ([]storage.BalanceUpdate, error) {
+	if er.Addresses == nil {
+		er.Addresses = make([]storage.Address, 0)
+	}
 
+	var updates []storage.BalanceUpdate
 	for i := range events {
+		var (
+			address *storage.Address
+			err     error
+		)
+
 		switch events[i].Type {
 		case types.EventTypeBurn:
 			amount := decode.Amount(events[i].Data)
@@ -31,23 +40,34 @@ func (er *eventsResult) Fill(events []storage.Event) error {
 			amount := decode.Amount(events[i].Data)
 			er.SupplyChange = er.SupplyChange.Add(amount)
 		case types.EventTypeCoinReceived:
-			address, err := parseCoinReceived(events[i].Data, events[i].Height)
+			address, err = parseCoinReceived(events[i].Data, events[i].Height)
 			if err != nil {
-				return errors.Wrap(err, "parse coin received")
-			}
-			if address != nil {
-				er.Addresses = append(er.Addresses, *address)
+				return nil, errors.Wrap(err, "parse coin received")
 			}
 		case types.EventTypeCoinSpent:
-			address, err := parseCoinSpent(events[i].Data, events[i].Height)
+			address, err = parseCoinSpent(events[i].Data, events[i].Height)
 			if err != nil {
-				return errors.Wrap(err, "parse coin spent")
-			}
-			if address != nil {
-				er.Addresses = append(er.Addresses, *address)
+				return nil, errors.Wrap(err, "parse coin spent")
 			}
 		}
+
+		if address == nil {
+			continue
+		}
+		er.Addresses = append(er.Addresses, *address)
+
+		for _, balance := range address.Balances {
+			updates = append(updates, storage.BalanceUpdate{
+				Height:        events[i].Height,
+				Time:          events[i].Time,
+				Currency:      balance.Currency,
+				Delta:         balance.Total,
+				EventPosition: events[i].Position,
+				EventType:     events[i].Type,
+				Address:       address,
+			})
+		}
 	}
 
-	return nil
+	return updates, nil
 }
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_eventsResult_Fill(t *testing.T) {
+	ts := time.Date(2023, 7, 4, 3, 10, 57, 0, time.UTC)
+	events := []storage.Event{
+		{
+			Height:   100,
+			Time:     ts,
+			Position: 0,
+			Type:     types.EventTypeCoinSpent,
+			Data: map[string]any{
+				"spender": testAddress,
+				"amount":  "10utia,5ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+			},
+		}, {
+			Height:   100,
+			Time:     ts,
+			Position: 1,
+			Type:     types.EventTypeMessage,
+			Data:     map[string]any{},
+		}, {
+			Height:   100,
+			Time:     ts,
+			Position: 2,
+			Type:     types.EventTypeCoinReceived,
+			Data: map[string]any{
+				"receiver": testAddress,
+				"amount":   "3utia",
+			},
+		},
+	}
+
+	var result eventsResult
+	updates, err := result.Fill(events)
+	require.NoError(t, err)
+	require.Len(t, result.Addresses, 2)
+	require.Len(t, updates, 3)
+
+	require.Equal(t, "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9", updates[0].Currency)
+	require.Equal(t, "-5", updates[0].Delta.String())
+	require.Equal(t, "utia", updates[1].Currency)
+	require.Equal(t, "-10", updates[1].Delta.String())
+	require.EqualValues(t, 0, updates[1].EventPosition)
+	require.Equal(t, types.EventTypeCoinSpent, updates[1].EventType)
+
+	require.Equal(t, "3", updates[2].Delta.String())
+	require.EqualValues(t, 2, updates[2].EventPosition)
+	require.Equal(t, types.EventTypeCoinReceived, updates[2].EventType)
+	require.EqualValues(t, 100, updates[2].Height)
+	require.Equal(t, ts, updates[2].Time)
+	require.NotNil(t, updates[2].Address)
+	require.Equal(t, testAddress, updates[2].Address.Address)
+}
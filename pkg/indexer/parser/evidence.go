@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// parseEvidence - decodes block evidence. Light client attack evidence produces an entry per byzantine validator. Unknown evidence types are skipped.
+func parseEvidence(b types.BlockData) ([]storage.Evidence, error) {
+	var evidence []storage.Evidence
+
+	for i, ev := range b.Block.Evidence.Evidence {
+		switch ev.Type {
+		case types.EvidenceTypeDuplicateVote:
+			if ev.Value.VoteA == nil {
+				return nil, errors.Errorf("empty vote in duplicate vote evidence at position %d", i)
+			}
+			evidence = append(evidence, storage.Evidence{
+				Height:           b.Height,
+				Time:             b.Block.Time,
+				Type:             storageTypes.EvidenceTypeDuplicateVote,
+				ConsAddress:      ev.Value.VoteA.ValidatorAddress,
+				InfractionHeight: types.Level(ev.Value.VoteA.Height),
+				InfractionTime:   ev.Value.Timestamp,
+				ValidatorPower:   ev.Value.ValidatorPower,
+				TotalVotingPower: ev.Value.TotalVotingPower,
+			})
+		case types.EvidenceTypeLightClientAttack:
+			for _, validator := range ev.Value.ByzantineValidators {
+				evidence = append(evidence, storage.Evidence{
+					Height:           b.Height,
+					Time:             b.Block.Time,
+					Type:             storageTypes.EvidenceTypeLightClientAttack,
+					ConsAddress:      validator.Address,
+					InfractionHeight: types.Level(ev.Value.CommonHeight),
+					InfractionTime:   ev.Value.Timestamp,
+					ValidatorPower:   validator.VotingPower,
+					TotalVotingPower: ev.Value.TotalVotingPower,
+				})
+			}
+		}
+	}
+
+	return evidence, nil
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"testing"
+	"time"
+
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseEvidence(t *testing.T) {
+	now := time.Now().UTC()
+	infraction := now.Add(-time.Minute)
+
+	b := types.BlockData{
+		ResultBlock: types.ResultBlock{
+			Block: &types.Block{
+				Header: types.Header{
+					Time: now,
+				},
+				Evidence: types.EvidenceData{
+					Evidence: []types.Evidence{
+						{
+							Type: types.EvidenceTypeDuplicateVote,
+							Value: types.EvidenceValue{
+								VoteA: &types.Vote{
+									Height:           99,
+									ValidatorAddress: testConsAddressHash,
+								},
+								VoteB: &types.Vote{
+									Height:           99,
+									ValidatorAddress: testConsAddressHash,
+								},
+								ValidatorPower:   10,
+								TotalVotingPower: 100,
+								Timestamp:        infraction,
+							},
+						}, {
+							Type: types.EvidenceTypeLightClientAttack,
+							Value: types.EvidenceValue{
+								CommonHeight: 90,
+								ByzantineValidators: []types.EvidenceValidator{
+									{Address: types.Hex{0x01}, VotingPower: 20},
+									{Address: types.Hex{0x02}, VotingPower: 30},
+								},
+								TotalVotingPower: 100,
+								Timestamp:        infraction,
+							},
+						}, {
+							Type: "unknown",
+						},
+					},
+				},
+			},
+		},
+		ResultBlockResults: types.ResultBlockResults{
+			Height: 100,
+		},
+	}
+
+	evidence, err := parseEvidence(b)
+	require.NoError(t, err)
+	require.Len(t, evidence, 3)
+
+	require.EqualValues(t, 100, evidence[0].Height)
+	require.Equal(t, now, evidence[0].Time)
+	require.Equal(t, storageTypes.EvidenceTypeDuplicateVote, evidence[0].Type)
+	require.Equal(t, testConsAddressHash, evidence[0].ConsAddress)
+	require.EqualValues(t, 99, evidence[0].InfractionHeight)
+	require.Equal(t, infraction, evidence[0].InfractionTime)
+	require.EqualValues(t, 10, evidence[0].ValidatorPower)
+	require.EqualValues(t, 100, evidence[0].TotalVotingPower)
+
+	require.Equal(t, storageTypes.EvidenceTypeLightClientAttack, evidence[1].Type)
+	require.Equal(t, types.Hex{0x01}, evidence[1].ConsAddress)
+	require.EqualValues(t, 90, evidence[1].InfractionHeight)
+	require.EqualValues(t, 20, evidence[1].ValidatorPower)
+
+	require.Equal(t, storageTypes.EvidenceTypeLightClientAttack, evidence[2].Type)
+	require.Equal(t, types.Hex{0x02}, evidence[2].ConsAddress)
+	require.EqualValues(t, 30, evidence[2].ValidatorPower)
+}
+
+func Test_parseEvidenceEmptyVote(t *testing.T) {
+	b := types.BlockData{
+		ResultBlock: types.ResultBlock{
+			Block: &types.Block{
+				Evidence: types.EvidenceData{
+					Evidence: []types.Evidence{
+						{Type: types.EvidenceTypeDuplicateVote},
+					},
+				},
+			},
+		},
+	}
+
+	_, err := parseEvidence(b)
+	require.Error(t, err)
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"strconv"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	proposalIdKey        = "proposal_id"
+	votingPeriodStartKey = "voting_period_start"
+	proposalResultKey    = "proposal_result"
+)
+
+// setProposalIds - sets ids of submitted proposals and their initial deposits from `submit_proposal` events.
+// Gov module emits one event with proposal id per message, so the n-th event corresponds to the n-th submitted proposal.
+func setProposalIds(tx *storage.Tx) error {
+	var (
+		msgs = flattenMessages(tx.Messages)
+		idx  int
+	)
+	for i := range tx.Events {
+		if tx.Events[i].Type != storageTypes.EventTypeSubmitProposal {
+			continue
+		}
+		value := decode.StringFromMap(tx.Events[i].Data, proposalIdKey)
+		if value == "" {
+			continue
+		}
+		msg := nextMessage(msgs, &idx, func(msg storage.Message) bool { return msg.Proposal != nil })
+		if msg == nil {
+			continue
+		}
+		id, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "proposal id")
+		}
+		msg.Proposal.Id = id
+		if msg.Deposit != nil {
+			msg.Deposit.ProposalId = id
+		}
+	}
+	return nil
+}
+
+// parseProposalUpdates - collects proposal status changes: start of voting period from transaction events
+// and finishing or removing of proposals from end-block events.
+func parseProposalUpdates(b types.BlockData, txs []storage.Tx, endEvents []storage.Event) ([]storage.Proposal, error) {
+	var updates []storage.Proposal
+
+	for i := range txs {
+		for j := range txs[i].Events {
+			switch txs[i].Events[j].Type {
+			case storageTypes.EventTypeSubmitProposal, storageTypes.EventTypeProposalDeposit:
+			default:
+				continue
+			}
+			value := decode.StringFromMap(txs[i].Events[j].Data, votingPeriodStartKey)
+			if value == "" {
+				continue
+			}
+			id, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, errors.Wrap(err, "voting period start")
+			}
+			updates = append(updates, storage.Proposal{
+				Id:                id,
+				Status:            storageTypes.ProposalStatusVotingPeriod,
+				VotingStartHeight: b.Height,
+				VotingStartTime:   b.Block.Time,
+			})
+		}
+	}
+
+	for i := range endEvents {
+		var status storageTypes.ProposalStatus
+		switch endEvents[i].Type {
+		case storageTypes.EventTypeActiveProposal:
+			switch result := decode.StringFromMap(endEvents[i].Data, proposalResultKey); result {
+			case "proposal_passed":
+				status = storageTypes.ProposalStatusPassed
+			case "proposal_rejected":
+				status = storageTypes.ProposalStatusRejected
+			case "proposal_failed":
+				status = storageTypes.ProposalStatusFailed
+			default:
+				log.Warn().
+					Uint64("height", uint64(b.Height)).
+					Str("proposal_id", decode.StringFromMap(endEvents[i].Data, proposalIdKey)).
+					Str("result", result).
+					Msg("unknown proposal result, proposal update is skipped")
+				continue
+			}
+		case storageTypes.EventTypeInactiveProposal:
+			status = storageTypes.ProposalStatusRemoved
+		default:
+			continue
+		}
+
+		id, err := strconv.ParseUint(decode.StringFromMap(endEvents[i].Data, proposalIdKey), 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "proposal id")
+		}
+		updates = append(updates, storage.Proposal{
+			Id:        id,
+			Status:    status,
+			EndHeight: b.Height,
+			EndTime:   b.Block.Time,
+		})
+	}
+
+	return updates, nil
+}
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_setProposalIds(t *testing.T) {
+	tx := storage.Tx{
+		Messages: []storage.Message{
+			{
+				Type:     storageTypes.MsgSubmitProposal,
+				Proposal: &storage.Proposal{},
+				Deposit:  &storage.Deposit{},
+			}, {
+				Type: storageTypes.MsgVote,
+			}, {
+				Type:     storageTypes.MsgSubmitProposal,
+				Proposal: &storage.Proposal{},
+			},
+		},
+		Events: []storage.Event{
+			{
+				Type: storageTypes.EventTypeSubmitProposal,
+				Data: map[string]any{
+					"proposal_id":       "10",
+					"proposal_messages": ",/cosmos.gov.v1.MsgExecLegacyContent",
+				},
+			}, {
+				Type: storageTypes.EventTypeSubmitProposal,
+				Data: map[string]any{
+					"voting_period_start": "10",
+				},
+			}, {
+				Type: storageTypes.EventTypeSubmitProposal,
+				Data: map[string]any{
+					"proposal_id": "11",
+				},
+			},
+		},
+	}
+
+	err := setProposalIds(&tx)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, tx.Messages[0].Proposal.Id)
+	require.EqualValues(t, 10, tx.Messages[0].Deposit.ProposalId)
+	require.EqualValues(t, 11, tx.Messages[2].Proposal.Id)
+}
+
+func Test_setProposalIds_InvalidId(t *testing.T) {
+	tx := storage.Tx{
+		Messages: []storage.Message{
+			{
+				Type:     storageTypes.MsgSubmitProposal,
+				Proposal: &storage.Proposal{},
+			},
+		},
+		Events: []storage.Event{
+			{
+				Type: storageTypes.EventTypeSubmitProposal,
+				Data: map[string]any{
+					"proposal_id": "invalid",
+				},
+			},
+		},
+	}
+
+	err := setProposalIds(&tx)
+	require.Error(t, err)
+}
+
+func Test_parseProposalUpdates(t *testing.T) {
+	now := time.Now().UTC()
+	b := types.BlockData{
+		ResultBlock: types.ResultBlock{
+			Block: &types.Block{
+				Header: types.Header{
+					Time: now,
+				},
+			},
+		},
+		ResultBlockResults: types.ResultBlockResults{
+			Height: 100,
+		},
+	}
+	txs := []storage.Tx{
+		{
+			Events: []storage.Event{
+				{
+					Type: storageTypes.EventTypeProposalDeposit,
+					Data: map[string]any{
+						"amount":      "1000utia",
+						"proposal_id": "3",
+					},
+				}, {
+					Type: storageTypes.EventTypeProposalDeposit,
+					Data: map[string]any{
+						"voting_period_start": "3",
+					},
+				},
+			},
+		},
+	}
+	endEvents := []storage.Event{
+		{
+			Type: storageTypes.EventTypeActiveProposal,
+			Data: map[string]any{
+				"proposal_id":     "1",
+				"proposal_result": "proposal_passed",
+			},
+		}, {
+			Type: storageTypes.EventTypeInactiveProposal,
+			Data: map[string]any{
+				"proposal_id":     "2",
+				"proposal_result": "proposal_dropped",
+			},
+		}, {
+			Type: storageTypes.EventTypeTransfer,
+			Data: map[string]any{},
+		},
+	}
+
+	updates, err := parseProposalUpdates(b, txs, endEvents)
+	require.NoError(t, err)
+	require.Equal(t, []storage.Proposal{
+		{
+			Id:                3,
+			Status:            storageTypes.ProposalStatusVotingPeriod,
+			VotingStartHeight: 100,
+			VotingStartTime:   now,
+		}, {
+			Id:        1,
+			Status:    storageTypes.ProposalStatusPassed,
+			EndHeight: 100,
+			EndTime:   now,
+		}, {
+			Id:        2,
+			Status:    storageTypes.ProposalStatusRemoved,
+			EndHeight: 100,
+			EndTime:   now,
+		},
+	}, updates)
+}
+
+func Test_parseProposalUpdates_UnknownResult(t *testing.T) {
+	b := types.BlockData{
+		ResultBlock: types.ResultBlock{
+			Block: &types.Block{},
+		},
+	}
+	endEvents := []storage.Event{
+		{
+			Type: storageTypes.EventTypeActiveProposal,
+			Data: map[string]any{
+				"proposal_id":     "1",
+				"proposal_result": "unknown",
+			},
+		},
+	}
+
+	updates, err := parseProposalUpdates(b, nil, endEvents)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	granterKey    = "granter"
+	granteeKey    = "grantee"
+	msgTypeUrlKey = "msg_type_url"
+)
+
+// setGrants - collects changes of authz grants and fee allowances in order of transaction events.
+// Given grants and allowances are taken from messages: the n-th `cosmos.authz.v1beta1.EventGrant` or `set_feegrant` event corresponds to the n-th message with grant or allowance.
+// Revocations are taken from `cosmos.authz.v1beta1.EventRevoke` and `revoke_feegrant` events which are emitted on revoke messages and on exhausting of grants.
+// Every `use_feegrant` event spends transaction fee from the allowance. `update_feegrant` event is skipped because it's always preceded by `use_feegrant` event.
+func setGrants(tx *storage.Tx) error {
+	var (
+		msgs                   = flattenMessages(tx.Messages)
+		grantIdx, allowanceIdx int
+	)
+	for i := range tx.Events {
+		event := tx.Events[i]
+
+		switch event.Type {
+		case storageTypes.EventTypeCosmosauthzv1beta1EventGrant:
+			msg := nextMessage(msgs, &grantIdx, func(msg storage.Message) bool { return msg.Grant != nil })
+			if msg == nil {
+				continue
+			}
+			tx.Grants = append(tx.Grants, msg.Grant)
+
+		case storageTypes.EventTypeCosmosauthzv1beta1EventRevoke:
+			granter, grantee, err := grantAddresses(event, typedEventValue)
+			if err != nil {
+				return errors.Wrap(err, "authz revoke")
+			}
+			tx.Grants = append(tx.Grants, &storage.Grant{
+				EndHeight: event.Height,
+				MsgType:   typedEventValue(event.Data, msgTypeUrlKey),
+				Granter:   granter,
+				Grantee:   grantee,
+			})
+
+		case storageTypes.EventTypeSetFeegrant:
+			msg := nextMessage(msgs, &allowanceIdx, func(msg storage.Message) bool { return msg.FeeAllowance != nil })
+			if msg == nil {
+				continue
+			}
+			tx.FeeAllowances = append(tx.FeeAllowances, msg.FeeAllowance)
+
+		case storageTypes.EventTypeRevokeFeegrant:
+			granter, grantee, err := grantAddresses(event, decode.StringFromMap)
+			if err != nil {
+				return errors.Wrap(err, "revoke feegrant")
+			}
+			tx.FeeAllowances = append(tx.FeeAllowances, &storage.FeeAllowance{
+				EndHeight: event.Height,
+				Granter:   granter,
+				Grantee:   grantee,
+			})
+
+		case storageTypes.EventTypeUseFeegrant:
+			granter, grantee, err := grantAddresses(event, decode.StringFromMap)
+			if err != nil {
+				return errors.Wrap(err, "use feegrant")
+			}
+			tx.FeeAllowances = append(tx.FeeAllowances, &storage.FeeAllowance{
+				Height:  event.Height,
+				Time:    event.Time,
+				Spent:   tx.Fee,
+				Granter: granter,
+				Grantee: grantee,
+			})
+		}
+	}
+	return nil
+}
+
+// typedEventValue - returns value of typed event attribute. Typed events contain JSON encoded values, so strings are quoted.
+func typedEventValue(data map[string]any, key string) string {
+	return strings.Trim(decode.StringFromMap(data, key), `"`)
+}
+
+func grantAddresses(event storage.Event, value func(map[string]any, string) string) (*storage.Address, *storage.Address, error) {
+	granter, err := grantAddress(value(event.Data, granterKey), event.Height)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, granterKey)
+	}
+	grantee, err := grantAddress(value(event.Data, granteeKey), event.Height)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, granteeKey)
+	}
+	return granter, grantee, nil
+}
+
+func grantAddress(address string, height types.Level) (*storage.Address, error) {
+	_, hash, err := types.Address(address).Decode()
+	if err != nil {
+		return nil, err
+	}
+	return &storage.Address{
+		Hash:       hash,
+		Height:     height,
+		LastHeight: height,
+		Address:    address,
+	}, nil
+}
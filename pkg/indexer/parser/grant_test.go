@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGranter = "celestia18r6ujzzkg6ku9sr39nxy4847q4qea5kg4a8pxv"
+	testGrantee = "celestia1vnflc6322f8z7cpl28r7un5dxhmjxghc20aydq"
+)
+
+func Test_setGrants(t *testing.T) {
+	now := time.Now().UTC()
+	grant := &storage.Grant{MsgType: "/cosmos.bank.v1beta1.MsgSend"}
+	allowance := &storage.FeeAllowance{Type: storageTypes.FeeAllowanceTypeBasic}
+
+	tx := storage.Tx{
+		Fee: decimal.NewFromInt(300),
+		Messages: []storage.Message{
+			{
+				Type: storageTypes.MsgRevokeAllowance,
+			}, {
+				Type:         storageTypes.MsgGrantAllowance,
+				FeeAllowance: allowance,
+			}, {
+				Type: storageTypes.MsgExec,
+				InternalMsgs: []storage.Message{
+					{
+						Type:  storageTypes.MsgGrant,
+						Grant: grant,
+					},
+				},
+			}, {
+				Type: storageTypes.MsgRevoke,
+			},
+		},
+		Events: []storage.Event{
+			{
+				Height: 100,
+				Time:   now,
+				Type:   storageTypes.EventTypeUseFeegrant,
+				Data: map[string]any{
+					"granter": testGranter,
+					"grantee": testGrantee,
+				},
+			}, {
+				Height: 100,
+				Time:   now,
+				Type:   storageTypes.EventTypeUpdateFeegrant,
+				Data: map[string]any{
+					"granter": testGranter,
+					"grantee": testGrantee,
+				},
+			}, {
+				Height: 100,
+				Time:   now,
+				Type:   storageTypes.EventTypeRevokeFeegrant,
+				Data: map[string]any{
+					"granter": testGranter,
+					"grantee": testGrantee,
+				},
+			}, {
+				Height: 100,
+				Time:   now,
+				Type:   storageTypes.EventTypeSetFeegrant,
+				Data: map[string]any{
+					"granter": testGranter,
+					"grantee": testGrantee,
+				},
+			}, {
+				Height: 100,
+				Time:   now,
+				Type:   storageTypes.EventTypeCosmosauthzv1beta1EventGrant,
+				Data: map[string]any{
+					"granter":      `"` + testGranter + `"`,
+					"grantee":      `"` + testGrantee + `"`,
+					"msg_type_url": `"/cosmos.bank.v1beta1.MsgSend"`,
+				},
+			}, {
+				Height: 100,
+				Time:   now,
+				Type:   storageTypes.EventTypeCosmosauthzv1beta1EventRevoke,
+				Data: map[string]any{
+					"granter":      `"` + testGranter + `"`,
+					"grantee":      `"` + testGrantee + `"`,
+					"msg_type_url": `"/cosmos.bank.v1beta1.MsgSend"`,
+				},
+			},
+		},
+	}
+
+	err := setGrants(&tx)
+	require.NoError(t, err)
+
+	require.Len(t, tx.FeeAllowances, 3)
+	require.True(t, tx.FeeAllowances[0].IsUsage())
+	require.EqualValues(t, 100, tx.FeeAllowances[0].Height)
+	require.Equal(t, now, tx.FeeAllowances[0].Time)
+	require.Equal(t, "300", tx.FeeAllowances[0].Spent.String())
+	require.Equal(t, testGranter, tx.FeeAllowances[0].Granter.Address)
+	require.Equal(t, testGrantee, tx.FeeAllowances[0].Grantee.Address)
+	require.True(t, tx.FeeAllowances[1].IsRevocation())
+	require.EqualValues(t, 100, tx.FeeAllowances[1].EndHeight)
+	require.Same(t, allowance, tx.FeeAllowances[2])
+
+	require.Len(t, tx.Grants, 2)
+	require.Same(t, grant, tx.Grants[0])
+	require.True(t, tx.Grants[1].IsRevocation())
+	require.EqualValues(t, 100, tx.Grants[1].EndHeight)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", tx.Grants[1].MsgType)
+	require.Equal(t, testGranter, tx.Grants[1].Granter.Address)
+	require.Equal(t, testGrantee, tx.Grants[1].Grantee.Address)
+	require.NotEmpty(t, tx.Grants[1].Grantee.Hash)
+}
+
+func Test_setGrants_InvalidAddress(t *testing.T) {
+	tx := storage.Tx{
+		Events: []storage.Event{
+			{
+				Type: storageTypes.EventTypeRevokeFeegrant,
+				Data: map[string]any{
+					"granter": "invalid",
+					"grantee": testGrantee,
+				},
+			},
+		},
+	}
+
+	err := setGrants(&tx)
+	require.Error(t, err)
+}
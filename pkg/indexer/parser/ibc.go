@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
+	"github.com/pkg/errors"
+)
+
+const (
+	packetSequenceKey   = "packet_sequence"
+	packetSrcPortKey    = "packet_src_port"
+	packetSrcChannelKey = "packet_src_channel"
+	packetDstPortKey    = "packet_dst_port"
+	packetDstChannelKey = "packet_dst_channel"
+	packetAckKey        = "packet_ack"
+)
+
+type packetKey struct {
+	port     string
+	channel  string
+	sequence uint64
+}
+
+// setIbcTransfers - links IBC transfers of messages with packet events.
+// Outgoing transfer gets packet sequence and destination from `send_packet` event: the n-th event corresponds to the n-th `MsgTransfer`.
+// Redundant relaying of packet is no-op which doesn't emit `recv_packet`, `acknowledge_packet` or `timeout_packet` event,
+// so transfers of relayed messages without the event are removed. Incoming transfer fails if written acknowledgement contains error.
+func setIbcTransfers(tx *storage.Tx) error {
+	var (
+		msgs     = flattenMessages(tx.Messages)
+		idx      int
+		sent     = make(map[*storage.Message]struct{})
+		received = make(map[packetKey]bool)
+		resolved = make(map[packetKey]struct{})
+	)
+	for i := range tx.Events {
+		event := tx.Events[i]
+
+		switch event.Type {
+		case storageTypes.EventTypeSendPacket:
+			msg := nextMessage(msgs, &idx, func(msg storage.Message) bool {
+				return msg.IbcTransfer != nil && msg.Type == storageTypes.IBCTransfer
+			})
+			if msg == nil {
+				continue
+			}
+			sequence, err := packetSequence(event)
+			if err != nil {
+				return errors.Wrap(err, "send packet")
+			}
+			msg.IbcTransfer.Sequence = sequence
+			msg.IbcTransfer.DestinationPort = decode.StringFromMap(event.Data, packetDstPortKey)
+			msg.IbcTransfer.DestinationChannel = decode.StringFromMap(event.Data, packetDstChannelKey)
+			sent[msg] = struct{}{}
+
+		case storageTypes.EventTypeRecvPacket:
+			key, err := destinationPacketKey(event)
+			if err != nil {
+				return errors.Wrap(err, "recv packet")
+			}
+			received[key] = true
+
+		case storageTypes.EventTypeWriteAcknowledgement:
+			key, err := destinationPacketKey(event)
+			if err != nil {
+				return errors.Wrap(err, "write acknowledgement")
+			}
+			if _, ok := received[key]; ok {
+				received[key] = !isErrorAcknowledgement(decode.StringFromMap(event.Data, packetAckKey))
+			}
+
+		case storageTypes.EventTypeAcknowledgePacket, storageTypes.EventTypeTimeoutPacket, storageTypes.EventTypeTimeoutOnClosePacket:
+			key, err := sourcePacketKey(event)
+			if err != nil {
+				return errors.Wrap(err, string(event.Type))
+			}
+			resolved[key] = struct{}{}
+		}
+	}
+
+	for _, msg := range msgs {
+		transfer := msg.IbcTransfer
+		if transfer == nil {
+			continue
+		}
+
+		switch {
+		case transfer.Incoming:
+			success, ok := received[packetKey{transfer.DestinationPort, transfer.DestinationChannel, transfer.Sequence}]
+			switch {
+			case !ok:
+				msg.IbcTransfer = nil
+			case !success:
+				transfer.Status = storageTypes.IbcTransferStatusFailed
+			}
+		case transfer.IsResolution():
+			if _, ok := resolved[packetKey{transfer.SourcePort, transfer.SourceChannel, transfer.Sequence}]; !ok {
+				msg.IbcTransfer = nil
+			}
+		default:
+			if _, ok := sent[msg]; !ok {
+				msg.IbcTransfer = nil
+			}
+		}
+	}
+	return nil
+}
+
+func packetSequence(event storage.Event) (uint64, error) {
+	sequence, err := strconv.ParseUint(decode.StringFromMap(event.Data, packetSequenceKey), 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, packetSequenceKey)
+	}
+	return sequence, nil
+}
+
+func sourcePacketKey(event storage.Event) (packetKey, error) {
+	sequence, err := packetSequence(event)
+	return packetKey{
+		port:     decode.StringFromMap(event.Data, packetSrcPortKey),
+		channel:  decode.StringFromMap(event.Data, packetSrcChannelKey),
+		sequence: sequence,
+	}, err
+}
+
+func destinationPacketKey(event storage.Event) (packetKey, error) {
+	sequence, err := packetSequence(event)
+	return packetKey{
+		port:     decode.StringFromMap(event.Data, packetDstPortKey),
+		channel:  decode.StringFromMap(event.Data, packetDstChannelKey),
+		sequence: sequence,
+	}, err
+}
+
+// isErrorAcknowledgement - returns true if JSON encoded acknowledgement contains error instead of result
+func isErrorAcknowledgement(ack string) bool {
+	return strings.HasPrefix(ack, `{"error"`)
+}
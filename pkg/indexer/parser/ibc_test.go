@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_setIbcTransfers(t *testing.T) {
+	outgoing := &storage.IbcTransfer{
+		Status:        storageTypes.IbcTransferStatusPending,
+		SourcePort:    "transfer",
+		SourceChannel: "channel-2",
+	}
+	incoming := &storage.IbcTransfer{
+		Incoming:           true,
+		Status:             storageTypes.IbcTransferStatusSuccess,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-6994",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-2",
+		Sequence:           10,
+	}
+	failedIncoming := &storage.IbcTransfer{
+		Incoming:           true,
+		Status:             storageTypes.IbcTransferStatusSuccess,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-6994",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-2",
+		Sequence:           11,
+	}
+	redundant := &storage.IbcTransfer{
+		Incoming:           true,
+		Status:             storageTypes.IbcTransferStatusSuccess,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-6994",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-2",
+		Sequence:           9,
+	}
+	ack := &storage.IbcTransfer{
+		Status:        storageTypes.IbcTransferStatusSuccess,
+		SourcePort:    "transfer",
+		SourceChannel: "channel-2",
+		Sequence:      3,
+	}
+
+	tx := storage.Tx{
+		Messages: []storage.Message{
+			{Type: storageTypes.MsgUpdateClient},
+			{Type: storageTypes.MsgRecvPacket, IbcTransfer: redundant},
+			{Type: storageTypes.MsgRecvPacket, IbcTransfer: incoming},
+			{Type: storageTypes.MsgRecvPacket, IbcTransfer: failedIncoming},
+			{Type: storageTypes.MsgAcknowledgement, IbcTransfer: ack},
+			{
+				Type: storageTypes.MsgExec,
+				InternalMsgs: []storage.Message{
+					{Type: storageTypes.IBCTransfer, IbcTransfer: outgoing},
+				},
+			},
+		},
+		Events: []storage.Event{
+			{
+				Type: storageTypes.EventTypeRecvPacket,
+				Data: map[string]any{
+					"packet_sequence":    "10",
+					"packet_src_port":    "transfer",
+					"packet_src_channel": "channel-6994",
+					"packet_dst_port":    "transfer",
+					"packet_dst_channel": "channel-2",
+				},
+			}, {
+				Type: storageTypes.EventTypeWriteAcknowledgement,
+				Data: map[string]any{
+					"packet_sequence":    "10",
+					"packet_dst_port":    "transfer",
+					"packet_dst_channel": "channel-2",
+					"packet_ack":         `{"result":"AQ=="}`,
+				},
+			}, {
+				Type: storageTypes.EventTypeRecvPacket,
+				Data: map[string]any{
+					"packet_sequence":    "11",
+					"packet_dst_port":    "transfer",
+					"packet_dst_channel": "channel-2",
+				},
+			}, {
+				Type: storageTypes.EventTypeWriteAcknowledgement,
+				Data: map[string]any{
+					"packet_sequence":    "11",
+					"packet_dst_port":    "transfer",
+					"packet_dst_channel": "channel-2",
+					"packet_ack":         `{"error":"ABCI code: 1: error handling packet: see events for details"}`,
+				},
+			}, {
+				Type: storageTypes.EventTypeAcknowledgePacket,
+				Data: map[string]any{
+					"packet_sequence":    "3",
+					"packet_src_port":    "transfer",
+					"packet_src_channel": "channel-2",
+				},
+			}, {
+				Type: storageTypes.EventTypeSendPacket,
+				Data: map[string]any{
+					"packet_sequence":    "4",
+					"packet_src_port":    "transfer",
+					"packet_src_channel": "channel-2",
+					"packet_dst_port":    "transfer",
+					"packet_dst_channel": "channel-6994",
+				},
+			},
+		},
+	}
+
+	err := setIbcTransfers(&tx)
+	require.NoError(t, err)
+
+	require.Nil(t, tx.Messages[1].IbcTransfer)
+
+	require.NotNil(t, tx.Messages[2].IbcTransfer)
+	require.Equal(t, storageTypes.IbcTransferStatusSuccess, tx.Messages[2].IbcTransfer.Status)
+
+	require.NotNil(t, tx.Messages[3].IbcTransfer)
+	require.Equal(t, storageTypes.IbcTransferStatusFailed, tx.Messages[3].IbcTransfer.Status)
+
+	require.NotNil(t, tx.Messages[4].IbcTransfer)
+	require.True(t, tx.Messages[4].IbcTransfer.IsResolution())
+
+	sent := tx.Messages[5].InternalMsgs[0].IbcTransfer
+	require.NotNil(t, sent)
+	require.EqualValues(t, 4, sent.Sequence)
+	require.Equal(t, "transfer", sent.DestinationPort)
+	require.Equal(t, "channel-6994", sent.DestinationChannel)
+	require.Equal(t, storageTypes.IbcTransferStatusPending, sent.Status)
+}
+
+func Test_setIbcTransfers_WithoutSendPacket(t *testing.T) {
+	tx := storage.Tx{
+		Messages: []storage.Message{
+			{
+				Type: storageTypes.IBCTransfer,
+				IbcTransfer: &storage.IbcTransfer{
+					Status:        storageTypes.IbcTransferStatusPending,
+					SourcePort:    "transfer",
+					SourceChannel: "channel-2",
+				},
+			},
+		},
+	}
+
+	err := setIbcTransfers(&tx)
+	require.NoError(t, err)
+	require.Nil(t, tx.Messages[0].IbcTransfer)
+}
+
+func Test_setIbcTransfers_InvalidSequence(t *testing.T) {
+	tx := storage.Tx{
+		Events: []storage.Event{
+			{
+				Type: storageTypes.EventTypeRecvPacket,
+				Data: map[string]any{
+					"packet_sequence": "invalid",
+				},
+			},
+		},
+	}
+
+	err := setIbcTransfers(&tx)
+	require.Error(t, err)
+}
@@ -13,6 +13,7 @@ func (p *Module) listen(ctx context.Context) {
 
 	input := p.MustInput(InputName)
 
+	var seq uint64
 	for {
 		select {
 		case <-ctx.Done():
@@ -30,12 +31,11 @@ func (p *Module) listen(ctx context.Context) {
 				continue
 			}
 
-			if err := p.parse(ctx, block); err != nil {
-				p.Log.Err(err).
-					Uint64("height", uint64(block.Height)).
-					Msg("block parsing error")
-				p.MustOutput(StopOutput).Push(struct{}{})
-				continue
+			select {
+			case <-ctx.Done():
+				return
+			case p.tasks <- parseTask{seq: seq, block: block}:
+				seq++
 			}
 		}
 	}
@@ -4,7 +4,6 @@
 package parser
 
 import (
-	"context"
 	"time"
 
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
@@ -14,7 +13,8 @@ import (
 	"github.com/shopspring/decimal"
 )
 
-func (p *Module) parse(ctx context.Context, b types.BlockData) error {
+// Parse - decodes block data received from node to storage model
+func (p *Module) Parse(b types.BlockData) (storage.Block, error) {
 	start := time.Now()
 	p.Log.Info().
 		Int64("height", b.Block.Height).
@@ -22,7 +22,7 @@ func (p *Module) parse(ctx context.Context, b types.BlockData) error {
 
 	txs, err := parseTxs(b)
 	if err != nil {
-		return errors.Wrapf(err, "while parsing block on level=%d", b.Height)
+		return storage.Block{}, errors.Wrapf(err, "while parsing block on level=%d", b.Height)
 	}
 
 	block := storage.Block{
@@ -62,25 +62,49 @@ func (p *Module) parse(ctx context.Context, b types.BlockData) error {
 		},
 	}
 
-	allEvents := make([]storage.Event, 0)
+	var eventsResult eventsResult
+
+	beginEvents := parseEvents(b, b.ResultBlockResults.BeginBlockEvents)
+	block.Events = beginEvents
+	updates, err := eventsResult.Fill(beginEvents)
+	if err != nil {
+		return storage.Block{}, err
+	}
+	block.BalanceUpdates = append(block.BalanceUpdates, updates...)
 
-	block.Events = parseEvents(b, b.ResultBlockResults.BeginBlockEvents)
-	allEvents = append(allEvents, block.Events...)
+	for i := range block.Txs {
+		block.Stats.Fee = block.Stats.Fee.Add(block.Txs[i].Fee)
+		block.MessageTypes.Set(block.Txs[i].MessageTypes.Bits)
+		block.Stats.BlobsSize += block.Txs[i].BlobsSize
 
-	for _, tx := range txs {
-		block.Stats.Fee = block.Stats.Fee.Add(tx.Fee)
-		block.MessageTypes.Set(tx.MessageTypes.Bits)
-		block.Stats.BlobsSize += tx.BlobsSize
-		allEvents = append(allEvents, tx.Events...)
+		block.Txs[i].BalanceUpdates, err = eventsResult.Fill(block.Txs[i].Events)
+		if err != nil {
+			return storage.Block{}, err
+		}
 	}
 
 	endEvents := parseEvents(b, b.ResultBlockResults.EndBlockEvents)
 	block.Events = append(block.Events, endEvents...)
-	allEvents = append(allEvents, endEvents...)
+	updates, err = eventsResult.Fill(endEvents)
+	if err != nil {
+		return storage.Block{}, err
+	}
+	block.BalanceUpdates = append(block.BalanceUpdates, updates...)
 
-	var eventsResult eventsResult
-	if err := eventsResult.Fill(allEvents); err != nil {
-		return err
+	block.ValidatorHistory, err = parseValidatorHistory(b, beginEvents, block.Txs, endEvents)
+	if err != nil {
+		return storage.Block{}, errors.Wrapf(err, "while parsing validator history on level=%d", b.Height)
+	}
+
+	block.Signatures = parseSignatures(b)
+	block.Evidence, err = parseEvidence(b)
+	if err != nil {
+		return storage.Block{}, errors.Wrapf(err, "while parsing evidence on level=%d", b.Height)
+	}
+
+	block.ProposalUpdates, err = parseProposalUpdates(b, block.Txs, endEvents)
+	if err != nil {
+		return storage.Block{}, errors.Wrapf(err, "while parsing proposal updates on level=%d", b.Height)
 	}
 
 	block.Stats.InflationRate = eventsResult.InflationRate
@@ -92,7 +116,5 @@ func (p *Module) parse(ctx context.Context, b types.BlockData) error {
 		Int64("ms", time.Since(start).Milliseconds()).
 		Msg("block parsed")
 
-	output := p.MustOutput(OutputName)
-	output.Push(block)
-	return nil
+	return block, nil
 }
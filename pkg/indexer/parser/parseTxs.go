@@ -9,7 +9,6 @@ import (
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
 	"github.com/pkg/errors"
-	"github.com/shopspring/decimal"
 )
 
 func parseTxs(b types.BlockData) ([]storage.Tx, error) {
@@ -66,9 +65,6 @@ func parseTx(b types.BlockData, index int, txRes *types.ResponseDeliverTx) (stor
 			Height:     t.Height,
 			LastHeight: t.Height,
 			Hash:       hash,
-			Balance: storage.Balance{
-				Total: decimal.Zero,
-			},
 		})
 	}
 
@@ -90,9 +86,43 @@ func parseTx(b types.BlockData, index int, txRes *types.ResponseDeliverTx) (stor
 		}
 
 		t.Messages[position] = dm.Msg
-		t.MessageTypes.SetBit(dm.Msg.Type)
+		setMessageTypes(&t.MessageTypes, dm.Msg)
 		t.BlobsSize += dm.BlobsSize
 	}
 
+	if err := setCompletionTimes(&t); err != nil {
+		return storage.Tx{}, errors.Wrapf(err, "while parsing tx=%v on index=%d", t.Hash, t.Position)
+	}
+
+	if err := setProposalIds(&t); err != nil {
+		return storage.Tx{}, errors.Wrapf(err, "while parsing tx=%v on index=%d", t.Hash, t.Position)
+	}
+
+	if err := setGrants(&t); err != nil {
+		return storage.Tx{}, errors.Wrapf(err, "while parsing tx=%v on index=%d", t.Hash, t.Position)
+	}
+
+	if err := setIbcTransfers(&t); err != nil {
+		return storage.Tx{}, errors.Wrapf(err, "while parsing tx=%v on index=%d", t.Hash, t.Position)
+	}
+
 	return t, nil
 }
+
+// setMessageTypes - sets bits of the message type and types of its internal messages executed by authz MsgExec
+func setMessageTypes(mask *storageTypes.MsgTypeBits, msg storage.Message) {
+	mask.SetBit(msg.Type)
+	for i := range msg.InternalMsgs {
+		setMessageTypes(mask, msg.InternalMsgs[i])
+	}
+}
+
+// flattenMessages - returns messages with their internal messages in execution order: every MsgExec is followed by messages executed by it
+func flattenMessages(msgs []storage.Message) []*storage.Message {
+	result := make([]*storage.Message, 0, len(msgs))
+	for i := range msgs {
+		result = append(result, &msgs[i])
+		result = append(result, flattenMessages(msgs[i].InternalMsgs)...)
+	}
+	return result
+}
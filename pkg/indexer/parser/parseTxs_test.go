@@ -6,6 +6,7 @@ package parser
 import (
 	"testing"
 
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
 	testsuite "github.com/dipdup-io/celestia-indexer/internal/test_suite"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
@@ -105,3 +106,38 @@ func TestParseTxs_FailedTxWithNonstandardErrorCode(t *testing.T) {
 	assert.Equal(t, int64(1000), f.GasUsed)
 	assert.Equal(t, "celestia-explorer", f.Codespace)
 }
+
+func TestFlattenMessages(t *testing.T) {
+	msgs := []storage.Message{
+		{
+			Type: storageTypes.MsgExec,
+			InternalMsgs: []storage.Message{
+				{Type: storageTypes.MsgDelegate},
+				{
+					Type: storageTypes.MsgExec,
+					InternalMsgs: []storage.Message{
+						{Type: storageTypes.MsgUndelegate},
+					},
+				},
+			},
+		}, {
+			Type: storageTypes.MsgSend,
+		},
+	}
+
+	flatten := flattenMessages(msgs)
+	assert.Len(t, flatten, 5)
+	assert.Equal(t, storageTypes.MsgExec, flatten[0].Type)
+	assert.Equal(t, storageTypes.MsgDelegate, flatten[1].Type)
+	assert.Equal(t, storageTypes.MsgExec, flatten[2].Type)
+	assert.Equal(t, storageTypes.MsgUndelegate, flatten[3].Type)
+	assert.Equal(t, storageTypes.MsgSend, flatten[4].Type)
+
+	flatten[3].Position = 10
+	assert.EqualValues(t, 10, msgs[0].InternalMsgs[1].InternalMsgs[0].Position)
+
+	mask := storageTypes.NewMsgTypeBitMask()
+	setMessageTypes(&mask, msgs[0])
+	assert.True(t, mask.HasOne(storageTypes.NewMsgTypeBitMask(storageTypes.MsgUndelegate)))
+	assert.False(t, mask.HasOne(storageTypes.NewMsgTypeBitMask(storageTypes.MsgSend)))
+}
@@ -5,11 +5,25 @@ package parser
 
 import (
 	"context"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/metrics"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 )
 
+// Module - decodes blocks concurrently by several workers and emits them in the order they were received.
+//
+//	                       |----------------|
+//	                       |                |
+//	-- types.BlockData ->  |     MODULE     | -- storage.Block ->
+//	                       |                |
+//	                       |----------------|
 type Module struct {
 	modules.BaseModule
+	threadsCount int
+	metrics      *metrics.Metrics
+	tasks        chan parseTask
+	results      chan parseResult
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -20,9 +34,17 @@ const (
 	StopOutput = "stop"
 )
 
-func NewModule() Module {
+func NewModule(cfg config.Indexer) Module {
+	threadsCount := int(cfg.ParserThreadsCount)
+	if threadsCount < 1 {
+		threadsCount = 1
+	}
+
 	m := Module{
-		BaseModule: modules.New("parser"),
+		BaseModule:   modules.New("parser"),
+		threadsCount: threadsCount,
+		tasks:        make(chan parseTask, threadsCount),
+		results:      make(chan parseResult, threadsCount*2),
 	}
 	m.CreateInput(InputName)
 	m.CreateOutput(OutputName)
@@ -31,8 +53,18 @@ func NewModule() Module {
 	return m
 }
 
+// WithMetrics - sets metrics collector
+func (p *Module) WithMetrics(m *metrics.Metrics) *Module {
+	p.metrics = m
+	return p
+}
+
 func (p *Module) Start(ctx context.Context) {
 	p.Log.Info().Msg("starting parser module...")
+	for i := 0; i < p.threadsCount; i++ {
+		p.G.GoCtx(ctx, p.worker)
+	}
+	p.G.GoCtx(ctx, p.sequencer)
 	p.G.GoCtx(ctx, p.listen)
 }
 
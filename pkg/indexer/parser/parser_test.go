@@ -10,6 +10,7 @@ import (
 
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 	"github.com/shopspring/decimal"
@@ -21,7 +22,7 @@ func createModules(t *testing.T) (modules.BaseModule, string, Module) {
 	writerModule := modules.New("writer-module")
 	outputName := "write"
 	writerModule.CreateOutput(outputName)
-	parserModule := NewModule()
+	parserModule := NewModule(config.Indexer{})
 
 	err := parserModule.AttachTo(&writerModule, outputName, InputName)
 	assert.NoError(t, err)
@@ -236,3 +237,46 @@ func TestModule_OnParseError(t *testing.T) {
 		}
 	}
 }
+
+func TestModule_ParallelParsingKeepsOrder(t *testing.T) {
+	const blocksCount = 50
+
+	writerModule := modules.New("writer-module")
+	outputName := "write"
+	writerModule.CreateOutput(outputName)
+	parserModule := NewModule(config.Indexer{ParserThreadsCount: 8})
+
+	err := parserModule.AttachTo(&writerModule, outputName, InputName)
+	assert.NoError(t, err)
+
+	readerModule := modules.New("reader-module")
+	readerInputName := "read"
+	readerModule.CreateInput(readerInputName)
+
+	err = readerModule.AttachTo(&parserModule, OutputName, readerInputName)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	parserModule.Start(ctx)
+
+	for i := types.Level(1); i <= blocksCount; i++ {
+		block := getBlock()
+		block.ResultBlockResults.Height = i
+		writerModule.MustOutput(outputName).Push(block)
+	}
+
+	expected := types.Level(1)
+	for expected <= blocksCount {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("stop by cancelled context, received %d blocks", expected-1)
+		case msg := <-readerModule.MustInput(readerInputName).Listen():
+			parsedBlock, ok := msg.(storage.Block)
+			assert.Truef(t, ok, "invalid message type: %T", msg)
+			assert.Equal(t, expected, parsedBlock.Height)
+			expected++
+		}
+	}
+}
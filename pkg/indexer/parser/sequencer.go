@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"context"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/metrics"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+type parseTask struct {
+	seq   uint64
+	block types.BlockData
+}
+
+type parseResult struct {
+	seq    uint64
+	height types.Level
+	block  storage.Block
+	err    error
+}
+
+func (p *Module) worker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case task := <-p.tasks:
+			start := time.Now()
+			block, err := p.Parse(task.block)
+			if err == nil {
+				p.metrics.Processed(metrics.StageParsed, 1, time.Since(start))
+			}
+			result := parseResult{
+				seq:    task.seq,
+				height: task.block.Height,
+				block:  block,
+				err:    err,
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case p.results <- result:
+			}
+		}
+	}
+}
+
+// sequencer - emits parsed blocks in the order they were received by the module. Receiver sends blocks in height order,
+// so input order is used instead of heights which can go back after rollback.
+func (p *Module) sequencer(ctx context.Context) {
+	var next uint64
+	ordered := make(map[uint64]parseResult)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case result := <-p.results:
+			ordered[result.seq] = result
+
+			for r, ok := ordered[next]; ok; r, ok = ordered[next] {
+				delete(ordered, next)
+				next++
+
+				if r.err != nil {
+					p.Log.Err(r.err).
+						Uint64("height", uint64(r.height)).
+						Msg("block parsing error")
+					p.MustOutput(StopOutput).Push(struct{}{})
+					continue
+				}
+
+				p.MustOutput(OutputName).Push(r.block)
+			}
+		}
+	}
+}
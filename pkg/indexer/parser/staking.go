@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
+	"github.com/pkg/errors"
+)
+
+const completionTimeKey = "completion_time"
+
+// setCompletionTimes - sets completion time of unbondings and redelegations from `unbond` and `redelegate` events.
+// Staking module emits one event per message, so the n-th event of the type corresponds to the n-th message.
+func setCompletionTimes(tx *storage.Tx) error {
+	var (
+		msgs                     = flattenMessages(tx.Messages)
+		unbondIdx, redelegateIdx int
+	)
+	for i := range tx.Events {
+		switch tx.Events[i].Type {
+		case types.EventTypeUnbond:
+			msg := nextMessage(msgs, &unbondIdx, func(msg storage.Message) bool { return msg.Unbonding != nil })
+			if msg == nil {
+				continue
+			}
+			completionTime, err := decode.TimeFromMap(tx.Events[i].Data, completionTimeKey)
+			if err != nil {
+				return errors.Wrap(err, "unbond completion time")
+			}
+			msg.Unbonding.CompletionTime = completionTime
+		case types.EventTypeRedelegate:
+			msg := nextMessage(msgs, &redelegateIdx, func(msg storage.Message) bool { return msg.Redelegation != nil })
+			if msg == nil {
+				continue
+			}
+			completionTime, err := decode.TimeFromMap(tx.Events[i].Data, completionTimeKey)
+			if err != nil {
+				return errors.Wrap(err, "redelegate completion time")
+			}
+			msg.Redelegation.CompletionTime = completionTime
+		}
+	}
+	return nil
+}
+
+func nextMessage(msgs []*storage.Message, idx *int, match func(msg storage.Message) bool) *storage.Message {
+	for ; *idx < len(msgs); *idx++ {
+		if match(*msgs[*idx]) {
+			msg := msgs[*idx]
+			*idx++
+			return msg
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_setCompletionTimes(t *testing.T) {
+	tx := storage.Tx{
+		Messages: []storage.Message{
+			{
+				Type:      types.MsgUndelegate,
+				Unbonding: &storage.Unbonding{},
+			}, {
+				Type: types.MsgDelegate,
+			}, {
+				Type:         types.MsgBeginRedelegate,
+				Redelegation: &storage.Redelegation{},
+			}, {
+				Type:      types.MsgUndelegate,
+				Unbonding: &storage.Unbonding{},
+			},
+		},
+		Events: []storage.Event{
+			{
+				Type: types.EventTypeUnbond,
+				Data: map[string]any{
+					"completion_time": "2023-11-08T12:00:00Z",
+				},
+			}, {
+				Type: types.EventTypeDelegate,
+				Data: map[string]any{},
+			}, {
+				Type: types.EventTypeRedelegate,
+				Data: map[string]any{
+					"completion_time": "2023-11-09T12:00:00Z",
+				},
+			}, {
+				Type: types.EventTypeUnbond,
+				Data: map[string]any{
+					"completion_time": "2023-11-10T12:00:00Z",
+				},
+			},
+		},
+	}
+
+	err := setCompletionTimes(&tx)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, 11, 8, 12, 0, 0, 0, time.UTC), tx.Messages[0].Unbonding.CompletionTime)
+	require.Equal(t, time.Date(2023, 11, 9, 12, 0, 0, 0, time.UTC), tx.Messages[2].Redelegation.CompletionTime)
+	require.Equal(t, time.Date(2023, 11, 10, 12, 0, 0, 0, time.UTC), tx.Messages[3].Unbonding.CompletionTime)
+}
+
+func Test_setCompletionTimes_InvalidTime(t *testing.T) {
+	tx := storage.Tx{
+		Messages: []storage.Message{
+			{
+				Type:      types.MsgUndelegate,
+				Unbonding: &storage.Unbonding{},
+			},
+		},
+		Events: []storage.Event{
+			{
+				Type: types.EventTypeUnbond,
+				Data: map[string]any{
+					"completion_time": "invalid",
+				},
+			},
+		},
+	}
+
+	err := setCompletionTimes(&tx)
+	require.Error(t, err)
+}
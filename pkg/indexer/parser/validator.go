@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"strconv"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/decode"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// parseValidatorHistory - collects slashing events and end-block validator updates in order of their applying:
+// begin-block events, transaction events, end-block events and validator updates.
+func parseValidatorHistory(b types.BlockData, beginEvents []storage.Event, txs []storage.Tx, endEvents []storage.Event) ([]storage.ValidatorHistory, error) {
+	var history []storage.ValidatorHistory
+
+	if err := appendSlashes(&history, beginEvents); err != nil {
+		return nil, err
+	}
+	for i := range txs {
+		if err := appendSlashes(&history, txs[i].Events); err != nil {
+			return nil, err
+		}
+	}
+	if err := appendSlashes(&history, endEvents); err != nil {
+		return nil, err
+	}
+
+	for _, update := range b.ValidatorUpdates {
+		consAddress := update.PubKey.Address()
+		if consAddress == nil {
+			continue
+		}
+		history = append(history, storage.ValidatorHistory{
+			Height:      b.Height,
+			Time:        b.Block.Time,
+			Type:        storageTypes.ValidatorHistoryTypePowerChange,
+			Power:       update.Power,
+			BurnedCoins: decimal.Zero,
+			ConsAddress: consAddress,
+		})
+	}
+
+	return history, nil
+}
+
+// parseSignatures - returns signatures of the previous block received from the last commit. Absent votes are skipped.
+func parseSignatures(b types.BlockData) []storage.BlockSignature {
+	commit := b.Block.LastCommit
+	if commit == nil {
+		return nil
+	}
+
+	var signatures []storage.BlockSignature
+	for _, sig := range commit.Signatures {
+		if !sig.Signed() {
+			continue
+		}
+		signatures = append(signatures, storage.BlockSignature{
+			Height:      types.Level(commit.Height),
+			Time:        b.Block.Time,
+			ConsAddress: sig.ValidatorAddress,
+		})
+	}
+	return signatures
+}
+
+func appendSlashes(history *[]storage.ValidatorHistory, events []storage.Event) error {
+	for i := range events {
+		if events[i].Type != storageTypes.EventTypeSlash {
+			continue
+		}
+		entries, err := parseSlash(events[i])
+		if err != nil {
+			return errors.Wrapf(err, "parse slash event at position %d", events[i].Position)
+		}
+		*history = append(*history, entries...)
+	}
+	return nil
+}
+
+// parseSlash - decodes `slash` event. The event contains `address`, `power`, `reason` and `burned_coins` attributes
+// when tokens are slashed and `jailed` attribute when validator is jailed. Downtime slashing emits all of them in one event,
+// so both slash and jail entries are returned in this case.
+func parseSlash(event storage.Event) ([]storage.ValidatorHistory, error) {
+	var entries []storage.ValidatorHistory
+
+	if address := decode.StringFromMap(event.Data, "address"); address != "" {
+		consAddress, err := consAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+		entry := storage.ValidatorHistory{
+			Height:      event.Height,
+			Time:        event.Time,
+			Type:        storageTypes.ValidatorHistoryTypeSlash,
+			ConsAddress: consAddress,
+			Reason:      decode.StringFromMap(event.Data, "reason"),
+			BurnedCoins: decode.DecimalFromMap(event.Data, "burned_coins"),
+		}
+		if power := decode.StringFromMap(event.Data, "power"); power != "" {
+			entry.SlashPower, err = strconv.ParseInt(power, 10, 64)
+			if err != nil {
+				return nil, errors.Wrap(err, "slash power")
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	if jailed := decode.StringFromMap(event.Data, "jailed"); jailed != "" {
+		consAddress, err := consAddressFromBech32(jailed)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, storage.ValidatorHistory{
+			Height:      event.Height,
+			Time:        event.Time,
+			Type:        storageTypes.ValidatorHistoryTypeJail,
+			ConsAddress: consAddress,
+			BurnedCoins: decimal.Zero,
+		})
+	}
+	return entries, nil
+}
+
+func consAddressFromBech32(address string) (types.Hex, error) {
+	_, hash, err := types.Address(address).Decode()
+	if err != nil {
+		return nil, errors.Wrapf(err, "decode consensus address %s", address)
+	}
+	return types.Hex(hash), nil
+}
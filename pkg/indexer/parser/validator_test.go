@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package parser
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testConsAddress = "celestiavalcons1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lyvtxk"
+
+var testConsAddressHash = types.Hex{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14}
+
+func Test_parseValidatorHistory(t *testing.T) {
+	now := time.Now().UTC()
+	pubKey := make([]byte, 32)
+	pubKey[0] = 1
+	hash := sha256.Sum256(pubKey)
+
+	b := types.BlockData{
+		ResultBlock: types.ResultBlock{
+			Block: &types.Block{
+				Header: types.Header{
+					Time: now,
+				},
+			},
+		},
+		ResultBlockResults: types.ResultBlockResults{
+			Height: 100,
+			ValidatorUpdates: []types.ValidatorUpdate{
+				{
+					PubKey: types.PublicKey{
+						Sum: types.PublicKeySum{
+							Type: "tendermint.crypto.PublicKey_Ed25519",
+							Value: types.PublicKeyValue{
+								Ed25519: pubKey,
+							},
+						},
+					},
+					Power: 0,
+				}, {
+					Power: 10,
+				},
+			},
+		},
+	}
+
+	beginEvents := []storage.Event{
+		{
+			Height: 100,
+			Time:   now,
+			Type:   storageTypes.EventTypeLiveness,
+			Data: map[string]any{
+				"address":       testConsAddress,
+				"missed_blocks": "10",
+			},
+		}, {
+			Height: 100,
+			Time:   now,
+			Type:   storageTypes.EventTypeSlash,
+			Data: map[string]any{
+				"address":      testConsAddress,
+				"power":        "1234",
+				"reason":       "missing_signature",
+				"jailed":       testConsAddress,
+				"burned_coins": "100utia",
+			},
+		},
+	}
+
+	history, err := parseValidatorHistory(b, beginEvents, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+
+	require.Equal(t, storageTypes.ValidatorHistoryTypeSlash, history[0].Type)
+	require.Equal(t, testConsAddressHash, history[0].ConsAddress)
+	require.EqualValues(t, 1234, history[0].SlashPower)
+	require.Equal(t, "missing_signature", history[0].Reason)
+	require.Equal(t, "100", history[0].BurnedCoins.String())
+	require.EqualValues(t, 100, history[0].Height)
+
+	require.Equal(t, storageTypes.ValidatorHistoryTypeJail, history[1].Type)
+	require.Equal(t, testConsAddressHash, history[1].ConsAddress)
+	require.EqualValues(t, 100, history[1].Height)
+
+	require.Equal(t, storageTypes.ValidatorHistoryTypePowerChange, history[2].Type)
+	require.Equal(t, types.Hex(hash[:20]), history[2].ConsAddress)
+	require.EqualValues(t, 0, history[2].Power)
+	require.Equal(t, now, history[2].Time)
+}
+
+func Test_parseSlashInvalidAddress(t *testing.T) {
+	_, err := parseSlash(storage.Event{
+		Type: storageTypes.EventTypeSlash,
+		Data: map[string]any{
+			"jailed": "invalid",
+		},
+	})
+	require.Error(t, err)
+}
+
+func Test_parseSignatures(t *testing.T) {
+	now := time.Now().UTC()
+
+	t.Run("empty commit", func(t *testing.T) {
+		b := types.BlockData{
+			ResultBlock: types.ResultBlock{
+				Block: &types.Block{
+					Header: types.Header{
+						Height: 1,
+						Time:   now,
+					},
+				},
+			},
+		}
+		require.Nil(t, parseSignatures(b))
+	})
+
+	t.Run("last commit", func(t *testing.T) {
+		b := types.BlockData{
+			ResultBlock: types.ResultBlock{
+				Block: &types.Block{
+					Header: types.Header{
+						Height: 101,
+						Time:   now,
+					},
+					LastCommit: &types.Commit{
+						Height: 100,
+						Signatures: []types.CommitSig{
+							{
+								BlockIDFlag:      types.BlockIDFlagCommit,
+								ValidatorAddress: testConsAddressHash,
+							}, {
+								BlockIDFlag: types.BlockIDFlagAbsent,
+							}, {
+								BlockIDFlag:      types.BlockIDFlagNil,
+								ValidatorAddress: types.Hex{0x15},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		signatures := parseSignatures(b)
+		require.Len(t, signatures, 2)
+		require.EqualValues(t, 100, signatures[0].Height)
+		require.Equal(t, now, signatures[0].Time)
+		require.Equal(t, testConsAddressHash, signatures[0].ConsAddress)
+		require.EqualValues(t, 100, signatures[1].Height)
+		require.Equal(t, types.Hex{0x15}, signatures[1].ConsAddress)
+	})
+}
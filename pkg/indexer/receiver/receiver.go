@@ -9,6 +9,7 @@ import (
 
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/metrics"
 	"github.com/dipdup-io/celestia-indexer/pkg/node"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
 	"github.com/dipdup-io/workerpool"
@@ -37,8 +38,11 @@ const (
 type Module struct {
 	modules.BaseModule
 	api              node.Api
+	subscriber       node.Subscriber
+	metrics          *metrics.Metrics
 	cfg              config.Indexer
-	pool             *workerpool.Pool[types.Level]
+	retry            retryPolicy
+	pool             *workerpool.Pool[levelRange]
 	blocks           chan types.BlockData
 	level            types.Level
 	hash             []byte
@@ -64,6 +68,7 @@ func NewModule(cfg config.Indexer, api node.Api, state *storage.State) Module {
 		BaseModule:   modules.New("receiver"),
 		api:          api,
 		cfg:          cfg,
+		retry:        newRetryPolicy(cfg.Retry),
 		blocks:       make(chan types.BlockData, cfg.ThreadsCount*10),
 		needGenesis:  state == nil,
 		level:        level,
@@ -86,6 +91,18 @@ func NewModule(cfg config.Indexer, api node.Api, state *storage.State) Module {
 	return receiver
 }
 
+// WithSubscriber - sets subscriber on new blocks. If it's set receiver gets new heads from subscription and uses polling only when subscription is lost.
+func (r *Module) WithSubscriber(subscriber node.Subscriber) *Module {
+	r.subscriber = subscriber
+	return r
+}
+
+// WithMetrics - sets metrics collector
+func (r *Module) WithMetrics(m *metrics.Metrics) *Module {
+	r.metrics = m
+	return r
+}
+
 func (r *Module) Start(ctx context.Context) {
 	r.Log.Info().Msg("starting receiver...")
 	workersCtx, cancelWorkers := context.WithCancel(ctx)
@@ -108,6 +125,12 @@ func (r *Module) Close() error {
 	r.Log.Info().Msg("closing...")
 	r.G.Wait()
 
+	if r.subscriber != nil {
+		if err := r.subscriber.Close(); err != nil {
+			return err
+		}
+	}
+
 	if err := r.pool.Close(); err != nil {
 		return err
 	}
@@ -162,3 +185,8 @@ func (r *Module) rollback(ctx context.Context) {
 func (r *Module) stopAll() {
 	r.MustOutput(StopOutput).Push(struct{}{})
 }
+
+// stop - stops the pipeline because of unrecoverable error
+func (r *Module) stop(err error) {
+	r.MustOutput(StopOutput).Push(err)
+}
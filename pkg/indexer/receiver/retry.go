@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package receiver
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	nodeTypes "github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultRetryCount    = 10
+	defaultRetryDelay    = time.Second
+	defaultRetryMaxDelay = 30 * time.Second
+)
+
+// messages of node errors which can't be fixed by retrying
+var permanentErrors = []string{
+	"is not available, lowest height is",
+	"could not find results for height",
+}
+
+type retryPolicy struct {
+	count    uint32
+	delay    time.Duration
+	maxDelay time.Duration
+}
+
+func newRetryPolicy(cfg config.Retry) retryPolicy {
+	policy := retryPolicy{
+		count:    cfg.Count,
+		delay:    time.Duration(cfg.Delay) * time.Millisecond,
+		maxDelay: time.Duration(cfg.MaxDelay) * time.Millisecond,
+	}
+	if policy.count == 0 {
+		policy.count = defaultRetryCount
+	}
+	if policy.delay <= 0 {
+		policy.delay = defaultRetryDelay
+	}
+	if policy.maxDelay <= 0 {
+		policy.maxDelay = defaultRetryMaxDelay
+	}
+	if policy.maxDelay < policy.delay {
+		policy.maxDelay = policy.delay
+	}
+	return policy
+}
+
+// backoff - returns delay before the next attempt: exponentially growing with attempt number, limited by maxDelay
+// and randomized in range [delay/2, delay] to spread requests of workers in time
+func (p retryPolicy) backoff(attempt uint32) time.Duration {
+	delay := p.maxDelay
+	if attempt < 32 {
+		if d := p.delay << attempt; d > 0 && d < p.maxDelay {
+			delay = d
+		}
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// wait - sleeps before the next attempt. Returns false if context was cancelled.
+func (p retryPolicy) wait(ctx context.Context, attempt uint32) bool {
+	timer := time.NewTimer(p.backoff(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// isPermanent - returns true if error can't be fixed by retrying the request: pruned height or malformed response
+func isPermanent(err error) bool {
+	var (
+		syntaxErr    *json.SyntaxError
+		unmarshalErr *json.UnmarshalTypeError
+	)
+	if errors.As(err, &syntaxErr) || errors.As(err, &unmarshalErr) {
+		return true
+	}
+
+	if !errors.Is(err, nodeTypes.ErrRequest) {
+		return false
+	}
+
+	msg := err.Error()
+	for i := range permanentErrors {
+		if strings.Contains(msg, permanentErrors[i]) {
+			return true
+		}
+	}
+	return false
+}
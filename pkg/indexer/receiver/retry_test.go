@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package receiver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	nodeTypes "github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := newRetryPolicy(config.Retry{
+		Count:    5,
+		Delay:    100,
+		MaxDelay: 1000,
+	})
+
+	tests := []struct {
+		attempt uint32
+		max     time.Duration
+	}{
+		{attempt: 0, max: 100 * time.Millisecond},
+		{attempt: 1, max: 200 * time.Millisecond},
+		{attempt: 2, max: 400 * time.Millisecond},
+		{attempt: 3, max: 800 * time.Millisecond},
+		{attempt: 4, max: time.Second},
+		{attempt: 100, max: time.Second},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			delay := policy.backoff(tt.attempt)
+			require.LessOrEqual(t, delay, tt.max)
+			require.GreaterOrEqual(t, delay, tt.max/2)
+		}
+	}
+}
+
+func TestRetryPolicy_Defaults(t *testing.T) {
+	policy := newRetryPolicy(config.Retry{})
+	require.EqualValues(t, defaultRetryCount, policy.count)
+	require.Equal(t, defaultRetryDelay, policy.delay)
+	require.Equal(t, defaultRetryMaxDelay, policy.maxDelay)
+}
+
+func TestIsPermanent(t *testing.T) {
+	var syntaxErr error
+	var dst map[string]any
+	syntaxErr = json.Unmarshal([]byte(`{"result":`), &dst)
+	require.Error(t, syntaxErr)
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "pruned height",
+			err:  errors.Wrapf(nodeTypes.ErrRequest, "request error: %s", "code=-32603 message=Internal error data=height 1 is not available, lowest height is 1000"),
+			want: true,
+		}, {
+			name: "pruned block results",
+			err:  errors.Wrapf(nodeTypes.ErrRequest, "request error: %s", "code=-32603 message=Internal error data=could not find results for height #1"),
+			want: true,
+		}, {
+			name: "height in future",
+			err:  errors.Wrapf(nodeTypes.ErrRequest, "request error: %s", "code=-32603 message=Internal error data=height 1001 must be less than or equal to the current blockchain height 1000"),
+			want: false,
+		}, {
+			name: "malformed json",
+			err:  errors.Wrap(syntaxErr, "api.post"),
+			want: true,
+		}, {
+			name: "network error",
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isPermanent(tt.err))
+		})
+	}
+}
@@ -28,6 +28,7 @@ func (r *Module) sequencer(ctx context.Context) {
 			}
 
 			orderedBlocks[block.Block.Height] = block
+			r.metrics.SetReceiverQueues(r.taskQueue.Len(), len(r.blocks)+len(orderedBlocks))
 
 			b, ok := orderedBlocks[currentBlock]
 			for ok {
@@ -11,8 +11,15 @@ import (
 )
 
 func (r *Module) sync(ctx context.Context) {
-	var blocksCtx context.Context
-	blocksCtx, r.cancelReadBlocks = context.WithCancel(ctx)
+	if level, _ := r.Level(); r.endLevelReached(level) {
+		r.Log.Info().Uint64("level", uint64(level)).Msg("end level is already indexed")
+		r.stopAll()
+		return
+	}
+
+	heads := r.subscribe(ctx)
+
+	blocksCtx := r.blocksContext(ctx, nil)
 	if err := r.readBlocks(blocksCtx); err != nil && !errors.Is(err, context.Canceled) {
 		r.Log.Err(err).Msg("while reading blocks")
 		r.stopAll()
@@ -28,8 +35,23 @@ func (r *Module) sync(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			return
+		case head, ok := <-heads:
+			if !ok {
+				r.Log.Warn().Msg("subscription on new blocks is lost, fallback to polling")
+				heads = nil
+				continue
+			}
+			r.metrics.SetNodeHeight(head)
+			blocksCtx = r.blocksContext(ctx, blocksCtx)
+			r.addTasks(blocksCtx, head)
 		case <-ticker.C:
-			blocksCtx, r.cancelReadBlocks = context.WithCancel(ctx)
+			if heads != nil {
+				continue
+			}
+			// subscribe before reading to cover blocks produced between status request and subscription
+			heads = r.subscribe(ctx)
+
+			blocksCtx = r.blocksContext(ctx, blocksCtx)
 			if err := r.readBlocks(blocksCtx); err != nil && !errors.Is(err, context.Canceled) {
 				r.Log.Err(err).Msg("while reading blocks by timer")
 				r.stopAll()
@@ -39,6 +61,29 @@ func (r *Module) sync(ctx context.Context) {
 	}
 }
 
+// blocksContext - returns context of blocks reading. The current context is reused until rollback cancels it, so only one child context of `ctx` is alive at a time.
+func (r *Module) blocksContext(ctx, current context.Context) context.Context {
+	if current != nil && current.Err() == nil {
+		return current
+	}
+	blocksCtx, cancel := context.WithCancel(ctx)
+	r.cancelReadBlocks = cancel
+	return blocksCtx
+}
+
+// subscribe - returns channel of new heads or nil if subscriber is not set or subscription is failed
+func (r *Module) subscribe(ctx context.Context) <-chan types.Level {
+	if r.subscriber == nil {
+		return nil
+	}
+	heads, err := r.subscriber.Subscribe(ctx)
+	if err != nil {
+		r.Log.Err(err).Msg("subscribe on new blocks")
+		return nil
+	}
+	return heads
+}
+
 func (r *Module) readBlocks(ctx context.Context) error {
 	headLevel, err := r.headLevel(ctx)
 	if err != nil {
@@ -48,22 +93,53 @@ func (r *Module) readBlocks(ctx context.Context) error {
 		return err
 	}
 
+	r.addTasks(ctx, headLevel)
+	return nil
+}
+
+// addTasks - schedules receiving of levels up to head. If receiver is far behind head, levels are received by batches of `FetchBatchSize`.
+func (r *Module) addTasks(ctx context.Context, headLevel types.Level) {
+	if r.cfg.EndLevel > 0 && headLevel > types.Level(r.cfg.EndLevel) {
+		headLevel = types.Level(r.cfg.EndLevel)
+	}
+
 	level, _ := r.Level()
 	level += 1
 
-	for ; level <= headLevel; level++ {
+	batchSize := types.Level(r.cfg.FetchBatchSize)
+	for level <= headLevel {
 		select {
 		case <-ctx.Done():
-			return nil
+			return
 		default:
-			if _, ok := r.taskQueue.Get(level); !ok {
-				r.taskQueue.Set(level, struct{}{})
-				r.pool.AddTask(level)
+		}
+
+		if _, ok := r.taskQueue.Get(level); ok {
+			level++
+			continue
+		}
+
+		task := levelRange{from: level, to: level}
+		if batchSize > 1 && headLevel-level >= batchSize {
+			for task.to+1 < level+batchSize {
+				if _, ok := r.taskQueue.Get(task.to + 1); ok {
+					break
+				}
+				task.to++
 			}
 		}
+
+		for l := task.from; l <= task.to; l++ {
+			r.taskQueue.Set(l, struct{}{})
+		}
+		r.pool.AddTask(task)
+		level = task.to + 1
 	}
+}
 
-	return nil
+// endLevelReached - returns true if `end_level` is set and level is not lower
+func (r *Module) endLevelReached(level types.Level) bool {
+	return r.cfg.EndLevel > 0 && level >= types.Level(r.cfg.EndLevel)
 }
 
 func (r *Module) headLevel(ctx context.Context) (types.Level, error) {
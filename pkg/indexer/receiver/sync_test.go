@@ -9,8 +9,10 @@ import (
 	"time"
 
 	ic "github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/mock"
 	nodeTypes "github.com/dipdup-io/celestia-indexer/pkg/node/types"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/indexer-sdk/pkg/modules"
 	"github.com/dipdup-net/indexer-sdk/pkg/modules/stopper"
 	"github.com/pkg/errors"
 	"go.uber.org/mock/gomock"
@@ -129,3 +131,378 @@ func (s *ModuleTestSuite) TestModule_SyncReadsBlocks() {
 		s.Require().EqualValues(i, syncedBlockData[i-1].Height)
 	}
 }
+
+func (s *ModuleTestSuite) receiveOrderedBlocks(ctx context.Context, receiverModule *Module, count int) []types.BlockData {
+	blocksReaderModule := modules.New("ordered-blocks-reader")
+	const orderedBlocksChannel = "ordered-blocks"
+	blocksReaderModule.CreateInput(orderedBlocksChannel)
+	err := blocksReaderModule.AttachTo(receiverModule, BlocksOutput, orderedBlocksChannel)
+	s.Require().NoError(err)
+
+	workersCtx, cancelWorkers := context.WithCancel(ctx)
+	receiverModule.cancelWorkers = cancelWorkers
+	receiverModule.pool.Start(workersCtx)
+
+	go receiverModule.sequencer(ctx)
+	go receiverModule.sync(ctx)
+
+	result := make([]types.BlockData, 0, count)
+	for len(result) < count {
+		select {
+		case <-ctx.Done():
+			s.FailNow("timeout", "received %d blocks of %d", len(result), count)
+		case msg := <-blocksReaderModule.MustInput(orderedBlocksChannel).Listen():
+			block, ok := msg.(types.BlockData)
+			s.Require().True(ok)
+			result = append(result, block)
+		}
+	}
+	return result
+}
+
+func (s *ModuleTestSuite) TestModule_SyncReadsBlocksFromSubscription() {
+	const (
+		statusHead = 2
+		blockCount = 4
+	)
+	heads := make(chan types.Level, 1)
+	subscriber := mock.NewMockSubscriber(gomock.NewController(s.T()))
+	subscriber.EXPECT().
+		Subscribe(gomock.Any()).
+		Return(heads, nil).
+		Times(1)
+
+	s.InitApi(func() {
+		s.api.EXPECT().
+			Status(gomock.Any()).
+			Return(nodeTypes.Status{
+				SyncInfo: nodeTypes.SyncInfo{
+					LatestBlockHeight: statusHead,
+				},
+			}, nil).
+			Times(1)
+
+		for i := types.Level(1); i <= blockCount; i++ {
+			s.api.EXPECT().
+				BlockData(gomock.Any(), i).
+				Return(types.BlockData{
+					ResultBlock:        getResultBlock(i),
+					ResultBlockResults: getResultBlockResults(i),
+				}, nil).
+				MinTimes(1)
+		}
+	})
+
+	receiverModule := s.createModuleEmptyState(nil)
+	receiverModule.WithSubscriber(subscriber)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelCtx()
+
+	heads <- blockCount
+
+	blocks := s.receiveOrderedBlocks(ctx, &receiverModule, blockCount)
+	for i := range blocks {
+		s.Require().EqualValues(i+1, blocks[i].Height)
+	}
+}
+
+func (s *ModuleTestSuite) TestModule_SyncFallbackToPollingWhenSubscriptionIsLost() {
+	const blockCount = 3
+	lost := make(chan types.Level)
+	close(lost)
+	heads := make(chan types.Level)
+
+	subscriber := mock.NewMockSubscriber(gomock.NewController(s.T()))
+	gomock.InOrder(
+		subscriber.EXPECT().
+			Subscribe(gomock.Any()).
+			Return(lost, nil).
+			Times(1),
+		subscriber.EXPECT().
+			Subscribe(gomock.Any()).
+			Return(heads, nil).
+			Times(1),
+	)
+
+	s.InitApi(func() {
+		gomock.InOrder(
+			s.api.EXPECT().
+				Status(gomock.Any()).
+				Return(nodeTypes.Status{
+					SyncInfo: nodeTypes.SyncInfo{
+						LatestBlockHeight: 1,
+					},
+				}, nil).
+				Times(1),
+			s.api.EXPECT().
+				Status(gomock.Any()).
+				Return(nodeTypes.Status{
+					SyncInfo: nodeTypes.SyncInfo{
+						LatestBlockHeight: blockCount,
+					},
+				}, nil).
+				Times(1),
+		)
+
+		for i := types.Level(1); i <= blockCount; i++ {
+			s.api.EXPECT().
+				BlockData(gomock.Any(), i).
+				Return(types.BlockData{
+					ResultBlock:        getResultBlock(i),
+					ResultBlockResults: getResultBlockResults(i),
+				}, nil).
+				MinTimes(1)
+		}
+	})
+
+	receiverModule := s.createModuleEmptyState(&ic.Indexer{
+		Name:         cfgDefault.Name,
+		ThreadsCount: cfgDefault.ThreadsCount,
+		BlockPeriod:  1,
+	})
+	receiverModule.WithSubscriber(subscriber)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelCtx()
+
+	blocks := s.receiveOrderedBlocks(ctx, &receiverModule, blockCount)
+	for i := range blocks {
+		s.Require().EqualValues(i+1, blocks[i].Height)
+	}
+}
+
+func (s *ModuleTestSuite) TestModule_SyncReadsBlocksByBatches() {
+	const (
+		blockCount = 7
+		batchSize  = 3
+	)
+	getBlockData := func(from, to types.Level) []types.BlockData {
+		result := make([]types.BlockData, 0)
+		for i := from; i <= to; i++ {
+			result = append(result, types.BlockData{
+				ResultBlock:        getResultBlock(i),
+				ResultBlockResults: getResultBlockResults(i),
+			})
+		}
+		return result
+	}
+
+	s.InitApi(func() {
+		s.api.EXPECT().
+			Status(gomock.Any()).
+			Return(nodeTypes.Status{
+				SyncInfo: nodeTypes.SyncInfo{
+					LatestBlockHeight: blockCount,
+				},
+			}, nil).
+			Times(1)
+
+		s.api.EXPECT().
+			BlockDataRange(gomock.Any(), types.Level(1), types.Level(3)).
+			Return(getBlockData(1, 3), nil).
+			MinTimes(1)
+		s.api.EXPECT().
+			BlockDataRange(gomock.Any(), types.Level(4), types.Level(6)).
+			Return(getBlockData(4, 6), nil).
+			MinTimes(1)
+		s.api.EXPECT().
+			BlockData(gomock.Any(), types.Level(7)).
+			Return(getBlockData(7, 7)[0], nil).
+			MinTimes(1)
+	})
+
+	receiverModule := s.createModuleEmptyState(&ic.Indexer{
+		Name:           cfgDefault.Name,
+		ThreadsCount:   2,
+		BlockPeriod:    cfgDefault.BlockPeriod,
+		FetchBatchSize: batchSize,
+	})
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelCtx()
+
+	blocks := s.receiveOrderedBlocks(ctx, &receiverModule, blockCount)
+	for i := range blocks {
+		s.Require().EqualValues(i+1, blocks[i].Height)
+	}
+}
+
+func (s *ModuleTestSuite) TestModule_WorkerRetriesTransientErrors() {
+	s.InitApi(func() {
+		gomock.InOrder(
+			s.api.EXPECT().
+				BlockData(gomock.Any(), types.Level(1)).
+				Return(types.BlockData{}, errors.New("connection refused")).
+				Times(2),
+			s.api.EXPECT().
+				BlockData(gomock.Any(), types.Level(1)).
+				Return(types.BlockData{
+					ResultBlock:        getResultBlock(1),
+					ResultBlockResults: getResultBlockResults(1),
+				}, nil).
+				Times(1),
+		)
+	})
+
+	cfg := cfgDefault
+	cfg.Retry = ic.Retry{Count: 3, Delay: 1, MaxDelay: 10}
+	receiverModule := s.createModuleEmptyState(&cfg)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelCtx()
+
+	go receiverModule.worker(ctx, levelRange{from: 1, to: 1})
+
+	select {
+	case <-ctx.Done():
+		s.FailNow("timeout")
+	case block := <-receiverModule.blocks:
+		s.Require().EqualValues(1, block.Height)
+	}
+}
+
+func (s *ModuleTestSuite) TestModule_WorkerStopsAfterRetries() {
+	s.InitApi(func() {
+		s.api.EXPECT().
+			BlockData(gomock.Any(), types.Level(7)).
+			Return(types.BlockData{}, errors.New("connection refused")).
+			Times(3)
+	})
+
+	cfg := cfgDefault
+	cfg.Retry = ic.Retry{Count: 3, Delay: 1, MaxDelay: 10}
+	receiverModule := s.createModuleEmptyState(&cfg)
+
+	stopReader := modules.New("stop-reader")
+	stopReader.CreateInput(stopper.InputName)
+	err := stopReader.AttachTo(&receiverModule, StopOutput, stopper.InputName)
+	s.Require().NoError(err)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelCtx()
+
+	go receiverModule.worker(ctx, levelRange{from: 7, to: 7})
+
+	select {
+	case <-ctx.Done():
+		s.FailNow("timeout")
+	case msg := <-stopReader.MustInput(stopper.InputName).Listen():
+		stopErr, ok := msg.(error)
+		s.Require().True(ok)
+		s.Require().ErrorContains(stopErr, "height 7")
+	}
+}
+
+func (s *ModuleTestSuite) TestModule_WorkerStopsOnPermanentError() {
+	s.InitApi(func() {
+		s.api.EXPECT().
+			BlockData(gomock.Any(), types.Level(1)).
+			Return(types.BlockData{}, errors.Wrap(nodeTypes.ErrRequest, "height 1 is not available, lowest height is 1000")).
+			Times(1)
+	})
+
+	receiverModule := s.createModuleEmptyState(nil)
+
+	stopReader := modules.New("stop-reader")
+	stopReader.CreateInput(stopper.InputName)
+	err := stopReader.AttachTo(&receiverModule, StopOutput, stopper.InputName)
+	s.Require().NoError(err)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelCtx()
+
+	go receiverModule.worker(ctx, levelRange{from: 1, to: 1})
+
+	select {
+	case <-ctx.Done():
+		s.FailNow("timeout")
+	case msg := <-stopReader.MustInput(stopper.InputName).Listen():
+		stopErr, ok := msg.(error)
+		s.Require().True(ok)
+		s.Require().ErrorIs(stopErr, nodeTypes.ErrRequest)
+		s.Require().ErrorContains(stopErr, "height 1")
+	}
+}
+
+func (s *ModuleTestSuite) TestModule_SyncStopsSchedulingAtEndLevel() {
+	const endLevel = 3
+	s.InitApi(func() {
+		s.api.EXPECT().
+			Status(gomock.Any()).
+			Return(nodeTypes.Status{
+				SyncInfo: nodeTypes.SyncInfo{
+					LatestBlockHeight: 10,
+				},
+			}, nil).
+			MaxTimes(1)
+
+		for i := types.Level(1); i <= endLevel; i++ {
+			s.api.EXPECT().
+				BlockData(gomock.Any(), i).
+				Return(types.BlockData{
+					ResultBlock:        getResultBlock(i),
+					ResultBlockResults: getResultBlockResults(i),
+				}, nil).
+				Times(1)
+		}
+	})
+
+	cfg := cfgDefault
+	cfg.EndLevel = endLevel
+	receiverModule := s.createModuleEmptyState(&cfg)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelCtx()
+
+	blocks := s.receiveOrderedBlocks(ctx, &receiverModule, endLevel)
+	s.Require().Len(blocks, endLevel)
+	s.Require().EqualValues(endLevel, blocks[endLevel-1].Height)
+
+	_, ok := receiverModule.taskQueue.Get(endLevel + 1)
+	s.Require().False(ok)
+}
+
+func (s *ModuleTestSuite) TestModule_SyncStopsIfEndLevelIsIndexed() {
+	s.InitApi(nil)
+
+	cfg := cfgDefault
+	cfg.EndLevel = 5
+	receiverModule := s.createModuleEmptyState(&cfg)
+	receiverModule.setLevel(5, nil)
+
+	stopReader := modules.New("stop-reader")
+	stopReader.CreateInput(stopper.InputName)
+	err := stopReader.AttachTo(&receiverModule, StopOutput, stopper.InputName)
+	s.Require().NoError(err)
+
+	ctx, cancelCtx := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelCtx()
+
+	go receiverModule.sync(ctx)
+
+	select {
+	case <-ctx.Done():
+		s.FailNow("timeout")
+	case <-stopReader.MustInput(stopper.InputName).Listen():
+	}
+}
+
+func (s *ModuleTestSuite) TestModule_BlocksContextIsReusedUntilCanceled() {
+	receiverModule := s.createModule()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	blocksCtx := receiverModule.blocksContext(ctx, nil)
+	s.Require().NoError(blocksCtx.Err())
+	s.Require().Equal(blocksCtx, receiverModule.blocksContext(ctx, blocksCtx))
+
+	// rollback cancels reading of blocks
+	receiverModule.cancelReadBlocks()
+	s.Require().ErrorIs(blocksCtx.Err(), context.Canceled)
+
+	newBlocksCtx := receiverModule.blocksContext(ctx, blocksCtx)
+	s.Require().NotEqual(blocksCtx, newBlocksCtx)
+	s.Require().NoError(newBlocksCtx.Err())
+}
@@ -5,45 +5,82 @@ package receiver
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/metrics"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
 	"github.com/pkg/errors"
 )
 
-func (r *Module) worker(ctx context.Context, level types.Level) {
-	defer r.taskQueue.Delete(level)
+// levelRange - range of levels [from, to] which is received by worker in one request
+type levelRange struct {
+	from types.Level
+	to   types.Level
+}
+
+func (lr levelRange) String() string {
+	if lr.from == lr.to {
+		return fmt.Sprintf("height %d", lr.from)
+	}
+	return fmt.Sprintf("heights %d-%d", lr.from, lr.to)
+}
+
+func (r *Module) worker(ctx context.Context, task levelRange) {
+	defer func() {
+		for level := task.from; level <= task.to; level++ {
+			r.taskQueue.Delete(level)
+		}
+	}()
 
 	start := time.Now()
 
-	var result types.BlockData
-	for {
-		select {
-		case <-ctx.Done():
+	var result []types.BlockData
+	for attempt := uint32(0); ; attempt++ {
+		blocks, err := r.receive(ctx, task)
+		if err == nil {
+			result = blocks
+			break
+		}
+		if ctx.Err() != nil {
 			return
-		default:
 		}
 
-		block, err := r.api.BlockData(ctx, level)
-		if err != nil {
-			if errors.Is(err, context.Canceled) {
-				return
-			}
-			r.Log.Err(err).
-				Uint64("height", uint64(level)).
-				Msg("while getting block data")
-
-			time.Sleep(time.Second)
-			continue
+		logEvent := r.Log.Err(err).
+			Uint64("from", uint64(task.from)).
+			Uint64("to", uint64(task.to)).
+			Uint32("attempt", attempt+1)
+
+		if isPermanent(err) || attempt+1 >= r.retry.count {
+			logEvent.Msg("can't receive block data, stopping")
+			r.stop(errors.Wrapf(err, "receiving block data at %s", task))
+			return
 		}
 
-		result = block
-		break
+		logEvent.Msg("while getting block data")
+		if !r.retry.wait(ctx, attempt) {
+			return
+		}
 	}
 
-	r.Log.Info().
-		Uint64("height", uint64(result.Height)).
-		Int64("ms", time.Since(start).Milliseconds()).
-		Msg("received block")
-	r.blocks <- result
+	r.metrics.Processed(metrics.StageReceived, len(result), time.Since(start))
+
+	for i := range result {
+		r.Log.Info().
+			Uint64("height", uint64(result[i].Height)).
+			Int64("ms", time.Since(start).Milliseconds()).
+			Msg("received block")
+		r.blocks <- result[i]
+	}
+}
+
+func (r *Module) receive(ctx context.Context, task levelRange) ([]types.BlockData, error) {
+	if task.from == task.to {
+		block, err := r.api.BlockData(ctx, task.from)
+		if err != nil {
+			return nil, err
+		}
+		return []types.BlockData{block}, nil
+	}
+	return r.api.BlockDataRange(ctx, task.from, task.to)
 }
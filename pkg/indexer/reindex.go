@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package indexer
+
+import (
+	"bytes"
+	"context"
+
+	internalStorage "github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/postgres"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/parser"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/rollback"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/storage"
+	"github.com/dipdup-io/celestia-indexer/pkg/node"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Reindex - removes all stored blocks from the last indexed one down to `from` and indexes them again with the current decoder.
+// Rollback data is stored as snapshots of previous state (validator power and status, grant end heights, proposal status and etc.),
+// so it can be reverted from the tail only. That's why reindexing cost depends on the distance between `from` and the last indexed block
+// and the whole span is rebuilt: data and state totals of all blocks above `from` may change. Indexer must be stopped during reindexing.
+func Reindex(ctx context.Context, cfg config.Config, from types.Level) error {
+	if from == 0 {
+		return errors.New("reindexing from genesis requires full resync")
+	}
+
+	pg, err := postgres.Create(ctx, cfg.Database)
+	if err != nil {
+		return errors.Wrap(err, "while creating pg context")
+	}
+	defer func() {
+		if err := pg.Close(); err != nil {
+			log.Err(err).Msg("closing database connection")
+		}
+	}()
+
+	api, err := createApi(cfg)
+	if err != nil {
+		return errors.Wrap(err, "while creating node api")
+	}
+
+	return reindex(ctx, pg, api, cfg.Indexer, from)
+}
+
+func reindex(ctx context.Context, pg postgres.Storage, api node.Api, cfg config.Indexer, from types.Level) error {
+	state, err := loadState(pg, ctx, cfg.Name)
+	if err != nil {
+		return errors.Wrap(err, "while loading state")
+	}
+	if state == nil {
+		return errors.Errorf("indexer state %s is not found", cfg.Name)
+	}
+	if from > state.LastHeight {
+		return errors.Errorf("height %d is not indexed yet, last indexed height is %d", from, state.LastHeight)
+	}
+	if _, err := pg.Blocks.ByHeight(ctx, from-1); err != nil {
+		if pg.Blocks.IsNoRows(err) {
+			return errors.Errorf("block %d is not found: reindexing from the first indexed block requires full resync", from-1)
+		}
+		return errors.Wrapf(err, "receiving block %d", from-1)
+	}
+	last := state.LastHeight
+
+	log.Warn().
+		Uint64("from", uint64(from)).
+		Uint64("to", uint64(last)).
+		Uint64("count", uint64(last-from+1)).
+		Msg("blocks will be removed and indexed again")
+
+	rollbackModule := rollback.NewModule(pg.Transactable, pg.State, pg.Blocks, api, cfg)
+	newState, err := rollbackModule.RollbackTo(ctx, from)
+	if err != nil {
+		return errors.Wrap(err, "while rolling back")
+	}
+	log.Info().
+		Uint64("from", uint64(from)).
+		Uint64("to", uint64(last)).
+		Msg("blocks were rolled back")
+
+	parserModule := parser.NewModule(cfg)
+	storageModule := storage.NewModule(pg.Transactable, pg.Notificator, cfg)
+
+	batchSize := types.Level(cfg.FetchBatchSize)
+	if batchSize == 0 {
+		batchSize = 1
+	}
+
+	prevHash := []byte(newState.LastHash)
+	for level := from; level <= last; level += batchSize {
+		end := min(level+batchSize-1, last)
+
+		blocks, err := reindexRange(ctx, api, &parserModule, level, end, prevHash)
+		if err != nil {
+			return err
+		}
+		if err := storageModule.SaveBlocks(ctx, blocks); err != nil {
+			return errors.Wrapf(err, "saving blocks %d-%d", level, end)
+		}
+		prevHash = blocks[len(blocks)-1].Hash
+	}
+
+	log.Info().
+		Uint64("from", uint64(from)).
+		Uint64("to", uint64(last)).
+		Msg("reindexing is finished")
+	return nil
+}
+
+func reindexRange(ctx context.Context, api node.Api, parserModule *parser.Module, from, to types.Level, prevHash []byte) ([]internalStorage.Block, error) {
+	var data []types.BlockData
+	if from == to {
+		block, err := api.BlockData(ctx, from)
+		if err != nil {
+			return nil, errors.Wrapf(err, "receiving block %d", from)
+		}
+		data = []types.BlockData{block}
+	} else {
+		blocks, err := api.BlockDataRange(ctx, from, to)
+		if err != nil {
+			return nil, errors.Wrapf(err, "receiving blocks %d-%d", from, to)
+		}
+		data = blocks
+	}
+
+	blocks := make([]internalStorage.Block, len(data))
+	for i := range data {
+		block, err := parserModule.Parse(data[i])
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(block.ParentHash, prevHash) {
+			return nil, errors.Errorf("parent hash of block %d doesn't match stored chain: run indexer to resolve the fork", block.Height)
+		}
+		prevHash = block.Hash
+		blocks[i] = block
+	}
+	return blocks, nil
+}
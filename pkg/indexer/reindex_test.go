@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/postgres"
+	indexerCfg "github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/mock"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/config"
+	"github.com/dipdup-net/go-lib/database"
+	"github.com/go-testfixtures/testfixtures/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+const testIndexerName = "test_indexer"
+
+// ReindexTestSuite -
+type ReindexTestSuite struct {
+	suite.Suite
+	psqlContainer *database.PostgreSQLContainer
+	storage       postgres.Storage
+}
+
+// SetupSuite -
+func (s *ReindexTestSuite) SetupSuite() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer ctxCancel()
+
+	psqlContainer, err := database.NewPostgreSQLContainer(ctx, database.PostgreSQLContainerConfig{
+		User:     "user",
+		Password: "password",
+		Database: "db_test",
+		Port:     5432,
+		Image:    "timescale/timescaledb:latest-pg15",
+	})
+	s.Require().NoError(err)
+	s.psqlContainer = psqlContainer
+
+	st, err := postgres.Create(ctx, config.Database{
+		Kind:     config.DBKindPostgres,
+		User:     s.psqlContainer.Config.User,
+		Database: s.psqlContainer.Config.Database,
+		Password: s.psqlContainer.Config.Password,
+		Host:     s.psqlContainer.Config.Host,
+		Port:     s.psqlContainer.MappedPort().Int(),
+	})
+	s.Require().NoError(err)
+	s.storage = st
+
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("timescaledb"),
+		testfixtures.Directory("../../test/data/rollback"),
+		testfixtures.UseAlterConstraint(),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+	s.Require().NoError(db.Close())
+}
+
+// TearDownSuite -
+func (s *ReindexTestSuite) TearDownSuite() {
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer ctxCancel()
+
+	s.Require().NoError(s.storage.Close())
+	s.Require().NoError(s.psqlContainer.Terminate(ctx))
+}
+
+func (s *ReindexTestSuite) hash(value string) []byte {
+	hash, err := hex.DecodeString(value)
+	s.Require().NoError(err)
+	return hash
+}
+
+func blockData(height types.Level, blockTime time.Time, hash, parentHash []byte) types.BlockData {
+	var data types.BlockData
+	data.ResultBlock.BlockID.Hash = hash
+	data.ResultBlock.Block = &types.Block{}
+	data.ResultBlock.Block.Height = int64(height)
+	data.ResultBlock.Block.Time = blockTime
+	data.ResultBlock.Block.LastBlockID.Hash = parentHash
+	data.ResultBlockResults.Height = height
+	return data
+}
+
+// TestReindexRebuildsTail - blocks above `from` are removed and fetched again: stored data of block 1001 is replaced by the node's one
+func (s *ReindexTestSuite) TestReindexRebuildsTail() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	var (
+		hash999   = s.hash("5F7A8DDFE6136FE76B65B9066D4F816D707F28C05B3362D66084664C5B39BA98")
+		hash1000  = s.hash("6A30C94091DA7C436D64E62111D6890D772E351823C41496B4E52F28F5B000BF")
+		hash1001  = s.hash("AA30C94091DA7C436D64E62111D6890D772E351823C41496B4E52F28F5B000FF")
+		blockTime = time.Date(2023, 7, 4, 3, 10, 57, 0, time.UTC)
+	)
+
+	api := mock.NewMockApi(ctrl)
+	api.EXPECT().
+		BlockData(gomock.Any(), types.Level(1000)).
+		Return(blockData(1000, blockTime, hash1000, hash999), nil).
+		Times(1)
+	api.EXPECT().
+		BlockData(gomock.Any(), types.Level(1001)).
+		Return(blockData(1001, blockTime.Add(time.Second), hash1001, hash1000), nil).
+		Times(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err := reindex(ctx, s.storage, api, indexerCfg.Indexer{Name: testIndexerName}, 1000)
+	s.Require().NoError(err)
+
+	last, err := s.storage.Blocks.Last(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1001, last.Height)
+	s.Require().Equal(hash1001, []byte(last.Hash))
+	s.Require().EqualValues(0, last.MessageTypes.Bits, "block 1001 must be rebuilt from the node data")
+	s.Require().Equal(blockTime.Add(time.Second), last.Time.UTC())
+
+	block, err := s.storage.Blocks.ByHeight(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Equal(hash1000, []byte(block.Hash))
+
+	state, err := s.storage.State.ByName(ctx, testIndexerName)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1001, state.LastHeight)
+	s.Require().Equal(hash1001, state.LastHash)
+	s.Require().EqualValues(1, state.TotalTx)
+	s.Require().EqualValues(324234-100-900, state.TotalBlobsSize)
+	s.Require().EqualValues(1000-3, state.TotalNamespaces)
+}
+
+func TestSuiteReindex_Run(t *testing.T) {
+	suite.Run(t, new(ReindexTestSuite))
+}
@@ -19,144 +19,19 @@ import (
 var (
 	testAddress     = "celestia1jc92qdnty48pafummfr8ava2tjtuhfdw774w60"
 	testHashAddress = []byte{0x96, 0xa, 0xa0, 0x36, 0x6b, 0x25, 0x4e, 0x1e, 0xa7, 0x9b, 0xda, 0x46, 0x7e, 0xb3, 0xaa, 0x5c, 0x97, 0xcb, 0xa5, 0xae}
+	testIbcDenom    = "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9"
 )
 
-func Test_coinReceived(t *testing.T) {
-	tests := []struct {
-		name    string
-		data    map[string]any
-		want    *storage.Address
-		wantErr bool
-	}{
-		{
-			name: "test 1",
-			data: map[string]any{
-				"receiver": testAddress,
-				"amount":   "123utia",
-			},
-			want: &storage.Address{
-				Hash:    testHashAddress,
-				Address: testAddress,
-				Balance: storage.Balance{
-					Currency: "utia",
-					Total:    decimal.RequireFromString("-123"),
-				},
-			},
-		}, {
-			name: "test 2",
-			data: map[string]any{
-				"receiver": testAddress,
-				"amount":   nil,
-			},
-			want: &storage.Address{
-				Hash:    testHashAddress,
-				Address: testAddress,
-				Balance: storage.Balance{
-					Currency: "utia",
-					Total:    decimal.Zero,
-				},
-			},
-		}, {
-			name: "test 3",
-			data: map[string]any{
-				"receiver": "invalid",
-				"amount":   nil,
-			},
-			wantErr: true,
-		}, {
-			name:    "test 4",
-			data:    nil,
-			wantErr: true,
-		}, {
-			name: "test 5",
-			data: map[string]any{
-				"receiver": "",
-				"amount":   nil,
-			},
-			wantErr: true,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := coinReceived(tt.data)
-			require.Equal(t, tt.wantErr, err != nil)
-			if err == nil {
-				require.Equal(t, tt.want, got)
-			}
-		})
-	}
-}
-
-func Test_coinSpent(t *testing.T) {
-	tests := []struct {
-		name    string
-		data    map[string]any
-		want    *storage.Address
-		wantErr bool
-	}{
-		{
-			name: "test 1",
-			data: map[string]any{
-				"spender": testAddress,
-				"amount":  "123utia",
-			},
-			want: &storage.Address{
-				Hash:    testHashAddress,
-				Address: testAddress,
-				Balance: storage.Balance{
-					Currency: "utia",
-					Total:    decimal.RequireFromString("123"),
-				},
-			},
-		}, {
-			name: "test 2",
-			data: map[string]any{
-				"spender": testAddress,
-				"amount":  nil,
-			},
-			want: &storage.Address{
-				Hash:    testHashAddress,
-				Address: testAddress,
-				Balance: storage.Balance{
-					Currency: "utia",
-					Total:    decimal.Zero,
-				},
-			},
-		}, {
-			name: "test 3",
-			data: map[string]any{
-				"spender": "invalid",
-				"amount":  nil,
-			},
-			wantErr: true,
-		}, {
-			name:    "test 4",
-			data:    nil,
-			wantErr: true,
-		}, {
-			name: "test 5",
-			data: map[string]any{
-				"spender": "",
-				"amount":  nil,
-			},
-			wantErr: true,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := coinSpent(tt.data)
-			require.Equal(t, tt.wantErr, err != nil)
-			if err == nil {
-				require.Equal(t, tt.want, got)
-			}
-		})
+func Test_getBalanceUpdates(t *testing.T) {
+	address := &storage.Address{
+		Id:      1,
+		Address: testAddress,
+		Hash:    testHashAddress,
 	}
-}
 
-func Test_getBalanceUpdates(t *testing.T) {
 	type args struct {
-		deletedAddress map[string]struct{}
-		deletedEvents  []storage.Event
+		deletedAddress map[uint64]struct{}
+		balanceUpdates []storage.BalanceUpdate
 	}
 	tests := []struct {
 		name    string
@@ -167,57 +42,98 @@ func Test_getBalanceUpdates(t *testing.T) {
 		{
 			name: "test 1",
 			args: args{
-				deletedAddress: map[string]struct{}{},
-				deletedEvents: []storage.Event{
+				deletedAddress: map[uint64]struct{}{},
+				balanceUpdates: []storage.BalanceUpdate{
 					{
-						Type: types.EventTypeCoinSpent,
-						Data: map[string]any{
-							"spender": testAddress,
-							"amount":  "123utia",
-						},
+						AddressId: 1,
+						Currency:  consts.DefaultCurrency,
+						Delta:     decimal.RequireFromString("-123"),
+						EventType: types.EventTypeCoinSpent,
+						Address:   address,
 					}, {
-						Type: types.EventTypeCoinReceived,
-						Data: map[string]any{
-							"receiver": testAddress,
-							"amount":   "23utia",
-						},
+						AddressId: 1,
+						Currency:  consts.DefaultCurrency,
+						Delta:     decimal.RequireFromString("23"),
+						EventType: types.EventTypeCoinReceived,
+						Address:   address,
 					},
 				},
 			},
 			want: []*storage.Address{
 				{
+					Id:      1,
 					Address: testAddress,
 					Hash:    testHashAddress,
-					Balance: storage.Balance{
+					Balances: []storage.Balance{{
+						Id:       1,
 						Currency: consts.DefaultCurrency,
 						Total:    decimal.RequireFromString("100"),
-					},
+					}},
 					LastHeight: 100,
 				},
 			},
 		}, {
 			name: "test 2",
 			args: args{
-				deletedAddress: map[string]struct{}{
-					testAddress: {},
+				deletedAddress: map[uint64]struct{}{
+					1: {},
 				},
-				deletedEvents: []storage.Event{
+				balanceUpdates: []storage.BalanceUpdate{
 					{
-						Type: types.EventTypeCoinSpent,
-						Data: map[string]any{
-							"spender": testAddress,
-							"amount":  "123utia",
-						},
+						AddressId: 1,
+						Currency:  consts.DefaultCurrency,
+						Delta:     decimal.RequireFromString("-123"),
+						EventType: types.EventTypeCoinSpent,
+						Address:   address,
+					},
+				},
+			},
+			want: []*storage.Address{},
+		}, {
+			name: "test 3",
+			args: args{
+				deletedAddress: map[uint64]struct{}{},
+				balanceUpdates: []storage.BalanceUpdate{
+					{
+						AddressId: 1,
+						Currency:  consts.DefaultCurrency,
+						Delta:     decimal.RequireFromString("-123"),
+						EventType: types.EventTypeCoinSpent,
+						Address:   address,
 					}, {
-						Type: types.EventTypeCoinReceived,
-						Data: map[string]any{
-							"receiver": testAddress,
-							"amount":   "23utia",
+						AddressId: 1,
+						Currency:  testIbcDenom,
+						Delta:     decimal.RequireFromString("-10"),
+						EventType: types.EventTypeCoinSpent,
+						Address:   address,
+					}, {
+						AddressId: 1,
+						Currency:  testIbcDenom,
+						Delta:     decimal.RequireFromString("4"),
+						EventType: types.EventTypeCoinReceived,
+						Address:   address,
+					},
+				},
+			},
+			want: []*storage.Address{
+				{
+					Id:      1,
+					Address: testAddress,
+					Hash:    testHashAddress,
+					Balances: []storage.Balance{
+						{
+							Id:       1,
+							Currency: consts.DefaultCurrency,
+							Total:    decimal.RequireFromString("123"),
+						}, {
+							Id:       1,
+							Currency: testIbcDenom,
+							Total:    decimal.RequireFromString("6"),
 						},
 					},
+					LastHeight: 100,
 				},
 			},
-			want: []*storage.Address{},
 		},
 	}
 
@@ -231,7 +147,7 @@ func Test_getBalanceUpdates(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := getBalanceUpdates(context.Background(), tx, tt.args.deletedAddress, tt.args.deletedEvents)
+			got, err := getBalanceUpdates(context.Background(), tx, tt.args.deletedAddress, tt.args.balanceUpdates)
 			require.Equal(t, tt.wantErr, err != nil)
 			if err == nil {
 				require.Equal(t, tt.want, got)
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+func (module *Module) rollbackDelegations(ctx context.Context, tx storage.Transaction, height pkgTypes.Level) error {
+	logs, err := tx.RollbackDelegationLogs(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	for i := range logs {
+		if logs[i].UnbondingId == nil {
+			continue
+		}
+		if err := tx.RestoreUnbonding(ctx, *logs[i].UnbondingId, logs[i].Amount); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.RollbackUnbondings(ctx, height); err != nil {
+		return err
+	}
+	if err := tx.RollbackRedelegations(ctx, height); err != nil {
+		return err
+	}
+
+	return tx.SaveDelegations(ctx, revertDelegations(logs)...)
+}
+
+// revertDelegations - returns delegation changes which revert deleted delegation logs
+func revertDelegations(logs []storage.DelegationLog) []storage.Delegation {
+	type key struct {
+		addressId uint64
+		validator string
+	}
+
+	var (
+		delegations = make([]storage.Delegation, 0)
+		indices     = make(map[key]int)
+	)
+	for i := range logs {
+		k := key{logs[i].AddressId, logs[i].Validator}
+		if idx, ok := indices[k]; ok {
+			delegations[idx].Amount = delegations[idx].Amount.Sub(logs[i].Amount)
+			continue
+		}
+		indices[k] = len(delegations)
+		delegations = append(delegations, storage.Delegation{
+			AddressId: logs[i].AddressId,
+			Validator: logs[i].Validator,
+			Amount:    logs[i].Amount.Neg(),
+		})
+	}
+	return delegations
+}
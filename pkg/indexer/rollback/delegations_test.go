@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_revertDelegations(t *testing.T) {
+	var (
+		validator1 = "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x"
+		validator2 = "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y"
+	)
+
+	tests := []struct {
+		name string
+		logs []storage.DelegationLog
+		want []storage.Delegation
+	}{
+		{
+			name: "empty",
+			logs: nil,
+			want: []storage.Delegation{},
+		}, {
+			name: "delegate and redelegate",
+			logs: []storage.DelegationLog{
+				{
+					AddressId: 1,
+					Validator: validator1,
+					Amount:    decimal.RequireFromString("100"),
+				}, {
+					AddressId: 1,
+					Validator: validator1,
+					Amount:    decimal.RequireFromString("-40"),
+				}, {
+					AddressId: 1,
+					Validator: validator2,
+					Amount:    decimal.RequireFromString("40"),
+				}, {
+					AddressId: 2,
+					Validator: validator1,
+					Amount:    decimal.RequireFromString("-5"),
+				},
+			},
+			want: []storage.Delegation{
+				{
+					AddressId: 1,
+					Validator: validator1,
+					Amount:    decimal.RequireFromString("-60"),
+				}, {
+					AddressId: 1,
+					Validator: validator2,
+					Amount:    decimal.RequireFromString("-40"),
+				}, {
+					AddressId: 2,
+					Validator: validator1,
+					Amount:    decimal.RequireFromString("5"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := revertDelegations(tt.logs)
+			require.Len(t, got, len(tt.want))
+			for i := range tt.want {
+				require.Equal(t, tt.want[i].AddressId, got[i].AddressId)
+				require.Equal(t, tt.want[i].Validator, got[i].Validator)
+				require.Equal(t, tt.want[i].Amount.String(), got[i].Amount.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageMock "github.com/dipdup-io/celestia-indexer/internal/storage/mock"
+	indexerCfg "github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/mock"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newForkModule - creates module with stored chain of blocks starting from height 1 and node chain which differs above `forkPoint`
+func newForkModule(t *testing.T, forkPoint types.Level, maxDepth uint64) (*Module, *int) {
+	ctrl := gomock.NewController(t)
+	blocks := storageMock.NewMockIBlock(ctrl)
+	api := mock.NewMockApi(ctrl)
+
+	blocks.EXPECT().First(gomock.Any()).Return(storage.Block{Height: 1}, nil).AnyTimes()
+	blocks.EXPECT().ByHeight(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, height types.Level) (storage.Block, error) {
+			if height < 1 {
+				t.Errorf("block %d is not stored", height)
+			}
+			return storage.Block{
+				Height: height,
+				Hash:   types.Hex{byte(height), byte(height >> 8)},
+			}, nil
+		},
+	).AnyTimes()
+
+	var calls int
+	api.EXPECT().Block(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, height types.Level) (types.ResultBlock, error) {
+			calls++
+			hash := types.Hex{byte(height), byte(height >> 8)}
+			if height > forkPoint {
+				hash = append(hash, 0xff)
+			}
+			return GetResultBlock(hash), nil
+		},
+	).AnyTimes()
+
+	module := NewModule(nil, nil, blocks, api, indexerCfg.Indexer{
+		Name:             testIndexerName,
+		MaxRollbackDepth: maxDepth,
+	})
+	return &module, &calls
+}
+
+func TestModule_ForkPoint(t *testing.T) {
+	tests := []struct {
+		name      string
+		last      types.Level
+		forkPoint types.Level
+		maxCalls  int
+	}{
+		{name: "one block", last: 1000, forkPoint: 999, maxCalls: 1},
+		{name: "shallow", last: 1000, forkPoint: 995, maxCalls: 5},
+		{name: "linear depth", last: 1000, forkPoint: 992, maxCalls: 8},
+		{name: "deep", last: 1000, forkPoint: 937, maxCalls: 20},
+		{name: "max depth", last: 1000, forkPoint: 900, maxCalls: 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module, calls := newForkModule(t, tt.forkPoint, 100)
+
+			forkPoint, err := module.forkPoint(context.Background(), tt.last)
+			require.NoError(t, err)
+			require.Equal(t, tt.forkPoint, forkPoint)
+			require.LessOrEqual(t, *calls, tt.maxCalls)
+		})
+	}
+}
+
+func TestModule_ForkPointMaxDepthExceeded(t *testing.T) {
+	module, calls := newForkModule(t, 899, 100)
+
+	_, err := module.forkPoint(context.Background(), 1000)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrMaxDepthExceeded))
+	require.LessOrEqual(t, *calls, 20)
+}
+
+func TestModule_ForkPointBelowFirstBlock(t *testing.T) {
+	module, _ := newForkModule(t, 0, 100)
+
+	_, err := module.forkPoint(context.Background(), 50)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrMaxDepthExceeded))
+}
+
+func TestModule_ForkPointNearFirstBlock(t *testing.T) {
+	module, _ := newForkModule(t, 1, 100)
+
+	forkPoint, err := module.forkPoint(context.Background(), 50)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, forkPoint)
+}
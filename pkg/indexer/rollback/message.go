@@ -29,6 +29,9 @@ func (module *Module) rollbackMessages(ctx context.Context, tx storage.Transacti
 	if err := tx.RollbackMessageAddresses(ctx, ids); err != nil {
 		return 0, err
 	}
+	if err := tx.RollbackValidatorMessages(ctx, ids); err != nil {
+		return 0, err
+	}
 
 	nsMsgs, err := tx.RollbackNamespaceMessages(ctx, height)
 	if err != nil {
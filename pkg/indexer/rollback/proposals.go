@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+func (module *Module) rollbackProposals(ctx context.Context, tx storage.Transaction, height pkgTypes.Level) error {
+	deposits, err := tx.RollbackDeposits(ctx, height)
+	if err != nil {
+		return err
+	}
+	if err := tx.UpdateProposals(ctx, revertDeposits(deposits)...); err != nil {
+		return err
+	}
+	if err := tx.RollbackVotes(ctx, height); err != nil {
+		return err
+	}
+	return tx.RollbackProposals(ctx, height)
+}
+
+// revertDeposits - returns proposal changes which revert total deposits of proposals by deleted deposits
+func revertDeposits(deposits []storage.Deposit) []*storage.Proposal {
+	var (
+		proposals = make([]*storage.Proposal, 0)
+		indices   = make(map[uint64]int)
+	)
+	for i := range deposits {
+		if idx, ok := indices[deposits[i].ProposalId]; ok {
+			proposals[idx].Deposit = proposals[idx].Deposit.Sub(deposits[i].Amount)
+			continue
+		}
+		indices[deposits[i].ProposalId] = len(proposals)
+		proposals = append(proposals, &storage.Proposal{
+			Id:      deposits[i].ProposalId,
+			Deposit: deposits[i].Amount.Neg(),
+		})
+	}
+	return proposals
+}
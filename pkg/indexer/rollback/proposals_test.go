@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_revertDeposits(t *testing.T) {
+	tests := []struct {
+		name     string
+		deposits []storage.Deposit
+		want     []storage.Proposal
+	}{
+		{
+			name:     "empty",
+			deposits: nil,
+			want:     []storage.Proposal{},
+		}, {
+			name: "several proposals",
+			deposits: []storage.Deposit{
+				{
+					ProposalId: 1,
+					Amount:     decimal.RequireFromString("100"),
+				}, {
+					ProposalId: 2,
+					Amount:     decimal.RequireFromString("30"),
+				}, {
+					ProposalId: 1,
+					Amount:     decimal.RequireFromString("50"),
+				},
+			},
+			want: []storage.Proposal{
+				{
+					Id:      1,
+					Deposit: decimal.RequireFromString("-150"),
+				}, {
+					Id:      2,
+					Deposit: decimal.RequireFromString("-30"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := revertDeposits(tt.deposits)
+			require.Len(t, got, len(tt.want))
+			for i := range tt.want {
+				require.Equal(t, tt.want[i].Id, got[i].Id)
+				require.Equal(t, tt.want[i].Deposit.String(), got[i].Deposit.String())
+				require.Empty(t, got[i].Status)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/dipdup-io/celestia-indexer/pkg/node"
 
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/metrics"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
 
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
@@ -20,6 +21,15 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	defaultMaxDepth   = 100
+	linearSearchDepth = 8
+	rollbackBatchSize = 100
+)
+
+// ErrMaxDepthExceeded - fork is deeper than configured maximum rollback depth. Indexer is stopped in this case.
+var ErrMaxDepthExceeded = errors.New("max rollback depth exceeded")
+
 const (
 	InputName  = "signal"
 	OutputName = "state"
@@ -40,6 +50,8 @@ type Module struct {
 	blocks    storage.IBlock
 	node      node.Api
 	indexName string
+	maxDepth  types.Level
+	metrics   *metrics.Metrics
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -58,6 +70,10 @@ func NewModule(
 		blocks:     blocks,
 		node:       node,
 		indexName:  cfg.Name,
+		maxDepth:   types.Level(cfg.MaxRollbackDepth),
+	}
+	if module.maxDepth == 0 {
+		module.maxDepth = defaultMaxDepth
 	}
 
 	module.CreateInput(InputName)
@@ -67,6 +83,12 @@ func NewModule(
 	return module
 }
 
+// WithMetrics - sets metrics collector
+func (module *Module) WithMetrics(m *metrics.Metrics) *Module {
+	module.metrics = m
+	return module
+}
+
 // Start -
 func (module *Module) Start(ctx context.Context) {
 	module.G.GoCtx(ctx, module.listen)
@@ -89,6 +111,10 @@ func (module *Module) listen(ctx context.Context) {
 
 			if err := module.rollback(ctx); err != nil {
 				module.Log.Err(err).Msgf("error occurred")
+				if errors.Is(err, ErrMaxDepthExceeded) {
+					module.MustOutput(StopOutput).Push(err)
+					return
+				}
 			}
 		}
 	}
@@ -102,41 +128,165 @@ func (module *Module) Close() error {
 }
 
 func (module *Module) rollback(ctx context.Context) error {
-	for {
-		select {
-		case <-ctx.Done():
-		default:
-			lastBlock, err := module.blocks.Last(ctx)
-			if err != nil {
-				return errors.Wrap(err, "receive last block from database")
-			}
+	lastBlock, err := module.blocks.Last(ctx)
+	if err != nil {
+		return errors.Wrap(err, "receive last block from database")
+	}
 
-			nodeBlock, err := module.node.Block(ctx, lastBlock.Height)
-			if err != nil {
-				return errors.Wrapf(err, "receive block from node by height: %d", lastBlock.Height)
-			}
+	equal, err := module.compareHash(ctx, lastBlock)
+	if err != nil {
+		return err
+	}
+	if equal {
+		return module.finish(ctx)
+	}
 
-			log.Debug().
-				Uint64("height", uint64(lastBlock.Height)).
-				Hex("db_block_hash", lastBlock.Hash).
-				Hex("node_block_hash", nodeBlock.BlockID.Hash).
-				Msg("comparing hash...")
+	forkPoint, err := module.forkPoint(ctx, lastBlock.Height)
+	if err != nil {
+		return err
+	}
 
-			if bytes.Equal(lastBlock.Hash, nodeBlock.BlockID.Hash) {
-				return module.finish(ctx)
-			}
+	log.Warn().
+		Uint64("fork_point", uint64(forkPoint)).
+		Uint64("last_height", uint64(lastBlock.Height)).
+		Msg("need rollback")
+
+	if err := module.rollbackRange(ctx, forkPoint+1, lastBlock.Height); err != nil {
+		return err
+	}
+	module.metrics.Rollback(int(lastBlock.Height - forkPoint))
+	return module.finish(ctx)
+}
+
+func (module *Module) compareHash(ctx context.Context, block storage.Block) (bool, error) {
+	nodeBlock, err := module.node.Block(ctx, block.Height)
+	if err != nil {
+		return false, errors.Wrapf(err, "receive block from node by height: %d", block.Height)
+	}
+
+	log.Debug().
+		Uint64("height", uint64(block.Height)).
+		Hex("db_block_hash", block.Hash).
+		Hex("node_block_hash", nodeBlock.BlockID.Hash).
+		Msg("comparing hash...")
+
+	return bytes.Equal(block.Hash, nodeBlock.BlockID.Hash), nil
+}
+
+func (module *Module) matchesNode(ctx context.Context, height types.Level) (bool, error) {
+	block, err := module.blocks.ByHeight(ctx, height)
+	if err != nil {
+		if module.blocks.IsNoRows(err) {
+			return false, errors.Errorf("fork point is not found: block %d is not stored", height)
+		}
+		return false, errors.Wrapf(err, "receive block from database by height: %d", height)
+	}
+	return module.compareHash(ctx, block)
+}
+
+// forkPoint - returns the highest stored height which block hash is equal to the node's one. Stored block at `last` differs from the node's one.
+// Heights are checked one by one for shallow forks, then with doubling step until matched block is found.
+// The fork point is specified by binary search between the last matched and the first differing heights.
+// Search never goes below the first indexed block.
+func (module *Module) forkPoint(ctx context.Context, last types.Level) (types.Level, error) {
+	first, err := module.blocks.First(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "receive first block from database")
+	}
 
-			log.Warn().
-				Uint64("height", uint64(lastBlock.Height)).
-				Hex("db_block_hash", lastBlock.Hash).
-				Hex("node_block_hash", nodeBlock.BlockID.Hash).
-				Msg("need rollback")
+	floor := first.Height
+	if last > module.maxDepth && last-module.maxDepth > floor {
+		floor = last - module.maxDepth
+	}
 
-			if err := module.rollbackBlock(ctx, lastBlock.Height); err != nil {
-				return errors.Wrapf(err, "rollback block: %d", lastBlock.Height)
+	var (
+		differs = last
+		matched types.Level
+		step    = types.Level(1)
+	)
+	for i := 0; ; i++ {
+		if differs <= floor {
+			if floor == first.Height {
+				return 0, errors.Wrapf(ErrMaxDepthExceeded, "fork point is below the first indexed block %d", first.Height)
 			}
+			return 0, errors.Wrapf(ErrMaxDepthExceeded, "fork point is deeper than %d blocks from height %d", module.maxDepth, last)
+		}
+
+		height := floor
+		if differs-floor > step {
+			height = differs - step
+		}
+
+		ok, err := module.matchesNode(ctx, height)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			matched = height
+			break
+		}
+		differs = height
+
+		if i >= linearSearchDepth {
+			step *= 2
 		}
 	}
+
+	for differs-matched > 1 {
+		middle := matched + (differs-matched)/2
+		ok, err := module.matchesNode(ctx, middle)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			matched = middle
+		} else {
+			differs = middle
+		}
+	}
+
+	return matched, nil
+}
+
+// RollbackTo - removes stored blocks from the last one down to `height` inclusively and returns the new state
+func (module *Module) RollbackTo(ctx context.Context, height types.Level) (storage.State, error) {
+	lastBlock, err := module.blocks.Last(ctx)
+	if err != nil {
+		return storage.State{}, errors.Wrap(err, "receive last block from database")
+	}
+	if lastBlock.Height >= height {
+		if err := module.rollbackRange(ctx, height, lastBlock.Height); err != nil {
+			return storage.State{}, err
+		}
+	}
+	return module.state.ByName(ctx, module.indexName)
+}
+
+// rollbackRange - removes blocks from `to` down to `from` inclusively. Blocks are removed in batches: one transaction per batch.
+func (module *Module) rollbackRange(ctx context.Context, from, to types.Level) error {
+	for to >= from {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batchFrom := from
+		if to-from >= rollbackBatchSize {
+			batchFrom = to - rollbackBatchSize + 1
+		}
+		if err := module.rollbackBlocks(ctx, batchFrom, to); err != nil {
+			return errors.Wrapf(err, "rollback blocks: %d-%d", batchFrom, to)
+		}
+		log.Info().
+			Uint64("from", uint64(batchFrom)).
+			Uint64("to", uint64(to)).
+			Msg("blocks were rolled back")
+
+		if batchFrom == 0 {
+			break
+		}
+		to = batchFrom - 1
+	}
+	return nil
 }
 
 func (module *Module) finish(ctx context.Context) error {
@@ -153,72 +303,117 @@ func (module *Module) finish(ctx context.Context) error {
 	return nil
 }
 
-func (module *Module) rollbackBlock(ctx context.Context, height types.Level) error {
+func (module *Module) rollbackBlocks(ctx context.Context, from, to types.Level) error {
 	tx, err := postgres.BeginTransaction(ctx, module.tx)
 	if err != nil {
 		return err
 	}
 	defer tx.Close(ctx)
 
-	if err := tx.RollbackBlock(ctx, height); err != nil {
+	state, err := tx.State(ctx, module.indexName)
+	if err != nil {
 		return tx.HandleError(ctx, err)
 	}
-	blockStats, err := tx.RollbackBlockStats(ctx, height)
+
+	for height := to; height >= from; height-- {
+		if err := module.rollbackBlock(ctx, tx, height, &state); err != nil {
+			return tx.HandleError(ctx, err)
+		}
+		if height == 0 {
+			break
+		}
+	}
+
+	newBlock, err := tx.LastBlock(ctx)
 	if err != nil {
 		return tx.HandleError(ctx, err)
 	}
+	state.LastHeight = newBlock.Height
+	state.LastHash = newBlock.Hash
+	state.LastTime = newBlock.Time
+
+	if err := tx.Update(ctx, &state); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	if err := tx.Flush(ctx); err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	return nil
+}
+
+func (module *Module) rollbackBlock(ctx context.Context, tx storage.Transaction, height types.Level, state *storage.State) error {
+	if err := tx.RollbackBlock(ctx, height); err != nil {
+		return err
+	}
+	blockStats, err := tx.RollbackBlockStats(ctx, height)
+	if err != nil {
+		return err
+	}
 	addresses, err := tx.RollbackAddresses(ctx, height)
 	if err != nil {
-		return tx.HandleError(ctx, err)
+		return err
 	}
 
 	if err := module.rollbackTransactions(ctx, tx, height); err != nil {
-		return tx.HandleError(ctx, err)
+		return err
 	}
 
 	totalNamespaces, err := module.rollbackMessages(ctx, tx, height)
 	if err != nil {
-		return tx.HandleError(ctx, err)
+		return err
 	}
 
-	events, err := tx.RollbackEvents(ctx, height)
-	if err != nil {
-		return tx.HandleError(ctx, err)
+	if _, err := tx.RollbackEvents(ctx, height); err != nil {
+		return err
 	}
 
-	if err := module.rollbackBalances(ctx, tx, events, addresses); err != nil {
-		return tx.HandleError(ctx, err)
+	if err := module.rollbackBalances(ctx, tx, height, addresses); err != nil {
+		return err
+	}
+
+	if err := module.rollbackValidatorHistory(ctx, tx, height); err != nil {
+		return err
+	}
+
+	if err := tx.RollbackBlockSignatures(ctx, height); err != nil {
+		return err
+	}
+
+	if err := tx.RollbackEvidence(ctx, height); err != nil {
+		return err
 	}
 
 	if err := tx.RollbackValidators(ctx, height); err != nil {
-		return tx.HandleError(ctx, err)
+		return err
 	}
 
-	newBlock, err := tx.LastBlock(ctx)
-	if err != nil {
-		return tx.HandleError(ctx, err)
+	if err := module.rollbackDelegations(ctx, tx, height); err != nil {
+		return err
 	}
-	state, err := tx.State(ctx, module.indexName)
-	if err != nil {
-		return tx.HandleError(ctx, err)
+
+	if err := module.rollbackProposals(ctx, tx, height); err != nil {
+		return err
 	}
-	state.LastHeight = newBlock.Height
-	state.LastHash = newBlock.Hash
-	state.LastTime = newBlock.Time
+
+	if err := tx.RollbackGrants(ctx, height); err != nil {
+		return err
+	}
+
+	if err := tx.RollbackFeeAllowances(ctx, height); err != nil {
+		return err
+	}
+
+	if err := tx.RollbackIbcTransfers(ctx, height); err != nil {
+		return err
+	}
+
 	state.TotalTx -= blockStats.TxCount
 	state.TotalBlobsSize -= blockStats.BlobsSize
 	state.TotalNamespaces -= totalNamespaces
 	state.TotalAccounts -= int64(len(addresses))
 	state.TotalFee = state.TotalFee.Sub(blockStats.Fee)
 	state.TotalSupply = state.TotalSupply.Sub(blockStats.SupplyChange)
-
-	if err := tx.Update(ctx, &state); err != nil {
-		return tx.HandleError(ctx, err)
-	}
-
-	if err := tx.Flush(ctx); err != nil {
-		return tx.HandleError(ctx, err)
-	}
-
 	return nil
 }
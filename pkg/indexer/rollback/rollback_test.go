@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
 	indexerCfg "github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
 	"github.com/dipdup-io/celestia-indexer/pkg/node/mock"
 	"github.com/dipdup-io/celestia-indexer/pkg/types"
@@ -183,6 +184,94 @@ func (s *ModuleTestSuite) TestModule_SuccessOnRollbackTwoBlocks() {
 	}
 }
 
+func (s *ModuleTestSuite) TestModule_RollbackTo() {
+	s.InitDb("../../../test/data/rollback")
+
+	expectedHash, err := hex.DecodeString("5F7A8DDFE6136FE76B65B9066D4F816D707F28C05B3362D66084664C5B39BA98")
+	s.Require().NoError(err)
+	s.InitApi(func() {
+		s.api.EXPECT().
+			Block(gomock.Any(), gomock.Any()).
+			Times(0)
+	})
+
+	rollbackModule := NewModule(
+		s.storage.Transactable,
+		s.storage.State,
+		s.storage.Blocks,
+		s.api,
+		indexerCfg.Indexer{Name: testIndexerName},
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	state, err := rollbackModule.RollbackTo(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Level(999), state.LastHeight)
+	s.Require().Equal(expectedHash, []byte(state.LastHash))
+	s.Require().Equal(int64(1), state.TotalTx)
+	s.Require().Equal(int64(1000-3), state.TotalNamespaces)
+	s.Require().Equal(int64(12512357-1), state.TotalAccounts)
+
+	last, err := s.storage.Blocks.Last(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.Level(999), last.Height)
+
+	validator, err := s.storage.Validator.GetByID(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(100, validator.Power)
+	s.Require().Equal(storageTypes.ValidatorStatusBonded, validator.Status)
+
+	history, err := s.storage.Validator.History(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().EqualValues(999, history[0].Height)
+
+	levels, err := s.storage.Signatures.LevelsByValidator(ctx, 1, 0)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Level{998}, levels)
+
+	evidence, err := s.storage.Evidence.ByHeight(ctx, 1000)
+	s.Require().NoError(err)
+	s.Require().Len(evidence, 0)
+
+	proposal, err := s.storage.Proposal.ById(ctx, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(storageTypes.ProposalStatusDepositPeriod, proposal.Status)
+	s.Require().Equal("100", proposal.Deposit.String())
+	s.Require().EqualValues(0, proposal.VotingStartHeight)
+
+	_, err = s.storage.Proposal.ById(ctx, 2)
+	s.Require().Error(err)
+
+	votes, err := s.storage.Vote.ByProposal(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(votes, 0)
+
+	grants, err := s.storage.Grant.ByAddress(ctx, 1, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(grants, 2)
+	s.Require().EqualValues(3, grants[0].Id)
+	s.Require().EqualValues(0, grants[0].EndHeight)
+	s.Require().EqualValues(1, grants[1].Id)
+	s.Require().EqualValues(0, grants[1].EndHeight)
+
+	allowances, err := s.storage.FeeAllowance.ByAddress(ctx, 2, 10, 0)
+	s.Require().NoError(err)
+	s.Require().Len(allowances, 1)
+	s.Require().EqualValues(1, allowances[0].Id)
+	s.Require().Equal("1000", allowances[0].SpendLimit.String())
+	s.Require().Equal("0", allowances[0].Spent.String())
+
+	transfers, err := s.storage.IbcTransfer.Filter(ctx, storage.IbcTransferFilter{Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(transfers, 1)
+	s.Require().EqualValues(1, transfers[0].Id)
+	s.Require().Equal(storageTypes.IbcTransferStatusPending, transfers[0].Status)
+	s.Require().EqualValues(0, transfers[0].ResolvedHeight)
+}
+
 func (s *ModuleTestSuite) TestModule_OnClosedInput() {
 	s.InitDb("../../../test/data/rollback")
 
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+func (module *Module) rollbackValidatorHistory(ctx context.Context, tx storage.Transaction, height pkgTypes.Level) error {
+	history, err := tx.RollbackValidatorHistory(ctx, height)
+	if err != nil {
+		return err
+	}
+	return tx.UpdateValidators(ctx, revertValidators(history)...)
+}
+
+// revertValidators - returns validators with power and status which were before the first deleted history entry
+func revertValidators(history []storage.ValidatorHistory) []*storage.Validator {
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Id < history[j].Id
+	})
+
+	var (
+		validators = make([]*storage.Validator, 0)
+		reverted   = make(map[uint64]struct{})
+	)
+	for i := range history {
+		if _, ok := reverted[history[i].ValidatorId]; ok {
+			continue
+		}
+		reverted[history[i].ValidatorId] = struct{}{}
+		validators = append(validators, &storage.Validator{
+			Id:     history[i].ValidatorId,
+			Power:  history[i].PrevPower,
+			Status: history[i].PrevStatus,
+		})
+	}
+	return validators
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rollback
+
+import (
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_revertValidators(t *testing.T) {
+	tests := []struct {
+		name    string
+		history []storage.ValidatorHistory
+		want    []*storage.Validator
+	}{
+		{
+			name:    "empty",
+			history: nil,
+			want:    []*storage.Validator{},
+		}, {
+			name: "slash and power change",
+			history: []storage.ValidatorHistory{
+				{
+					Id:          3,
+					ValidatorId: 1,
+					Type:        types.ValidatorHistoryTypePowerChange,
+					Power:       0,
+					PrevPower:   90,
+					Status:      types.ValidatorStatusJailed,
+					PrevStatus:  types.ValidatorStatusJailed,
+				}, {
+					Id:          1,
+					ValidatorId: 1,
+					Type:        types.ValidatorHistoryTypeSlash,
+					Power:       90,
+					PrevPower:   100,
+					Status:      types.ValidatorStatusBonded,
+					PrevStatus:  types.ValidatorStatusBonded,
+				}, {
+					Id:          2,
+					ValidatorId: 1,
+					Type:        types.ValidatorHistoryTypeJail,
+					Power:       90,
+					PrevPower:   90,
+					Status:      types.ValidatorStatusJailed,
+					PrevStatus:  types.ValidatorStatusBonded,
+				}, {
+					Id:          4,
+					ValidatorId: 2,
+					Type:        types.ValidatorHistoryTypePowerChange,
+					Power:       10,
+					PrevPower:   0,
+					Status:      types.ValidatorStatusBonded,
+					PrevStatus:  types.ValidatorStatusUnbonded,
+				},
+			},
+			want: []*storage.Validator{
+				{
+					Id:     1,
+					Power:  100,
+					Status: types.ValidatorStatusBonded,
+				}, {
+					Id:     2,
+					Power:  0,
+					Status: types.ValidatorStatusUnbonded,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := revertValidators(tt.history)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -32,8 +32,10 @@ func saveAddresses(
 	balances := make([]storage.Balance, 0)
 	for i := range data {
 		addToId[data[i].Address] = data[i].Id
-		data[i].Balance.Id = data[i].Id
-		balances = append(balances, data[i].Balance)
+		for j := range data[i].Balances {
+			data[i].Balances[j].Id = data[i].Id
+			balances = append(balances, data[i].Balances[j])
+		}
 	}
 	err = tx.SaveBalances(ctx, balances...)
 	return addToId, totalAccounts, err
@@ -62,3 +64,38 @@ func saveSigners(
 	}
 	return tx.SaveSigners(ctx, txAddresses...)
 }
+
+func saveBalanceUpdates(
+	ctx context.Context,
+	tx storage.Transaction,
+	addrToId map[string]uint64,
+	block *storage.Block,
+) error {
+	updates := make([]storage.BalanceUpdate, 0, len(block.BalanceUpdates))
+	for i := range block.BalanceUpdates {
+		if update, ok := balanceUpdate(block.BalanceUpdates[i], addrToId, nil); ok {
+			updates = append(updates, update)
+		}
+	}
+	for i := range block.Txs {
+		for j := range block.Txs[i].BalanceUpdates {
+			if update, ok := balanceUpdate(block.Txs[i].BalanceUpdates[j], addrToId, &block.Txs[i].Id); ok {
+				updates = append(updates, update)
+			}
+		}
+	}
+	return tx.SaveBalanceUpdates(ctx, updates...)
+}
+
+func balanceUpdate(update storage.BalanceUpdate, addrToId map[string]uint64, txId *uint64) (storage.BalanceUpdate, bool) {
+	if update.Address == nil {
+		return update, false
+	}
+	id, ok := addrToId[update.Address.Address]
+	if !ok {
+		return update, false
+	}
+	update.AddressId = id
+	update.TxId = txId
+	return update, true
+}
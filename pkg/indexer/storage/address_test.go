@@ -100,10 +100,10 @@ func Test_saveAddresses(t *testing.T) {
 					Address:    "address1",
 					Height:     100,
 					LastHeight: 100,
-					Balance: storage.Balance{
+					Balances: []storage.Balance{{
 						Currency: "utia",
 						Total:    decimal.RequireFromString("1"),
-					},
+					}},
 				},
 			},
 			addr: map[string]uint64{
@@ -118,10 +118,10 @@ func Test_saveAddresses(t *testing.T) {
 					Address:    "address1",
 					Height:     100,
 					LastHeight: 101,
-					Balance: storage.Balance{
+					Balances: []storage.Balance{{
 						Currency: "utia",
 						Total:    decimal.RequireFromString("1"),
-					},
+					}},
 				},
 			},
 			addr: map[string]uint64{
@@ -172,3 +172,61 @@ func Test_saveAddresses(t *testing.T) {
 		})
 	}
 }
+
+func Test_saveBalanceUpdates(t *testing.T) {
+	txId := uint64(10)
+	block := &storage.Block{
+		BalanceUpdates: []storage.BalanceUpdate{
+			{
+				Currency: "utia",
+				Delta:    decimal.RequireFromString("1"),
+				Address:  &storage.Address{Address: "address1"},
+			}, {
+				Currency: "utia",
+				Delta:    decimal.RequireFromString("2"),
+				Address:  &storage.Address{Address: "unknown"},
+			},
+		},
+		Txs: []storage.Tx{
+			{
+				Id: txId,
+				BalanceUpdates: []storage.BalanceUpdate{
+					{
+						Currency:      "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9",
+						Delta:         decimal.RequireFromString("-3"),
+						EventPosition: 4,
+						Address:       &storage.Address{Address: "address2"},
+					},
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	tx.EXPECT().
+		SaveBalanceUpdates(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, updates ...storage.BalanceUpdate) error {
+			require.Len(t, updates, 2)
+
+			require.EqualValues(t, 1, updates[0].AddressId)
+			require.Nil(t, updates[0].TxId)
+			require.Equal(t, "1", updates[0].Delta.String())
+
+			require.EqualValues(t, 2, updates[1].AddressId)
+			require.NotNil(t, updates[1].TxId)
+			require.Equal(t, txId, *updates[1].TxId)
+			require.Equal(t, "-3", updates[1].Delta.String())
+			require.EqualValues(t, 4, updates[1].EventPosition)
+			return nil
+		})
+
+	err := saveBalanceUpdates(context.Background(), tx, map[string]uint64{
+		"address1": 1,
+		"address2": 2,
+	}, block)
+	require.NoError(t, err)
+}
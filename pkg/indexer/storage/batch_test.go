@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModule_collectMore(t *testing.T) {
+	old := storage.Block{Time: time.Now().Add(-time.Hour)}
+	fresh := storage.Block{Time: time.Now()}
+
+	tests := []struct {
+		name     string
+		cfg      config.Batch
+		endLevel int64
+		batch    []storage.Block
+		want     bool
+	}{
+		{
+			name:  "batching is disabled",
+			cfg:   config.Batch{},
+			batch: []storage.Block{old},
+			want:  false,
+		}, {
+			name:  "catching up",
+			cfg:   config.Batch{Size: 3},
+			batch: []storage.Block{old, old},
+			want:  true,
+		}, {
+			name:  "batch is full",
+			cfg:   config.Batch{Size: 3},
+			batch: []storage.Block{old, old, old},
+			want:  false,
+		}, {
+			name:  "near head",
+			cfg:   config.Batch{Size: 3},
+			batch: []storage.Block{old, fresh},
+			want:  false,
+		}, {
+			name:  "custom head lag",
+			cfg:   config.Batch{Size: 3, HeadLag: 7200},
+			batch: []storage.Block{old},
+			want:  false,
+		}, {
+			name:     "end level",
+			cfg:      config.Batch{Size: 3},
+			endLevel: 10,
+			batch:    []storage.Block{{Height: 9, Time: old.Time}, {Height: 10, Time: old.Time}},
+			want:     false,
+		}, {
+			name:     "before end level",
+			cfg:      config.Batch{Size: 3},
+			endLevel: 10,
+			batch:    []storage.Block{{Height: 8, Time: old.Time}, {Height: 9, Time: old.Time}},
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			module := NewModule(nil, nil, config.Indexer{Batch: tt.cfg, EndLevel: tt.endLevel})
+			require.Equal(t, tt.want, module.collectMore(tt.batch))
+		})
+	}
+}
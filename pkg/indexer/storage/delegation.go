@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/pkg/errors"
+)
+
+type delegationKey struct {
+	addressId uint64
+	validator string
+}
+
+func saveDelegations(
+	ctx context.Context,
+	tx storage.Transaction,
+	messages []*storage.Message,
+	addrToId map[string]uint64,
+) error {
+	var (
+		unbondings    = make([]*storage.Unbonding, 0)
+		redelegations = make([]*storage.Redelegation, 0)
+	)
+
+	for i := range messages {
+		if messages[i].Unbonding != nil {
+			addressId, err := delegatorId(addrToId, messages[i].Unbonding.Address)
+			if err != nil {
+				return err
+			}
+			messages[i].Unbonding.AddressId = addressId
+			messages[i].Unbonding.Time = messages[i].Time
+			messages[i].Unbonding.TxId = messages[i].TxId
+			messages[i].Unbonding.MsgId = messages[i].Id
+			unbondings = append(unbondings, messages[i].Unbonding)
+		}
+
+		if messages[i].Redelegation != nil {
+			addressId, err := delegatorId(addrToId, messages[i].Redelegation.Address)
+			if err != nil {
+				return err
+			}
+			messages[i].Redelegation.AddressId = addressId
+			messages[i].Redelegation.Time = messages[i].Time
+			messages[i].Redelegation.TxId = messages[i].TxId
+			messages[i].Redelegation.MsgId = messages[i].Id
+			redelegations = append(redelegations, messages[i].Redelegation)
+		}
+	}
+
+	if err := tx.SaveUnbondings(ctx, unbondings...); err != nil {
+		return err
+	}
+	if err := tx.SaveRedelegations(ctx, redelegations...); err != nil {
+		return err
+	}
+
+	var (
+		logs        = make([]storage.DelegationLog, 0)
+		delegations = make([]storage.Delegation, 0)
+		indices     = make(map[delegationKey]int)
+	)
+
+	for i := range messages {
+		for j := range messages[i].Delegations {
+			entry := messages[i].Delegations[j]
+			addressId, err := delegatorId(addrToId, entry.Address)
+			if err != nil {
+				return err
+			}
+			entry.AddressId = addressId
+			entry.Time = messages[i].Time
+			entry.MsgId = messages[i].Id
+
+			if messages[i].Type == storageTypes.MsgCancelUnbondingDelegation {
+				unbondingId, err := tx.CancelUnbonding(ctx, addressId, entry.Validator, entry.CreationHeight, entry.Amount)
+				if err != nil {
+					return errors.Wrapf(err, "cancel unbonding of %s created at %d", entry.Address, entry.CreationHeight)
+				}
+				if unbondingId > 0 {
+					entry.UnbondingId = &unbondingId
+				}
+			}
+			logs = append(logs, entry)
+
+			key := delegationKey{addressId, entry.Validator}
+			if idx, ok := indices[key]; ok {
+				delegations[idx].Amount = delegations[idx].Amount.Add(entry.Amount)
+				continue
+			}
+			indices[key] = len(delegations)
+			delegations = append(delegations, storage.Delegation{
+				AddressId: addressId,
+				Validator: entry.Validator,
+				Amount:    entry.Amount,
+			})
+		}
+	}
+
+	if err := tx.SaveDelegationLogs(ctx, logs...); err != nil {
+		return err
+	}
+	return tx.SaveDelegations(ctx, delegations...)
+}
+
+func delegatorId(addrToId map[string]uint64, address *storage.Address) (uint64, error) {
+	if address == nil {
+		return 0, errors.New("empty delegator address")
+	}
+	id, ok := addrToId[address.Address]
+	if !ok {
+		return 0, errors.Errorf("unknown delegator address: %s", address.Address)
+	}
+	return id, nil
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/mock"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_saveDelegations(t *testing.T) {
+	var (
+		ts         = time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+		delegator  = &storage.Address{Address: "address1"}
+		validator1 = "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x"
+		validator2 = "celestiavaloper12c6cwd0kqlg48sdhjnn9f0z82g0c82fmrl7j9y"
+	)
+
+	messages := []*storage.Message{
+		{
+			Id:   1,
+			TxId: 10,
+			Time: ts,
+			Type: types.MsgDelegate,
+			Delegations: []storage.DelegationLog{
+				{Validator: validator1, Amount: decimal.RequireFromString("100"), Address: delegator},
+			},
+		}, {
+			Id:   2,
+			TxId: 10,
+			Time: ts,
+			Type: types.MsgUndelegate,
+			Delegations: []storage.DelegationLog{
+				{Validator: validator1, Amount: decimal.RequireFromString("-30"), Address: delegator},
+			},
+			Unbonding: &storage.Unbonding{
+				Validator: validator1,
+				Amount:    decimal.RequireFromString("30"),
+				Address:   delegator,
+			},
+		}, {
+			Id:   3,
+			TxId: 11,
+			Time: ts,
+			Type: types.MsgCancelUnbondingDelegation,
+			Delegations: []storage.DelegationLog{
+				{Validator: validator2, Amount: decimal.RequireFromString("5"), CreationHeight: 90, Address: delegator},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	tx.EXPECT().
+		SaveUnbondings(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, unbondings ...*storage.Unbonding) error {
+			require.Len(t, unbondings, 1)
+			require.EqualValues(t, 1, unbondings[0].AddressId)
+			require.EqualValues(t, 10, unbondings[0].TxId)
+			require.EqualValues(t, 2, unbondings[0].MsgId)
+			require.Equal(t, ts, unbondings[0].Time)
+			return nil
+		})
+	tx.EXPECT().
+		SaveRedelegations(gomock.Any()).
+		Times(1).
+		Return(nil)
+	tx.EXPECT().
+		CancelUnbonding(gomock.Any(), uint64(1), validator2, gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(uint64(7), nil)
+	tx.EXPECT().
+		SaveDelegationLogs(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, logs ...storage.DelegationLog) error {
+			require.Len(t, logs, 3)
+			for i := range logs {
+				require.EqualValues(t, 1, logs[i].AddressId)
+				require.Equal(t, ts, logs[i].Time)
+				require.EqualValues(t, i+1, logs[i].MsgId)
+			}
+			require.Nil(t, logs[0].UnbondingId)
+			require.Nil(t, logs[1].UnbondingId)
+			require.NotNil(t, logs[2].UnbondingId)
+			require.EqualValues(t, 7, *logs[2].UnbondingId)
+			return nil
+		})
+	tx.EXPECT().
+		SaveDelegations(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, delegations ...storage.Delegation) error {
+			require.Len(t, delegations, 2)
+			require.Equal(t, validator1, delegations[0].Validator)
+			require.Equal(t, "70", delegations[0].Amount.String())
+			require.Equal(t, validator2, delegations[1].Validator)
+			require.Equal(t, "5", delegations[1].Amount.String())
+			return nil
+		})
+
+	err := saveDelegations(context.Background(), tx, messages, map[string]uint64{
+		"address1": 1,
+	})
+	require.NoError(t, err)
+}
+
+func Test_saveDelegations_UnknownDelegator(t *testing.T) {
+	messages := []*storage.Message{
+		{
+			Type: types.MsgDelegate,
+			Delegations: []storage.DelegationLog{
+				{
+					Validator: "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x",
+					Amount:    decimal.RequireFromString("100"),
+					Address:   &storage.Address{Address: "unknown"},
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	tx.EXPECT().SaveUnbondings(gomock.Any()).Return(nil)
+	tx.EXPECT().SaveRedelegations(gomock.Any()).Return(nil)
+
+	err := saveDelegations(context.Background(), tx, messages, map[string]uint64{})
+	require.Error(t, err)
+}
+
+func Test_saveDelegations_UnknownUnbonding(t *testing.T) {
+	validator := "celestiavaloper1fg9l3xvfuu9wxremv2229966zawysg4r40gw5x"
+	messages := []*storage.Message{
+		{
+			Id:   1,
+			Type: types.MsgCancelUnbondingDelegation,
+			Delegations: []storage.DelegationLog{
+				{
+					Validator:      validator,
+					Amount:         decimal.RequireFromString("5"),
+					CreationHeight: 1,
+					Address:        &storage.Address{Address: "address1"},
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	tx.EXPECT().SaveUnbondings(gomock.Any()).Return(nil)
+	tx.EXPECT().SaveRedelegations(gomock.Any()).Return(nil)
+	tx.EXPECT().
+		CancelUnbonding(gomock.Any(), uint64(1), validator, gomock.Any(), gomock.Any()).
+		Return(uint64(0), nil)
+	tx.EXPECT().
+		SaveDelegationLogs(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, logs ...storage.DelegationLog) error {
+			require.Len(t, logs, 1)
+			require.Nil(t, logs[0].UnbondingId)
+			return nil
+		})
+	tx.EXPECT().SaveDelegations(gomock.Any(), gomock.Any()).Return(nil)
+
+	err := saveDelegations(context.Background(), tx, messages, map[string]uint64{
+		"address1": 1,
+	})
+	require.NoError(t, err)
+}
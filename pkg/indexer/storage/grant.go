@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/pkg/errors"
+)
+
+// setGrantAddresses - adds granters and grantees of transaction to the block addresses. Grantee may appear in the block for the first time.
+func setGrantAddresses(tx storage.Tx, addresses map[string]*storage.Address) {
+	for _, grant := range tx.Grants {
+		addGrantAddress(addresses, grant.Granter)
+		addGrantAddress(addresses, grant.Grantee)
+	}
+	for _, allowance := range tx.FeeAllowances {
+		addGrantAddress(addresses, allowance.Granter)
+		addGrantAddress(addresses, allowance.Grantee)
+	}
+}
+
+func addGrantAddress(addresses map[string]*storage.Address, address *storage.Address) {
+	if address == nil {
+		return
+	}
+	if _, ok := addresses[address.Address]; !ok {
+		addresses[address.Address] = address
+	}
+}
+
+// saveGrants - applies changes of authz grants and fee allowances in order of transaction events
+func saveGrants(
+	ctx context.Context,
+	tx storage.Transaction,
+	messages []*storage.Message,
+	txs []storage.Tx,
+	addrToId map[string]uint64,
+) error {
+	for i := range messages {
+		if messages[i].Grant != nil {
+			messages[i].Grant.Time = messages[i].Time
+			messages[i].Grant.TxId = messages[i].TxId
+			messages[i].Grant.MsgId = messages[i].Id
+		}
+		if messages[i].FeeAllowance != nil {
+			messages[i].FeeAllowance.Time = messages[i].Time
+			messages[i].FeeAllowance.TxId = messages[i].TxId
+			messages[i].FeeAllowance.MsgId = messages[i].Id
+		}
+	}
+
+	for i := range txs {
+		for _, grant := range txs[i].Grants {
+			granterId, granteeId, err := grantParticipants(addrToId, grant.Granter, grant.Grantee)
+			if err != nil {
+				return errors.Wrap(err, "authz grant")
+			}
+			grant.GranterId = granterId
+			grant.GranteeId = granteeId
+
+			if grant.IsRevocation() {
+				err = tx.RevokeGrant(ctx, *grant)
+			} else {
+				err = tx.SaveGrants(ctx, grant)
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, allowance := range txs[i].FeeAllowances {
+			granterId, granteeId, err := grantParticipants(addrToId, allowance.Granter, allowance.Grantee)
+			if err != nil {
+				return errors.Wrap(err, "fee allowance")
+			}
+			allowance.GranterId = granterId
+			allowance.GranteeId = granteeId
+
+			switch {
+			case allowance.IsRevocation():
+				err = tx.RevokeFeeAllowance(ctx, *allowance)
+			case allowance.IsUsage():
+				allowance.TxId = txs[i].Id
+				err = tx.UseFeeAllowance(ctx, *allowance)
+			default:
+				err = tx.SaveFeeAllowances(ctx, allowance)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func grantParticipants(addrToId map[string]uint64, granter, grantee *storage.Address) (uint64, uint64, error) {
+	if granter == nil || grantee == nil {
+		return 0, 0, errors.New("empty granter or grantee address")
+	}
+	granterId, ok := addrToId[granter.Address]
+	if !ok {
+		return 0, 0, errors.Errorf("unknown granter address: %s", granter.Address)
+	}
+	granteeId, ok := addrToId[grantee.Address]
+	if !ok {
+		return 0, 0, errors.Errorf("unknown grantee address: %s", grantee.Address)
+	}
+	return granterId, granteeId, nil
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/mock"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_saveGrants(t *testing.T) {
+	var (
+		ts      = time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+		granter = &storage.Address{Address: "address1"}
+		grantee = &storage.Address{Address: "address2"}
+	)
+
+	grant := &storage.Grant{
+		Height:        100,
+		Authorization: types.AuthorizationTypeGeneric,
+		MsgType:       "/cosmos.bank.v1beta1.MsgSend",
+		Granter:       granter,
+		Grantee:       grantee,
+	}
+	allowance := &storage.FeeAllowance{
+		Height:  100,
+		Type:    types.FeeAllowanceTypeBasic,
+		Granter: granter,
+		Grantee: grantee,
+	}
+	messages := []*storage.Message{
+		{Id: 1, TxId: 10, Time: ts, Type: types.MsgGrant, Grant: grant},
+		{Id: 2, TxId: 10, Time: ts, Type: types.MsgGrantAllowance, FeeAllowance: allowance},
+	}
+	txs := []storage.Tx{
+		{
+			Id: 10,
+			Grants: []*storage.Grant{
+				grant,
+				{EndHeight: 100, MsgType: "/cosmos.bank.v1beta1.MsgSend", Granter: granter, Grantee: grantee},
+			},
+			FeeAllowances: []*storage.FeeAllowance{
+				{EndHeight: 100, Granter: granter, Grantee: grantee},
+				allowance,
+				{Height: 100, Time: ts, Spent: decimal.NewFromInt(300), Granter: granter, Grantee: grantee},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	gomock.InOrder(
+		tx.EXPECT().
+			SaveGrants(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, grants ...*storage.Grant) error {
+				require.Len(t, grants, 1)
+				require.EqualValues(t, 1, grants[0].GranterId)
+				require.EqualValues(t, 2, grants[0].GranteeId)
+				require.EqualValues(t, 10, grants[0].TxId)
+				require.EqualValues(t, 1, grants[0].MsgId)
+				require.Equal(t, ts, grants[0].Time)
+				return nil
+			}),
+		tx.EXPECT().
+			RevokeGrant(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, grant storage.Grant) error {
+				require.EqualValues(t, 100, grant.EndHeight)
+				require.EqualValues(t, 1, grant.GranterId)
+				require.EqualValues(t, 2, grant.GranteeId)
+				require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", grant.MsgType)
+				return nil
+			}),
+		tx.EXPECT().
+			RevokeFeeAllowance(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, allowance storage.FeeAllowance) error {
+				require.EqualValues(t, 100, allowance.EndHeight)
+				require.EqualValues(t, 1, allowance.GranterId)
+				require.EqualValues(t, 2, allowance.GranteeId)
+				return nil
+			}),
+		tx.EXPECT().
+			SaveFeeAllowances(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, allowances ...*storage.FeeAllowance) error {
+				require.Len(t, allowances, 1)
+				require.EqualValues(t, 10, allowances[0].TxId)
+				require.EqualValues(t, 2, allowances[0].MsgId)
+				require.Equal(t, ts, allowances[0].Time)
+				return nil
+			}),
+		tx.EXPECT().
+			UseFeeAllowance(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, usage storage.FeeAllowance) error {
+				require.EqualValues(t, 10, usage.TxId)
+				require.EqualValues(t, 1, usage.GranterId)
+				require.EqualValues(t, 2, usage.GranteeId)
+				require.Equal(t, "300", usage.Spent.String())
+				return nil
+			}),
+	)
+
+	err := saveGrants(context.Background(), tx, messages, txs, map[string]uint64{
+		"address1": 1,
+		"address2": 2,
+	})
+	require.NoError(t, err)
+}
+
+func Test_setGrantAddresses(t *testing.T) {
+	signer := &storage.Address{Address: "address1", Height: 90}
+	addresses := map[string]*storage.Address{
+		"address1": signer,
+	}
+
+	setGrantAddresses(storage.Tx{
+		FeeAllowances: []*storage.FeeAllowance{
+			{
+				Granter: &storage.Address{Address: "address1", Height: 100},
+				Grantee: &storage.Address{Address: "address2", Height: 100},
+			},
+		},
+	}, addresses)
+
+	require.Len(t, addresses, 2)
+	require.Same(t, signer, addresses["address1"])
+	require.Contains(t, addresses, "address2")
+}
+
+func Test_saveGrants_UnknownGrantee(t *testing.T) {
+	txs := []storage.Tx{
+		{
+			Grants: []*storage.Grant{
+				{
+					Height:  100,
+					Granter: &storage.Address{Address: "address1"},
+					Grantee: &storage.Address{Address: "unknown"},
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	err := saveGrants(context.Background(), tx, nil, txs, map[string]uint64{
+		"address1": 1,
+	})
+	require.Error(t, err)
+}
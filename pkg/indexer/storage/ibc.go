@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+)
+
+// saveIbcTransfers - saves sent and received IBC transfers and resolves pending outgoing transfers by acknowledgements and timeouts
+func saveIbcTransfers(
+	ctx context.Context,
+	tx storage.Transaction,
+	messages []*storage.Message,
+) error {
+	var transfers []*storage.IbcTransfer
+	for i := range messages {
+		transfer := messages[i].IbcTransfer
+		if transfer == nil {
+			continue
+		}
+
+		if transfer.IsResolution() {
+			transfer.ResolvedTime = messages[i].Time
+			transfer.ResolvedTxId = messages[i].TxId
+			if err := tx.ResolveIbcTransfer(ctx, *transfer); err != nil {
+				return err
+			}
+			continue
+		}
+
+		transfer.Time = messages[i].Time
+		transfer.TxId = messages[i].TxId
+		transfer.MsgId = messages[i].Id
+		transfers = append(transfers, transfer)
+	}
+
+	return tx.SaveIbcTransfers(ctx, transfers...)
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/mock"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_saveIbcTransfers(t *testing.T) {
+	ts := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	messages := []*storage.Message{
+		{Id: 1, TxId: 10, Time: ts, Type: types.MsgUpdateClient},
+		{Id: 2, TxId: 10, Time: ts, Type: types.MsgAcknowledgement, IbcTransfer: &storage.IbcTransfer{
+			Status:         types.IbcTransferStatusFailed,
+			SourcePort:     "transfer",
+			SourceChannel:  "channel-2",
+			Sequence:       3,
+			ResolvedHeight: 100,
+		}},
+		{Id: 3, TxId: 10, Time: ts, Type: types.MsgRecvPacket, IbcTransfer: &storage.IbcTransfer{
+			Height:   100,
+			Incoming: true,
+			Status:   types.IbcTransferStatusSuccess,
+			Sequence: 10,
+		}},
+		{Id: 4, TxId: 11, Time: ts, Type: types.IBCTransfer, IbcTransfer: &storage.IbcTransfer{
+			Height:   100,
+			Status:   types.IbcTransferStatusPending,
+			Sequence: 4,
+		}},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	gomock.InOrder(
+		tx.EXPECT().
+			ResolveIbcTransfer(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, resolution storage.IbcTransfer) error {
+				require.Equal(t, types.IbcTransferStatusFailed, resolution.Status)
+				require.EqualValues(t, 3, resolution.Sequence)
+				require.EqualValues(t, 100, resolution.ResolvedHeight)
+				require.EqualValues(t, 10, resolution.ResolvedTxId)
+				require.Equal(t, ts, resolution.ResolvedTime)
+				return nil
+			}),
+		tx.EXPECT().
+			SaveIbcTransfers(gomock.Any(), gomock.Any()).
+			Times(1).
+			DoAndReturn(func(_ context.Context, transfers ...*storage.IbcTransfer) error {
+				require.Len(t, transfers, 2)
+				require.True(t, transfers[0].Incoming)
+				require.EqualValues(t, 10, transfers[0].TxId)
+				require.EqualValues(t, 3, transfers[0].MsgId)
+				require.Equal(t, ts, transfers[0].Time)
+				require.False(t, transfers[1].Incoming)
+				require.EqualValues(t, 11, transfers[1].TxId)
+				require.EqualValues(t, 4, transfers[1].MsgId)
+				return nil
+			}),
+	)
+
+	err := saveIbcTransfers(context.Background(), tx, messages)
+	require.NoError(t, err)
+}
@@ -15,13 +15,14 @@ func saveMessages(
 	messages []*storage.Message,
 	addrToId map[string]uint64,
 ) error {
-	if err := tx.SaveMessages(ctx, messages...); err != nil {
+	if err := insertMessages(ctx, tx, messages); err != nil {
 		return err
 	}
 
 	var (
 		namespaceMsgs []storage.NamespaceMessage
 		msgAddress    []storage.MsgAddress
+		validatorMsgs []storage.ValidatorMessage
 		validators    = make([]*storage.Validator, 0)
 		namespaces    = make(map[string]uint64)
 		addedMsgId    = make(map[uint64]struct{})
@@ -59,6 +60,16 @@ func saveMessages(
 		}
 
 		for j := range messages[i].Addresses {
+			if messages[i].Addresses[j].Type.IsValidator() {
+				validatorMsgs = append(validatorMsgs, storage.ValidatorMessage{
+					Validator: messages[i].Addresses[j].String(),
+					MsgId:     messages[i].Id,
+					Type:      messages[i].Addresses[j].Type,
+					Time:      messages[i].Time,
+					Height:    messages[i].Height,
+				})
+			}
+
 			id, ok := addrToId[messages[i].Addresses[j].String()]
 			if !ok {
 				continue
@@ -80,6 +91,53 @@ func saveMessages(
 	if err := tx.SaveMsgAddresses(ctx, msgAddress...); err != nil {
 		return err
 	}
+	if err := tx.SaveValidatorMessages(ctx, validatorMsgs...); err != nil {
+		return err
+	}
 
 	return nil
 }
+
+// insertMessages - inserts messages level by level: internal messages of authz MsgExec are inserted after their parent to receive parent id.
+// `messages` contains both parents and their internal messages.
+func insertMessages(ctx context.Context, tx storage.Transaction, messages []*storage.Message) error {
+	internal := make(map[*storage.Message]struct{})
+	for i := range messages {
+		for j := range messages[i].InternalMsgs {
+			internal[&messages[i].InternalMsgs[j]] = struct{}{}
+		}
+	}
+
+	level := make([]*storage.Message, 0, len(messages))
+	for i := range messages {
+		if _, ok := internal[messages[i]]; !ok {
+			level = append(level, messages[i])
+		}
+	}
+
+	for len(level) > 0 {
+		if err := tx.SaveMessages(ctx, level...); err != nil {
+			return err
+		}
+
+		next := make([]*storage.Message, 0)
+		for i := range level {
+			for j := range level[i].InternalMsgs {
+				level[i].InternalMsgs[j].ParentId = &level[i].Id
+				next = append(next, &level[i].InternalMsgs[j])
+			}
+		}
+		level = next
+	}
+	return nil
+}
+
+// appendMessage - appends the message and its internal messages executed by authz MsgExec to the list
+func appendMessage(messages []*storage.Message, msg *storage.Message, txId uint64) []*storage.Message {
+	msg.TxId = txId
+	messages = append(messages, msg)
+	for i := range msg.InternalMsgs {
+		messages = appendMessage(messages, &msg.InternalMsgs[i], txId)
+	}
+	return messages
+}
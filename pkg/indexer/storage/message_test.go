@@ -29,6 +29,7 @@ func Test_saveMessages(t *testing.T) {
 		wantNamespaceMessageCount int
 		wantValidatorsCount       int
 		wantMsgAddress            int
+		wantValidatorMsgs         int
 		wantErr                   bool
 	}{
 		{
@@ -214,6 +215,7 @@ func Test_saveMessages(t *testing.T) {
 			wantNamespaceMessageCount: 0,
 			wantValidatorsCount:       1,
 			wantMsgAddress:            4,
+			wantValidatorMsgs:         1,
 			wantErr:                   false,
 		}, {
 			name: "test with duplicate namespaces",
@@ -287,6 +289,53 @@ func Test_saveMessages(t *testing.T) {
 			wantNamespaceMessageCount: 1,
 			wantValidatorsCount:       0,
 			wantMsgAddress:            3,
+			wantValidatorMsgs:         0,
+			wantErr:                   false,
+		}, {
+			name: "test with validator messages",
+			args: args{
+				messages: []*storage.Message{
+					{
+						Id:       1,
+						Height:   100,
+						Time:     now,
+						Position: 0,
+						Type:     types.MsgBeginRedelegate,
+						TxId:     1,
+						Addresses: []storage.AddressWithType{
+							{
+								Type: types.MsgAddressTypeDelegator,
+								Address: storage.Address{
+									Address:    "address1",
+									Height:     100,
+									LastHeight: 100,
+								},
+							}, {
+								Type: types.MsgAddressTypeValidatorSrc,
+								Address: storage.Address{
+									Address:    "valoper1",
+									Height:     100,
+									LastHeight: 100,
+								},
+							}, {
+								Type: types.MsgAddressTypeValidatorDst,
+								Address: storage.Address{
+									Address:    "valoper2",
+									Height:     100,
+									LastHeight: 100,
+								},
+							},
+						},
+					},
+				},
+				addrToId: map[string]uint64{
+					"address1": 1,
+				},
+			},
+			wantNamespaceMessageCount: 0,
+			wantValidatorsCount:       0,
+			wantMsgAddress:            1,
+			wantValidatorMsgs:         2,
 			wantErr:                   false,
 		},
 	}
@@ -333,9 +382,79 @@ func Test_saveMessages(t *testing.T) {
 				return nil
 			})
 
+		tx.EXPECT().
+			SaveValidatorMessages(gomock.Any(), gomock.Any()).
+			MaxTimes(1).
+			MinTimes(1).
+			DoAndReturn(func(_ context.Context, msgs ...storage.ValidatorMessage) error {
+				require.Equal(t, tt.wantValidatorMsgs, len(msgs))
+				for i := range msgs {
+					require.True(t, msgs[i].Type.IsValidator())
+				}
+				return nil
+			})
+
 		t.Run(tt.name, func(t *testing.T) {
 			err := saveMessages(context.Background(), tx, tt.args.messages, tt.args.addrToId)
 			require.Equal(t, tt.wantErr, err != nil)
 		})
 	}
 }
+
+func Test_saveMessages_InternalMessages(t *testing.T) {
+	now := time.Now()
+	exec := &storage.Message{
+		Height: 100,
+		Time:   now,
+		Type:   types.MsgExec,
+		InternalMsgs: []storage.Message{
+			{
+				Height:   100,
+				Time:     now,
+				Position: 0,
+				Type:     types.MsgDelegate,
+			}, {
+				Height:   100,
+				Time:     now,
+				Position: 1,
+				Type:     types.MsgSend,
+			},
+		},
+	}
+	messages := appendMessage(nil, exec, 10)
+	require.Len(t, messages, 3)
+	for i := range messages {
+		require.EqualValues(t, 10, messages[i].TxId)
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var lastId uint64
+	tx := mock.NewMockTransaction(ctrl)
+	tx.EXPECT().
+		SaveMessages(gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(_ context.Context, msgs ...*storage.Message) error {
+			for i := range msgs {
+				lastId++
+				msgs[i].Id = lastId
+			}
+			return nil
+		})
+	tx.EXPECT().SaveNamespaceMessage(gomock.Any()).Return(nil)
+	tx.EXPECT().SaveValidators(gomock.Any()).Return(nil)
+	tx.EXPECT().SaveMsgAddresses(gomock.Any()).Return(nil)
+	tx.EXPECT().SaveValidatorMessages(gomock.Any()).Return(nil)
+
+	err := saveMessages(context.Background(), tx, messages, map[string]uint64{})
+	require.NoError(t, err)
+
+	require.EqualValues(t, 1, exec.Id)
+	require.Nil(t, exec.ParentId)
+	for i := range exec.InternalMsgs {
+		require.EqualValues(t, i+2, exec.InternalMsgs[i].Id)
+		require.NotNil(t, exec.InternalMsgs[i].ParentId)
+		require.EqualValues(t, 1, *exec.InternalMsgs[i].ParentId)
+	}
+}
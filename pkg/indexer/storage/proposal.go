@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/pkg/errors"
+)
+
+func saveProposals(
+	ctx context.Context,
+	tx storage.Transaction,
+	messages []*storage.Message,
+	updates []storage.Proposal,
+	addrToId map[string]uint64,
+) error {
+	var (
+		proposals = make([]*storage.Proposal, 0)
+		votes     = make([]storage.Vote, 0)
+		deposits  = make([]storage.Deposit, 0)
+	)
+
+	for i := range messages {
+		if messages[i].Proposal != nil {
+			proposerId, err := govAddressId(addrToId, messages[i].Proposal.Proposer)
+			if err != nil {
+				return errors.Wrap(err, "proposer")
+			}
+			messages[i].Proposal.ProposerId = proposerId
+			messages[i].Proposal.Time = messages[i].Time
+			messages[i].Proposal.TxId = messages[i].TxId
+			messages[i].Proposal.MsgId = messages[i].Id
+			proposals = append(proposals, messages[i].Proposal)
+		}
+
+		for j := range messages[i].Votes {
+			voterId, err := govAddressId(addrToId, messages[i].Votes[j].Voter)
+			if err != nil {
+				return errors.Wrap(err, "voter")
+			}
+			vote := messages[i].Votes[j]
+			vote.VoterId = voterId
+			vote.Time = messages[i].Time
+			vote.TxId = messages[i].TxId
+			vote.MsgId = messages[i].Id
+			votes = append(votes, vote)
+		}
+
+		if messages[i].Deposit != nil {
+			depositorId, err := govAddressId(addrToId, messages[i].Deposit.Depositor)
+			if err != nil {
+				return errors.Wrap(err, "depositor")
+			}
+			deposit := *messages[i].Deposit
+			deposit.DepositorId = depositorId
+			deposit.Time = messages[i].Time
+			deposit.TxId = messages[i].TxId
+			deposit.MsgId = messages[i].Id
+			deposits = append(deposits, deposit)
+		}
+	}
+
+	if err := tx.SaveProposals(ctx, proposals...); err != nil {
+		return err
+	}
+	if err := tx.SaveVotes(ctx, votes...); err != nil {
+		return err
+	}
+	if err := tx.SaveDeposits(ctx, deposits...); err != nil {
+		return err
+	}
+
+	changes := make([]*storage.Proposal, 0, len(updates))
+	indices := make(map[uint64]int)
+	for i := range deposits {
+		if idx, ok := indices[deposits[i].ProposalId]; ok {
+			changes[idx].Deposit = changes[idx].Deposit.Add(deposits[i].Amount)
+			continue
+		}
+		indices[deposits[i].ProposalId] = len(changes)
+		changes = append(changes, &storage.Proposal{
+			Id:      deposits[i].ProposalId,
+			Deposit: deposits[i].Amount,
+		})
+	}
+	for i := range updates {
+		changes = append(changes, &updates[i])
+	}
+
+	return tx.UpdateProposals(ctx, changes...)
+}
+
+func govAddressId(addrToId map[string]uint64, address *storage.Address) (uint64, error) {
+	if address == nil {
+		return 0, errors.New("empty address")
+	}
+	id, ok := addrToId[address.Address]
+	if !ok {
+		return 0, errors.Errorf("unknown address: %s", address.Address)
+	}
+	return id, nil
+}
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/mock"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_saveProposals(t *testing.T) {
+	var (
+		ts        = time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+		proposer  = &storage.Address{Address: "address1"}
+		depositor = &storage.Address{Address: "address2"}
+	)
+
+	messages := []*storage.Message{
+		{
+			Id:   1,
+			TxId: 10,
+			Time: ts,
+			Type: types.MsgSubmitProposal,
+			Proposal: &storage.Proposal{
+				Id:       5,
+				Height:   100,
+				Status:   types.ProposalStatusDepositPeriod,
+				Deposit:  decimal.Zero,
+				Proposer: proposer,
+			},
+			Deposit: &storage.Deposit{
+				ProposalId: 5,
+				Amount:     decimal.RequireFromString("100"),
+				Depositor:  proposer,
+			},
+		}, {
+			Id:   2,
+			TxId: 11,
+			Time: ts,
+			Type: types.MsgDeposit,
+			Deposit: &storage.Deposit{
+				ProposalId: 5,
+				Amount:     decimal.RequireFromString("50"),
+				Depositor:  depositor,
+			},
+		}, {
+			Id:   3,
+			TxId: 12,
+			Time: ts,
+			Type: types.MsgVoteWeighted,
+			Votes: []storage.Vote{
+				{ProposalId: 4, Option: types.VoteOptionYes, Weight: decimal.RequireFromString("0.7"), Voter: depositor},
+				{ProposalId: 4, Option: types.VoteOptionNo, Weight: decimal.RequireFromString("0.3"), Voter: depositor},
+			},
+		},
+	}
+	updates := []storage.Proposal{
+		{
+			Id:                5,
+			Status:            types.ProposalStatusVotingPeriod,
+			VotingStartHeight: 100,
+			VotingStartTime:   ts,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	tx.EXPECT().
+		SaveProposals(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, proposals ...*storage.Proposal) error {
+			require.Len(t, proposals, 1)
+			require.EqualValues(t, 5, proposals[0].Id)
+			require.EqualValues(t, 1, proposals[0].ProposerId)
+			require.EqualValues(t, 10, proposals[0].TxId)
+			require.EqualValues(t, 1, proposals[0].MsgId)
+			require.Equal(t, ts, proposals[0].Time)
+			return nil
+		})
+	tx.EXPECT().
+		SaveVotes(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, votes ...storage.Vote) error {
+			require.Len(t, votes, 2)
+			for i := range votes {
+				require.EqualValues(t, 2, votes[i].VoterId)
+				require.EqualValues(t, 12, votes[i].TxId)
+				require.EqualValues(t, 3, votes[i].MsgId)
+				require.Equal(t, ts, votes[i].Time)
+			}
+			return nil
+		})
+	tx.EXPECT().
+		SaveDeposits(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, deposits ...storage.Deposit) error {
+			require.Len(t, deposits, 2)
+			require.EqualValues(t, 1, deposits[0].DepositorId)
+			require.EqualValues(t, 1, deposits[0].MsgId)
+			require.EqualValues(t, 2, deposits[1].DepositorId)
+			require.EqualValues(t, 2, deposits[1].MsgId)
+			return nil
+		})
+	tx.EXPECT().
+		UpdateProposals(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, proposals ...*storage.Proposal) error {
+			require.Len(t, proposals, 2)
+			require.EqualValues(t, 5, proposals[0].Id)
+			require.Equal(t, "150", proposals[0].Deposit.String())
+			require.EqualValues(t, 5, proposals[1].Id)
+			require.Equal(t, types.ProposalStatusVotingPeriod, proposals[1].Status)
+			require.EqualValues(t, 100, proposals[1].VotingStartHeight)
+			return nil
+		})
+
+	err := saveProposals(context.Background(), tx, messages, updates, map[string]uint64{
+		"address1": 1,
+		"address2": 2,
+	})
+	require.NoError(t, err)
+}
+
+func Test_saveProposals_UnknownVoter(t *testing.T) {
+	messages := []*storage.Message{
+		{
+			Type: types.MsgVote,
+			Votes: []storage.Vote{
+				{
+					ProposalId: 1,
+					Option:     types.VoteOptionYes,
+					Weight:     decimal.NewFromInt(1),
+					Voter:      &storage.Address{Address: "unknown"},
+				},
+			},
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+
+	err := saveProposals(context.Background(), tx, messages, nil, map[string]uint64{})
+	require.Error(t, err)
+}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/metrics"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
 
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	"github.com/dipdup-io/celestia-indexer/internal/storage/postgres"
@@ -21,6 +23,11 @@ const (
 	StopOutput = "stop"
 )
 
+const (
+	defaultBatchTimeout = 5 * time.Second
+	defaultHeadLag      = time.Minute
+)
+
 // Module - saves received from input block to storage.
 //
 //	                     |----------------|
@@ -30,9 +37,14 @@ const (
 //	                     |----------------|
 type Module struct {
 	modules.BaseModule
-	storage     sdk.Transactable
-	notificator storage.Notificator
-	indexerName string
+	storage      sdk.Transactable
+	notificator  storage.Notificator
+	indexerName  string
+	batchSize    int
+	batchTimeout time.Duration
+	headLag      time.Duration
+	endLevel     types.Level
+	metrics      *metrics.Metrics
 }
 
 var _ modules.Module = (*Module)(nil)
@@ -44,10 +56,25 @@ func NewModule(
 	cfg config.Indexer,
 ) Module {
 	m := Module{
-		BaseModule:  modules.New("storage"),
-		storage:     storage,
-		notificator: notificator,
-		indexerName: cfg.Name,
+		BaseModule:   modules.New("storage"),
+		storage:      storage,
+		notificator:  notificator,
+		indexerName:  cfg.Name,
+		batchSize:    int(cfg.Batch.Size),
+		batchTimeout: time.Duration(cfg.Batch.Timeout) * time.Millisecond,
+		headLag:      time.Duration(cfg.Batch.HeadLag) * time.Second,
+	}
+	if m.batchSize < 1 {
+		m.batchSize = 1
+	}
+	if m.batchTimeout <= 0 {
+		m.batchTimeout = defaultBatchTimeout
+	}
+	if m.headLag <= 0 {
+		m.headLag = defaultHeadLag
+	}
+	if cfg.EndLevel > 0 {
+		m.endLevel = types.Level(cfg.EndLevel)
 	}
 
 	m.CreateInput(InputName)
@@ -56,6 +83,12 @@ func NewModule(
 	return m
 }
 
+// WithMetrics - sets metrics collector
+func (module *Module) WithMetrics(m *metrics.Metrics) *Module {
+	module.metrics = m
+	return module
+}
+
 // Start -
 func (module *Module) Start(ctx context.Context) {
 	module.G.GoCtx(ctx, module.listen)
@@ -65,6 +98,11 @@ func (module *Module) listen(ctx context.Context) {
 	module.Log.Info().Msg("module started")
 	input := module.MustInput(InputName)
 
+	batch := make([]storage.Block, 0, module.batchSize)
+	timer := time.NewTimer(module.batchTimeout)
+	timer.Stop()
+	defer timer.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -81,21 +119,64 @@ func (module *Module) listen(ctx context.Context) {
 				continue
 			}
 
-			if err := module.saveBlock(ctx, &block); err != nil {
-				module.Log.Err(err).
-					Uint64("height", uint64(block.Height)).
-					Msg("block saving error")
-				module.MustOutput(StopOutput).Push(struct{}{})
+			batch = append(batch, block)
+			if module.collectMore(batch) {
+				if len(batch) == 1 {
+					timer.Reset(module.batchTimeout)
+				}
 				continue
 			}
 
-			if err := module.notify(ctx, block); err != nil {
-				module.Log.Err(err).Msg("block notification error")
-			}
+			timer.Stop()
+			module.saveBatch(ctx, batch)
+			batch = batch[:0]
+		case <-timer.C:
+			module.saveBatch(ctx, batch)
+			batch = batch[:0]
 		}
 	}
 }
 
+// collectMore - returns true if module should wait for the next block before saving the batch.
+// Blocks are collected only while indexer is catching up head, i.e. the last block is older than head lag.
+func (module *Module) collectMore(batch []storage.Block) bool {
+	if len(batch) >= module.batchSize || module.endLevelReached(batch[len(batch)-1].Height) {
+		return false
+	}
+	return time.Since(batch[len(batch)-1].Time) > module.headLag
+}
+
+func (module *Module) saveBatch(ctx context.Context, batch []storage.Block) {
+	if len(batch) == 0 {
+		return
+	}
+
+	if err := module.SaveBlocks(ctx, batch); err != nil {
+		module.Log.Err(err).
+			Uint64("height", uint64(batch[0].Height)).
+			Int("count", len(batch)).
+			Msg("block saving error")
+		module.MustOutput(StopOutput).Push(struct{}{})
+		return
+	}
+
+	for i := range batch {
+		if err := module.notify(ctx, batch[i]); err != nil {
+			module.Log.Err(err).Msg("block notification error")
+		}
+	}
+
+	if last := batch[len(batch)-1].Height; module.endLevelReached(last) {
+		module.Log.Info().Uint64("height", uint64(last)).Msg("end level is reached, stopping...")
+		module.MustOutput(StopOutput).Push(struct{}{})
+	}
+}
+
+// endLevelReached - returns true if `end_level` is set and height is not lower
+func (module *Module) endLevelReached(height types.Level) bool {
+	return module.endLevel > 0 && height >= module.endLevel
+}
+
 // Close -
 func (module *Module) Close() error {
 	module.Log.Info().Msg("closing module...")
@@ -103,37 +184,54 @@ func (module *Module) Close() error {
 	return nil
 }
 
-func (module *Module) saveBlock(ctx context.Context, block *storage.Block) error {
+// SaveBlocks - saves blocks in one transaction. State is updated once per transaction.
+func (module *Module) SaveBlocks(ctx context.Context, blocks []storage.Block) error {
 	start := time.Now()
-	module.Log.Info().Uint64("height", uint64(block.Height)).Msg("saving block...")
+	module.Log.Info().
+		Uint64("height", uint64(blocks[0].Height)).
+		Int("count", len(blocks)).
+		Msg("saving blocks...")
 	tx, err := postgres.BeginTransaction(ctx, module.storage)
 	if err != nil {
 		return err
 	}
 	defer tx.Close(ctx)
 
-	if err := module.processBlockInTransaction(ctx, tx, block); err != nil {
+	state, err := tx.State(ctx, module.indexerName)
+	if err != nil {
+		return tx.HandleError(ctx, err)
+	}
+
+	for i := range blocks {
+		if err := module.processBlockInTransaction(ctx, tx, &blocks[i], &state); err != nil {
+			return tx.HandleError(ctx, err)
+		}
+	}
+
+	if err := tx.Update(ctx, &state); err != nil {
 		return tx.HandleError(ctx, err)
 	}
 
 	if err := tx.Flush(ctx); err != nil {
 		return tx.HandleError(ctx, err)
 	}
-	module.Log.Info().
-		Uint64("height", uint64(block.Height)).
-		Time("block_time", block.Time).
-		Int64("block_ns_size", block.Stats.BlobsSize).
-		Str("block_fee", block.Stats.Fee.String()).
-		Int64("ms", time.Since(start).Milliseconds()).
-		Msg("block saved")
+
+	module.metrics.Processed(metrics.StageSaved, len(blocks), time.Since(start))
+	module.metrics.SetIndexedHeight(state.LastHeight)
+
+	for i := range blocks {
+		module.Log.Info().
+			Uint64("height", uint64(blocks[i].Height)).
+			Time("block_time", blocks[i].Time).
+			Int64("block_ns_size", blocks[i].Stats.BlobsSize).
+			Str("block_fee", blocks[i].Stats.Fee.String()).
+			Int64("ms", time.Since(start).Milliseconds()).
+			Msg("block saved")
+	}
 	return nil
 }
 
-func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.Transaction, block *storage.Block) error {
-	state, err := tx.State(ctx, module.indexerName)
-	if err != nil {
-		return err
-	}
+func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.Transaction, block *storage.Block, state *storage.State) error {
 	block.Stats.BlockTime = uint64(block.Time.Sub(state.LastTime).Milliseconds())
 
 	if err := tx.Add(ctx, block); err != nil {
@@ -160,7 +258,7 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 		if addr, ok := addresses[key]; !ok {
 			addresses[key] = &block.Addresses[i]
 		} else {
-			addr.Balance.Total = addr.Balance.Total.Add(block.Addresses[i].Balance.Total)
+			addr.MergeBalances(block.Addresses[i])
 		}
 	}
 
@@ -168,9 +266,7 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 
 	for i := range block.Txs {
 		for j := range block.Txs[i].Messages {
-			block.Txs[i].Messages[j].TxId = block.Txs[i].Id
-			messages = append(messages, &block.Txs[i].Messages[j])
-			setNamespacesFromMessage(block.Txs[i].Messages[j], namespaces)
+			messages = appendMessage(messages, &block.Txs[i].Messages[j], block.Txs[i].Id)
 		}
 
 		for j := range block.Txs[i].Events {
@@ -184,6 +280,12 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 				addresses[key] = &block.Txs[i].Signers[j]
 			}
 		}
+
+		setGrantAddresses(block.Txs[i], addresses)
+	}
+
+	for i := range messages {
+		setNamespacesFromMessage(*messages[i], namespaces)
 	}
 
 	addrToId, totalAccounts, err := saveAddresses(ctx, tx, addresses)
@@ -195,6 +297,10 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 		return err
 	}
 
+	if err := saveBalanceUpdates(ctx, tx, addrToId, block); err != nil {
+		return err
+	}
+
 	if err := tx.SaveEvents(ctx, events...); err != nil {
 		return err
 	}
@@ -208,11 +314,35 @@ func (module *Module) processBlockInTransaction(ctx context.Context, tx storage.
 		return err
 	}
 
-	updateState(block, totalAccounts, totalNamespaces, &state)
-	if err := tx.Update(ctx, &state); err != nil {
+	if err := saveDelegations(ctx, tx, messages, addrToId); err != nil {
+		return err
+	}
+
+	if err := saveProposals(ctx, tx, messages, block.ProposalUpdates, addrToId); err != nil {
+		return err
+	}
+
+	if err := saveGrants(ctx, tx, messages, block.Txs, addrToId); err != nil {
+		return err
+	}
+
+	if err := saveIbcTransfers(ctx, tx, messages); err != nil {
+		return err
+	}
+
+	if err := saveValidatorHistory(ctx, tx, block.ValidatorHistory); err != nil {
+		return err
+	}
+
+	if err := saveBlockSignatures(ctx, tx, block.Signatures); err != nil {
+		return err
+	}
+
+	if err := saveEvidence(ctx, tx, block.Evidence); err != nil {
 		return err
 	}
 
+	updateState(block, totalAccounts, totalNamespaces, state)
 	return nil
 }
 
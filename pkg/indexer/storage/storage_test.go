@@ -13,6 +13,7 @@ import (
 	"github.com/dipdup-io/celestia-indexer/internal/storage"
 	"github.com/dipdup-io/celestia-indexer/internal/storage/postgres"
 	indexerCfg "github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
 	"github.com/dipdup-net/go-lib/config"
 	"github.com/dipdup-net/go-lib/database"
 	"github.com/go-testfixtures/testfixtures/v3"
@@ -111,6 +112,54 @@ func (s *ModuleTestSuite) TestBlockLast() {
 	s.Require().NoError(module.Close())
 }
 
+func (s *ModuleTestSuite) TestBlocksBatch() {
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+
+	fixtures, err := testfixtures.New(
+		testfixtures.Database(db),
+		testfixtures.Dialect("timescaledb"),
+		testfixtures.Directory("../../../test/data"),
+		testfixtures.UseAlterConstraint(),
+	)
+	s.Require().NoError(err)
+	s.Require().NoError(fixtures.Load())
+	s.Require().NoError(db.Close())
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer ctxCancel()
+
+	module := NewModule(s.storage.Transactable, s.storage.Notificator, indexerCfg.Indexer{
+		Name: testIndexerName,
+		Batch: indexerCfg.Batch{
+			Size:    3,
+			Timeout: 10000,
+		},
+	})
+	module.Start(ctx)
+
+	for height := pkgTypes.Level(1001); height <= 1003; height++ {
+		module.MustInput(InputName).Push(storage.Block{
+			Height:       height,
+			Hash:         []byte{byte(height % 256)},
+			VersionBlock: 11,
+			VersionApp:   1,
+			Time:         time.Date(2023, 7, 4, 3, 11, 26+int(height-1000), 0, time.UTC),
+		})
+	}
+	time.Sleep(time.Second)
+
+	block, err := s.storage.Blocks.Last(ctx)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1003, block.Height)
+
+	state, err := s.storage.State.ByName(ctx, testIndexerName)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1003, state.LastHeight)
+
+	s.Require().NoError(module.Close())
+}
+
 func TestSuiteModule_Run(t *testing.T) {
 	suite.Run(t, new(ModuleTestSuite))
 }
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	storageTypes "github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+const doubleSignReason = "double_sign"
+
+// saveValidatorHistory - applies block changes to validators in order of their occurrence,
+// saves history entries with previous values for rollback and updates validators' power and status.
+// Changes of validators which are unknown to the indexer are skipped.
+func saveValidatorHistory(
+	ctx context.Context,
+	tx storage.Transaction,
+	history []storage.ValidatorHistory,
+) error {
+	if len(history) == 0 {
+		return nil
+	}
+
+	addresses := make([]pkgTypes.Hex, len(history))
+	for i := range history {
+		addresses[i] = history[i].ConsAddress
+	}
+	byAddress, err := validatorsByConsAddress(ctx, tx, addresses)
+	if err != nil {
+		return err
+	}
+
+	var (
+		entries = make([]storage.ValidatorHistory, 0, len(history))
+		updated = make([]*storage.Validator, 0)
+		changed = make(map[uint64]struct{})
+	)
+	for i := range history {
+		validator, ok := byAddress[history[i].ConsAddress.String()]
+		if !ok {
+			continue
+		}
+
+		entry := history[i]
+		entry.ValidatorId = validator.Id
+		entry.PrevPower = validator.Power
+		entry.PrevStatus = validator.Status
+		applyValidatorChange(validator, entry)
+		entry.Power = validator.Power
+		entry.Status = validator.Status
+		entries = append(entries, entry)
+
+		if _, ok := changed[validator.Id]; !ok {
+			changed[validator.Id] = struct{}{}
+			updated = append(updated, validator)
+		}
+	}
+
+	if err := tx.SaveValidatorHistory(ctx, entries...); err != nil {
+		return err
+	}
+	return tx.UpdateValidators(ctx, updated...)
+}
+
+// saveBlockSignatures - links signatures from the last commit to validators and saves them.
+// Signatures of validators which are unknown to the indexer are skipped.
+func saveBlockSignatures(
+	ctx context.Context,
+	tx storage.Transaction,
+	signatures []storage.BlockSignature,
+) error {
+	if len(signatures) == 0 {
+		return nil
+	}
+
+	addresses := make([]pkgTypes.Hex, len(signatures))
+	for i := range signatures {
+		addresses[i] = signatures[i].ConsAddress
+	}
+	byAddress, err := validatorsByConsAddress(ctx, tx, addresses)
+	if err != nil {
+		return err
+	}
+
+	entries := make([]storage.BlockSignature, 0, len(signatures))
+	for i := range signatures {
+		validator, ok := byAddress[signatures[i].ConsAddress.String()]
+		if !ok {
+			continue
+		}
+		signatures[i].ValidatorId = validator.Id
+		entries = append(entries, signatures[i])
+	}
+	return tx.SaveBlockSignatures(ctx, entries...)
+}
+
+// saveEvidence - links evidence to accused validators and saves it. Evidence against unknown validators is saved without link.
+func saveEvidence(
+	ctx context.Context,
+	tx storage.Transaction,
+	evidence []storage.Evidence,
+) error {
+	if len(evidence) == 0 {
+		return nil
+	}
+
+	addresses := make([]pkgTypes.Hex, len(evidence))
+	for i := range evidence {
+		addresses[i] = evidence[i].ConsAddress
+	}
+	byAddress, err := validatorsByConsAddress(ctx, tx, addresses)
+	if err != nil {
+		return err
+	}
+
+	for i := range evidence {
+		if validator, ok := byAddress[evidence[i].ConsAddress.String()]; ok {
+			evidence[i].ValidatorId = validator.Id
+		}
+	}
+	return tx.SaveEvidence(ctx, evidence...)
+}
+
+// validatorsByConsAddress - returns known validators with the consensus addresses mapped by hex string of the address
+func validatorsByConsAddress(ctx context.Context, tx storage.Transaction, consAddresses []pkgTypes.Hex) (map[string]*storage.Validator, error) {
+	var (
+		addresses = make([][]byte, 0)
+		unique    = make(map[string]struct{})
+	)
+	for i := range consAddresses {
+		key := consAddresses[i].String()
+		if _, ok := unique[key]; ok {
+			continue
+		}
+		unique[key] = struct{}{}
+		addresses = append(addresses, consAddresses[i])
+	}
+
+	validators, err := tx.ValidatorsByConsAddress(ctx, addresses...)
+	if err != nil {
+		return nil, err
+	}
+
+	byAddress := make(map[string]*storage.Validator, len(validators))
+	for i := range validators {
+		byAddress[validators[i].ConsAddress.String()] = &validators[i]
+	}
+	return byAddress, nil
+}
+
+// applyValidatorChange - changes validator's power and status:
+//   - validator with positive power is bonded;
+//   - validator losing its power is unbonding if it is not jailed or tombstoned;
+//   - double signing tombstones validator;
+//   - jailing does not change status of tombstoned validator.
+func applyValidatorChange(validator *storage.Validator, entry storage.ValidatorHistory) {
+	switch entry.Type {
+	case storageTypes.ValidatorHistoryTypePowerChange:
+		validator.Power = entry.Power
+		switch {
+		case entry.Power > 0:
+			validator.Status = storageTypes.ValidatorStatusBonded
+		case validator.Status != storageTypes.ValidatorStatusJailed && validator.Status != storageTypes.ValidatorStatusTombstoned:
+			validator.Status = storageTypes.ValidatorStatusUnbonding
+		}
+	case storageTypes.ValidatorHistoryTypeSlash:
+		if entry.Reason == doubleSignReason {
+			validator.Status = storageTypes.ValidatorStatusTombstoned
+		}
+	case storageTypes.ValidatorHistoryTypeJail:
+		if validator.Status != storageTypes.ValidatorStatusTombstoned {
+			validator.Status = storageTypes.ValidatorStatusJailed
+		}
+	}
+}
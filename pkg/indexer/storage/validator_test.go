@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/mock"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_saveValidatorHistory(t *testing.T) {
+	var (
+		consAddress1 = pkgTypes.Hex{0x01}
+		consAddress2 = pkgTypes.Hex{0x02}
+		unknown      = pkgTypes.Hex{0x03}
+	)
+
+	history := []storage.ValidatorHistory{
+		{Height: 100, Type: types.ValidatorHistoryTypeSlash, Reason: "missing_signature", SlashPower: 10, ConsAddress: consAddress1},
+		{Height: 100, Type: types.ValidatorHistoryTypeJail, ConsAddress: consAddress1},
+		{Height: 100, Type: types.ValidatorHistoryTypePowerChange, Power: 0, ConsAddress: consAddress1},
+		{Height: 100, Type: types.ValidatorHistoryTypePowerChange, Power: 20, ConsAddress: consAddress2},
+		{Height: 100, Type: types.ValidatorHistoryTypePowerChange, Power: 30, ConsAddress: unknown},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	tx.EXPECT().
+		ValidatorsByConsAddress(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, addresses ...[]byte) ([]storage.Validator, error) {
+			require.Len(t, addresses, 3)
+			return []storage.Validator{
+				{Id: 1, ConsAddress: consAddress1, Power: 10, Status: types.ValidatorStatusBonded},
+				{Id: 2, ConsAddress: consAddress2, Power: 0, Status: types.ValidatorStatusUnbonded},
+			}, nil
+		})
+	tx.EXPECT().
+		SaveValidatorHistory(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, entries ...storage.ValidatorHistory) error {
+			require.Len(t, entries, 4)
+
+			require.EqualValues(t, 1, entries[0].ValidatorId)
+			require.EqualValues(t, 10, entries[0].PrevPower)
+			require.EqualValues(t, 10, entries[0].Power)
+			require.Equal(t, types.ValidatorStatusBonded, entries[0].PrevStatus)
+			require.Equal(t, types.ValidatorStatusBonded, entries[0].Status)
+
+			require.Equal(t, types.ValidatorStatusBonded, entries[1].PrevStatus)
+			require.Equal(t, types.ValidatorStatusJailed, entries[1].Status)
+
+			require.EqualValues(t, 10, entries[2].PrevPower)
+			require.EqualValues(t, 0, entries[2].Power)
+			require.Equal(t, types.ValidatorStatusJailed, entries[2].Status)
+
+			require.EqualValues(t, 2, entries[3].ValidatorId)
+			require.EqualValues(t, 0, entries[3].PrevPower)
+			require.EqualValues(t, 20, entries[3].Power)
+			require.Equal(t, types.ValidatorStatusUnbonded, entries[3].PrevStatus)
+			require.Equal(t, types.ValidatorStatusBonded, entries[3].Status)
+			return nil
+		})
+	tx.EXPECT().
+		UpdateValidators(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, validators ...*storage.Validator) error {
+			require.Len(t, validators, 2)
+			require.EqualValues(t, 1, validators[0].Id)
+			require.EqualValues(t, 0, validators[0].Power)
+			require.Equal(t, types.ValidatorStatusJailed, validators[0].Status)
+			require.EqualValues(t, 2, validators[1].Id)
+			require.EqualValues(t, 20, validators[1].Power)
+			require.Equal(t, types.ValidatorStatusBonded, validators[1].Status)
+			return nil
+		})
+
+	err := saveValidatorHistory(context.Background(), tx, history)
+	require.NoError(t, err)
+}
+
+func Test_applyValidatorChange(t *testing.T) {
+	tests := []struct {
+		name       string
+		validator  storage.Validator
+		entry      storage.ValidatorHistory
+		wantPower  int64
+		wantStatus types.ValidatorStatus
+	}{
+		{
+			name:       "unbonding after power loss",
+			validator:  storage.Validator{Power: 10, Status: types.ValidatorStatusBonded},
+			entry:      storage.ValidatorHistory{Type: types.ValidatorHistoryTypePowerChange, Power: 0},
+			wantPower:  0,
+			wantStatus: types.ValidatorStatusUnbonding,
+		}, {
+			name:       "bonded after unjail",
+			validator:  storage.Validator{Power: 0, Status: types.ValidatorStatusJailed},
+			entry:      storage.ValidatorHistory{Type: types.ValidatorHistoryTypePowerChange, Power: 5},
+			wantPower:  5,
+			wantStatus: types.ValidatorStatusBonded,
+		}, {
+			name:       "double sign",
+			validator:  storage.Validator{Power: 10, Status: types.ValidatorStatusBonded},
+			entry:      storage.ValidatorHistory{Type: types.ValidatorHistoryTypeSlash, Reason: "double_sign"},
+			wantPower:  10,
+			wantStatus: types.ValidatorStatusTombstoned,
+		}, {
+			name:       "jail tombstoned",
+			validator:  storage.Validator{Power: 10, Status: types.ValidatorStatusTombstoned},
+			entry:      storage.ValidatorHistory{Type: types.ValidatorHistoryTypeJail},
+			wantPower:  10,
+			wantStatus: types.ValidatorStatusTombstoned,
+		}, {
+			name:       "tombstoned loses power",
+			validator:  storage.Validator{Power: 10, Status: types.ValidatorStatusTombstoned},
+			entry:      storage.ValidatorHistory{Type: types.ValidatorHistoryTypePowerChange, Power: 0},
+			wantPower:  0,
+			wantStatus: types.ValidatorStatusTombstoned,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyValidatorChange(&tt.validator, tt.entry)
+			require.Equal(t, tt.wantPower, tt.validator.Power)
+			require.Equal(t, tt.wantStatus, tt.validator.Status)
+		})
+	}
+}
+
+func Test_saveBlockSignatures(t *testing.T) {
+	var (
+		consAddress1 = pkgTypes.Hex{0x01}
+		consAddress2 = pkgTypes.Hex{0x02}
+		unknown      = pkgTypes.Hex{0x03}
+	)
+
+	signatures := []storage.BlockSignature{
+		{Height: 99, ConsAddress: consAddress1},
+		{Height: 99, ConsAddress: unknown},
+		{Height: 99, ConsAddress: consAddress2},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	tx.EXPECT().
+		ValidatorsByConsAddress(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, addresses ...[]byte) ([]storage.Validator, error) {
+			require.Len(t, addresses, 3)
+			return []storage.Validator{
+				{Id: 1, ConsAddress: consAddress1},
+				{Id: 2, ConsAddress: consAddress2},
+			}, nil
+		})
+	tx.EXPECT().
+		SaveBlockSignatures(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, entries ...storage.BlockSignature) error {
+			require.Len(t, entries, 2)
+			require.EqualValues(t, 1, entries[0].ValidatorId)
+			require.EqualValues(t, 99, entries[0].Height)
+			require.EqualValues(t, 2, entries[1].ValidatorId)
+			return nil
+		})
+
+	err := saveBlockSignatures(context.Background(), tx, signatures)
+	require.NoError(t, err)
+}
+
+func Test_saveEvidence(t *testing.T) {
+	var (
+		consAddress = pkgTypes.Hex{0x01}
+		unknown     = pkgTypes.Hex{0x02}
+	)
+
+	evidence := []storage.Evidence{
+		{Height: 100, Type: types.EvidenceTypeDuplicateVote, ConsAddress: consAddress},
+		{Height: 100, Type: types.EvidenceTypeLightClientAttack, ConsAddress: unknown},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tx := mock.NewMockTransaction(ctrl)
+	tx.EXPECT().
+		ValidatorsByConsAddress(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return([]storage.Validator{
+			{Id: 1, ConsAddress: consAddress},
+		}, nil)
+	tx.EXPECT().
+		SaveEvidence(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, entries ...storage.Evidence) error {
+			require.Len(t, entries, 2)
+			require.EqualValues(t, 1, entries[0].ValidatorId)
+			require.EqualValues(t, 0, entries[1].ValidatorId)
+			require.Equal(t, unknown, entries[1].ConsAddress)
+			return nil
+		})
+
+	err := saveEvidence(context.Background(), tx, evidence)
+	require.NoError(t, err)
+}
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package verifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/pkg/node"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	sdk "github.com/dipdup-net/indexer-sdk/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+const txPageSize = 100
+
+// Parser - decodes block data received from node to storage model
+type Parser interface {
+	Parse(b types.BlockData) (storage.Block, error)
+}
+
+// Mismatch - difference between stored data and data received from node
+type Mismatch struct {
+	Height types.Level `json:"height"`
+	Field  string      `json:"field"`
+	Stored string      `json:"stored"`
+	Node   string      `json:"node"`
+}
+
+func (m Mismatch) String() string {
+	if m.Height == 0 {
+		return fmt.Sprintf("%s: stored=%s computed=%s", m.Field, m.Stored, m.Node)
+	}
+	return fmt.Sprintf("height %d %s: stored=%s node=%s", m.Height, m.Field, m.Stored, m.Node)
+}
+
+// Report - result of verification. Mismatches of state totals have zero height.
+type Report struct {
+	From       types.Level `json:"from"`
+	To         types.Level `json:"to"`
+	Mismatches []Mismatch  `json:"mismatches"`
+}
+
+// Ok - returns true if no mismatches were found
+func (r *Report) Ok() bool {
+	return len(r.Mismatches) == 0
+}
+
+func (r *Report) add(height types.Level, field string, stored, node any) {
+	r.Mismatches = append(r.Mismatches, Mismatch{
+		Height: height,
+		Field:  field,
+		Stored: fmt.Sprintf("%v", stored),
+		Node:   fmt.Sprintf("%v", node),
+	})
+}
+
+// Verifier - compares stored blocks with blocks received from node and state totals with data in tables
+type Verifier struct {
+	api       node.Api
+	parser    Parser
+	blocks    storage.IBlock
+	txs       storage.ITx
+	events    storage.IEvent
+	state     storage.IState
+	indexName string
+}
+
+func New(
+	api node.Api,
+	parser Parser,
+	blocks storage.IBlock,
+	txs storage.ITx,
+	events storage.IEvent,
+	state storage.IState,
+	indexName string,
+) Verifier {
+	return Verifier{
+		api:       api,
+		parser:    parser,
+		blocks:    blocks,
+		txs:       txs,
+		events:    events,
+		state:     state,
+		indexName: indexName,
+	}
+}
+
+// Verify - walks heights from `from` to `to` inclusively and checks state totals
+func (v Verifier) Verify(ctx context.Context, from, to types.Level) (Report, error) {
+	report := Report{
+		From: from,
+		To:   to,
+	}
+
+	for height := from; height <= to; height++ {
+		if err := ctx.Err(); err != nil {
+			return report, err
+		}
+		if err := v.verifyBlock(ctx, height, &report); err != nil {
+			return report, errors.Wrapf(err, "verifying block %d", height)
+		}
+		if height%1000 == 0 {
+			log.Info().Uint64("height", uint64(height)).Int("mismatches", len(report.Mismatches)).Msg("verifying...")
+		}
+	}
+
+	if err := v.verifyState(ctx, &report); err != nil {
+		return report, errors.Wrap(err, "verifying state")
+	}
+	return report, nil
+}
+
+func (v Verifier) verifyBlock(ctx context.Context, height types.Level, report *Report) error {
+	stored, err := v.blocks.ByHeightWithStats(ctx, height)
+	if err != nil {
+		if v.blocks.IsNoRows(err) {
+			report.add(height, "block", "not found", "exists")
+			return nil
+		}
+		return err
+	}
+
+	data, err := v.api.BlockData(ctx, height)
+	if err != nil {
+		return errors.Wrap(err, "receiving block from node")
+	}
+	expected, err := v.parser.Parse(data)
+	if err != nil {
+		return errors.Wrap(err, "parsing block")
+	}
+
+	if !bytes.Equal(stored.Hash, expected.Hash) {
+		report.add(height, "hash", stored.Hash, expected.Hash)
+	}
+	if !bytes.Equal(stored.ParentHash, expected.ParentHash) {
+		report.add(height, "parent_hash", stored.ParentHash, expected.ParentHash)
+	}
+	if stored.Stats.TxCount != expected.Stats.TxCount {
+		report.add(height, "tx_count", stored.Stats.TxCount, expected.Stats.TxCount)
+	}
+	if stored.Stats.EventsCount != expected.Stats.EventsCount {
+		report.add(height, "events_count", stored.Stats.EventsCount, expected.Stats.EventsCount)
+	}
+	if stored.Stats.BlobsSize != expected.Stats.BlobsSize {
+		report.add(height, "blobs_size", stored.Stats.BlobsSize, expected.Stats.BlobsSize)
+	}
+	if !stored.Stats.Fee.Equal(expected.Stats.Fee) {
+		report.add(height, "fee", stored.Stats.Fee, expected.Stats.Fee)
+	}
+
+	events, err := v.events.ByBlock(ctx, height)
+	if err != nil {
+		return errors.Wrap(err, "receiving block events")
+	}
+	if len(events) != len(expected.Events) {
+		report.add(height, "block_events", len(events), len(expected.Events))
+	}
+
+	return v.verifyTxs(ctx, height, expected.Txs, report)
+}
+
+func (v Verifier) verifyTxs(ctx context.Context, height types.Level, expected []storage.Tx, report *Report) error {
+	txs, err := v.blockTxs(ctx, height)
+	if err != nil {
+		return errors.Wrap(err, "receiving block transactions")
+	}
+	if len(txs) != len(expected) {
+		report.add(height, "txs", len(txs), len(expected))
+		return nil
+	}
+
+	for i := range txs {
+		if !bytes.Equal(txs[i].Hash, expected[i].Hash) {
+			report.add(height, fmt.Sprintf("tx[%d].hash", i), types.Hex(txs[i].Hash), types.Hex(expected[i].Hash))
+		}
+		if txs[i].EventsCount != expected[i].EventsCount {
+			report.add(height, fmt.Sprintf("tx[%d].events_count", i), txs[i].EventsCount, expected[i].EventsCount)
+		}
+	}
+	return nil
+}
+
+func (v Verifier) blockTxs(ctx context.Context, height types.Level) ([]storage.Tx, error) {
+	var result []storage.Tx
+	for {
+		txs, err := v.txs.Filter(ctx, storage.TxFilter{
+			Height: uint64(height),
+			Limit:  txPageSize,
+			Offset: len(result),
+			Sort:   sdk.SortOrderAsc,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, txs...)
+		if len(txs) < txPageSize {
+			return result, nil
+		}
+	}
+}
+
+func (v Verifier) verifyState(ctx context.Context, report *Report) error {
+	state, err := v.state.ByName(ctx, v.indexName)
+	if err != nil {
+		return errors.Wrap(err, "receiving state")
+	}
+	totals, err := v.state.Totals(ctx)
+	if err != nil {
+		return errors.Wrap(err, "computing totals")
+	}
+
+	if state.TotalTx != totals.TxCount {
+		report.add(0, "state.total_tx", state.TotalTx, totals.TxCount)
+	}
+	if state.TotalBlobsSize != totals.BlobsSize {
+		report.add(0, "state.total_blobs_size", state.TotalBlobsSize, totals.BlobsSize)
+	}
+	if !state.TotalFee.Equal(totals.Fee) {
+		report.add(0, "state.total_fee", state.TotalFee, totals.Fee)
+	}
+	if state.TotalAccounts != totals.Accounts {
+		report.add(0, "state.total_accounts", state.TotalAccounts, totals.Accounts)
+	}
+	return nil
+}
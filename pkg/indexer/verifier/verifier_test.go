@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package verifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage"
+	"github.com/dipdup-io/celestia-indexer/internal/storage/mock"
+	nodeMock "github.com/dipdup-io/celestia-indexer/pkg/node/mock"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type testParser struct {
+	blocks map[types.Level]storage.Block
+}
+
+func (p testParser) Parse(b types.BlockData) (storage.Block, error) {
+	return p.blocks[b.Height], nil
+}
+
+func testBlock(height types.Level, fee int64, txHashes ...string) storage.Block {
+	block := storage.Block{
+		Height:     height,
+		Hash:       types.Hex{byte(height)},
+		ParentHash: types.Hex{byte(height - 1)},
+		Stats: storage.BlockStats{
+			TxCount:   int64(len(txHashes)),
+			BlobsSize: 100,
+			Fee:       decimal.NewFromInt(fee),
+		},
+	}
+	for i := range txHashes {
+		block.Txs = append(block.Txs, storage.Tx{
+			Height:      height,
+			Hash:        []byte(txHashes[i]),
+			EventsCount: 2,
+		})
+	}
+	return block
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	api := nodeMock.NewMockApi(ctrl)
+	blocks := mock.NewMockIBlock(ctrl)
+	txs := mock.NewMockITx(ctrl)
+	events := mock.NewMockIEvent(ctrl)
+	state := mock.NewMockIState(ctrl)
+
+	stored := map[types.Level]storage.Block{
+		1: testBlock(1, 10, "a"),
+		2: testBlock(2, 10, "b", "c"),
+	}
+	expected := testParser{
+		blocks: map[types.Level]storage.Block{
+			1: testBlock(1, 10, "a"),
+			2: testBlock(2, 20, "b", "d"),
+		},
+	}
+
+	blocks.EXPECT().ByHeightWithStats(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, height types.Level) (storage.Block, error) {
+			return stored[height], nil
+		},
+	).Times(2)
+	api.EXPECT().BlockData(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, height types.Level) (types.BlockData, error) {
+			return types.BlockData{ResultBlockResults: types.ResultBlockResults{Height: height}}, nil
+		},
+	).Times(2)
+	events.EXPECT().ByBlock(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+	txs.EXPECT().Filter(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fltrs storage.TxFilter) ([]storage.Tx, error) {
+			return stored[types.Level(fltrs.Height)].Txs, nil
+		},
+	).Times(2)
+
+	state.EXPECT().ByName(gomock.Any(), "test").Return(storage.State{
+		TotalTx:        3,
+		TotalBlobsSize: 200,
+		TotalFee:       decimal.NewFromInt(20),
+		TotalAccounts:  5,
+	}, nil).Times(1)
+	state.EXPECT().Totals(gomock.Any()).Return(storage.Totals{
+		TxCount:   3,
+		BlobsSize: 200,
+		Fee:       decimal.NewFromInt(20),
+		Accounts:  4,
+	}, nil).Times(1)
+
+	v := New(api, expected, blocks, txs, events, state, "test")
+	report, err := v.Verify(context.Background(), 1, 2)
+	require.NoError(t, err)
+	require.False(t, report.Ok())
+	require.Equal(t, []Mismatch{
+		{Height: 2, Field: "fee", Stored: "10", Node: "20"},
+		{Height: 2, Field: "tx[1].hash", Stored: types.Hex("c").String(), Node: types.Hex("d").String()},
+		{Height: 0, Field: "state.total_accounts", Stored: "5", Node: "4"},
+	}, report.Mismatches)
+}
+
+func TestVerifier_VerifyMissingBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	blocks := mock.NewMockIBlock(ctrl)
+	state := mock.NewMockIState(ctrl)
+
+	blocks.EXPECT().ByHeightWithStats(gomock.Any(), types.Level(1)).Return(storage.Block{}, context.DeadlineExceeded).Times(1)
+	blocks.EXPECT().IsNoRows(context.DeadlineExceeded).Return(true).Times(1)
+	state.EXPECT().ByName(gomock.Any(), "test").Return(storage.State{}, nil).Times(1)
+	state.EXPECT().Totals(gomock.Any()).Return(storage.Totals{}, nil).Times(1)
+
+	v := New(nil, testParser{}, blocks, nil, nil, state, "test")
+	report, err := v.Verify(context.Background(), 1, 1)
+	require.NoError(t, err)
+	require.Len(t, report.Mismatches, 1)
+	require.Equal(t, "block", report.Mismatches[0].Field)
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package indexer
+
+import (
+	"context"
+
+	"github.com/dipdup-io/celestia-indexer/internal/storage/postgres"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/config"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/parser"
+	"github.com/dipdup-io/celestia-indexer/pkg/indexer/verifier"
+	"github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// Verify - compares stored blocks in range `from`..`to` with blocks received from node and state totals with stored data.
+// Zero `from` means the start level, zero `to` means the last indexed height.
+func Verify(ctx context.Context, cfg config.Config, from, to types.Level) (verifier.Report, error) {
+	pg, err := postgres.Create(ctx, cfg.Database)
+	if err != nil {
+		return verifier.Report{}, errors.Wrap(err, "while creating pg context")
+	}
+	defer func() {
+		if err := pg.Close(); err != nil {
+			log.Err(err).Msg("closing database connection")
+		}
+	}()
+
+	state, err := loadState(pg, ctx, cfg.Indexer.Name)
+	if err != nil {
+		return verifier.Report{}, errors.Wrap(err, "while loading state")
+	}
+	if state == nil {
+		return verifier.Report{}, errors.Errorf("indexer state %s is not found", cfg.Indexer.Name)
+	}
+
+	if from == 0 {
+		from = 1
+		if cfg.Indexer.StartLevel > 0 {
+			from = types.Level(cfg.Indexer.StartLevel)
+		}
+	}
+	if to == 0 || to > state.LastHeight {
+		to = state.LastHeight
+	}
+	if to < from {
+		return verifier.Report{}, errors.Errorf("invalid height range: %d-%d", from, to)
+	}
+
+	api, err := createApi(cfg)
+	if err != nil {
+		return verifier.Report{}, errors.Wrap(err, "while creating node api")
+	}
+
+	parserModule := parser.NewModule(cfg.Indexer)
+	v := verifier.New(api, &parserModule, pg.Blocks, pg.Tx, pg.Event, pg.State, cfg.Indexer.Name)
+	return v.Verify(ctx, from, to)
+}
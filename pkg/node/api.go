@@ -19,6 +19,7 @@ type Api interface {
 	BlockResults(ctx context.Context, level pkgTypes.Level) (pkgTypes.ResultBlockResults, error)
 	Genesis(ctx context.Context) (types.Genesis, error)
 	BlockData(ctx context.Context, level pkgTypes.Level) (pkgTypes.BlockData, error)
+	BlockDataRange(ctx context.Context, from, to pkgTypes.Level) ([]pkgTypes.BlockData, error)
 }
 
 //go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
@@ -26,3 +27,9 @@ type DalApi interface {
 	Blobs(ctx context.Context, height pkgTypes.Level, hash ...string) ([]types.Blob, error)
 	Blob(ctx context.Context, height pkgTypes.Level, namespace, commitment string) (types.Blob, error)
 }
+
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock -typed
+type Subscriber interface {
+	Subscribe(ctx context.Context) (<-chan pkgTypes.Level, error)
+	Close() error
+}
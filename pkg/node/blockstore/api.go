@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package blockstore
+
+import (
+	"context"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/dipdup-io/celestia-indexer/pkg/node"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+)
+
+const (
+	blockStoreName = "blockstore"
+	stateStoreName = "state"
+)
+
+// key of genesis document in state database, see node.LoadStateFromDBOrGenesisDocProvider of CometBFT
+var genesisDocKey = []byte("genesisDoc")
+
+// API - node.Api which reads blocks and ABCI responses directly from `blockstore.db` and `state.db` of stopped node.
+// Returned data is the same as returned by node RPC.
+type API struct {
+	blocksDB dbm.DB
+	stateDB  dbm.DB
+	blocks   *store.BlockStore
+	state    sm.Store
+}
+
+var _ node.Api = (*API)(nil)
+
+// New - opens databases in node data directory in read-only mode
+func New(dir string) (*API, error) {
+	options := &opt.Options{
+		ReadOnly: true,
+	}
+	blocksDB, err := dbm.NewGoLevelDBWithOpts(blockStoreName, dir, options)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening %s database in %s", blockStoreName, dir)
+	}
+	stateDB, err := dbm.NewGoLevelDBWithOpts(stateStoreName, dir, options)
+	if err != nil {
+		_ = blocksDB.Close()
+		return nil, errors.Wrapf(err, "opening %s database in %s", stateStoreName, dir)
+	}
+	return newAPI(blocksDB, stateDB), nil
+}
+
+func newAPI(blocksDB, stateDB dbm.DB) *API {
+	return &API{
+		blocksDB: blocksDB,
+		stateDB:  stateDB,
+		blocks:   store.NewBlockStore(blocksDB),
+		state: sm.NewStore(stateDB, sm.StoreOptions{
+			DiscardABCIResponses: false,
+		}),
+	}
+}
+
+// Close - closes databases
+func (api *API) Close() error {
+	if err := api.blocksDB.Close(); err != nil {
+		return err
+	}
+	return api.stateDB.Close()
+}
+
+func (api *API) Status(ctx context.Context) (types.Status, error) {
+	var status types.Status
+
+	head := api.blocks.Height()
+	if head == 0 {
+		return status, errors.New("block store is empty")
+	}
+	latest := api.blocks.LoadBlockMeta(head)
+	if latest == nil {
+		return status, errors.Errorf("meta of block %d is not found", head)
+	}
+	status.NodeInfo.Network = latest.Header.ChainID
+	status.SyncInfo.LatestBlockHeight = pkgTypes.Level(head)
+	status.SyncInfo.LatestBlockHash = latest.BlockID.Hash
+	status.SyncInfo.LatestAppHash = latest.Header.AppHash
+	status.SyncInfo.LatestBlockTime = latest.Header.Time
+
+	if earliest := api.blocks.LoadBaseMeta(); earliest != nil {
+		status.SyncInfo.EarliestBlockHeight = pkgTypes.Level(earliest.Header.Height)
+		status.SyncInfo.EarliestBlockHash = earliest.BlockID.Hash
+		status.SyncInfo.EarliestAppHash = earliest.Header.AppHash
+		status.SyncInfo.EarliestBlockTime = earliest.Header.Time
+	}
+	return status, nil
+}
+
+func (api *API) Head(ctx context.Context) (pkgTypes.ResultBlock, error) {
+	return api.Block(ctx, 0)
+}
+
+func (api *API) Block(ctx context.Context, level pkgTypes.Level) (pkgTypes.ResultBlock, error) {
+	height := int64(level)
+	if height == 0 {
+		height = api.blocks.Height()
+	}
+
+	meta := api.blocks.LoadBlockMeta(height)
+	block := api.blocks.LoadBlock(height)
+	if meta == nil || block == nil {
+		return pkgTypes.ResultBlock{}, errors.Wrapf(types.ErrRequest, "height %d is not available, lowest height is %d", height, api.blocks.Base())
+	}
+	return resultBlock(meta.BlockID, block), nil
+}
+
+func (api *API) BlockResults(ctx context.Context, level pkgTypes.Level) (pkgTypes.ResultBlockResults, error) {
+	height := int64(level)
+	if height == 0 {
+		height = api.blocks.Height()
+	}
+
+	responses, err := api.state.LoadABCIResponses(height)
+	if err != nil {
+		return pkgTypes.ResultBlockResults{}, errors.Wrapf(types.ErrRequest, "could not find results for height %d: %s", height, err)
+	}
+	return resultBlockResults(height, responses), nil
+}
+
+func (api *API) Genesis(ctx context.Context) (types.Genesis, error) {
+	var genesis types.Genesis
+
+	data, err := api.stateDB.Get(genesisDocKey)
+	if err != nil {
+		return genesis, errors.Wrap(err, "reading genesis document")
+	}
+	if len(data) == 0 {
+		return genesis, errors.New("genesis document is not found in state database")
+	}
+	if err := json.Unmarshal(data, &genesis); err != nil {
+		return genesis, errors.Wrap(err, "decoding genesis document")
+	}
+	return genesis, nil
+}
+
+func (api *API) BlockData(ctx context.Context, level pkgTypes.Level) (pkgTypes.BlockData, error) {
+	block, err := api.Block(ctx, level)
+	if err != nil {
+		return pkgTypes.BlockData{}, err
+	}
+	results, err := api.BlockResults(ctx, level)
+	if err != nil {
+		return pkgTypes.BlockData{}, err
+	}
+	return pkgTypes.BlockData{
+		ResultBlock:        block,
+		ResultBlockResults: results,
+	}, nil
+}
+
+func (api *API) BlockDataRange(ctx context.Context, from, to pkgTypes.Level) ([]pkgTypes.BlockData, error) {
+	if to < from {
+		return nil, errors.Errorf("invalid range: %d-%d", from, to)
+	}
+
+	blocks := make([]pkgTypes.BlockData, 0, to-from+1)
+	for level := from; level <= to; level++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		block, err := api.BlockData(ctx, level)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
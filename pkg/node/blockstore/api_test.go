@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package blockstore
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmtstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	cmttypes "github.com/tendermint/tendermint/types"
+)
+
+const testChainId = "test-chain"
+
+func newTestAPI(t *testing.T) (*API, *cmttypes.Block) {
+	blocksDB := dbm.NewMemDB()
+	stateDB := dbm.NewMemDB()
+
+	block := cmttypes.MakeBlock(1, cmttypes.Data{
+		Txs: cmttypes.Txs{cmttypes.Tx("tx")},
+	}, &cmttypes.Commit{}, nil)
+	block.ChainID = testChainId
+	block.Time = time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+	block.ProposerAddress = make([]byte, 20)
+	parts := block.MakePartSet(cmttypes.BlockPartSizeBytes)
+	store.NewBlockStore(blocksDB).SaveBlock(block, parts, &cmttypes.Commit{Height: 1})
+
+	err := sm.NewStore(stateDB, sm.StoreOptions{}).SaveABCIResponses(1, &cmtstate.ABCIResponses{
+		DeliverTxs: []*abci.ResponseDeliverTx{
+			{
+				Code:      0,
+				Data:      []byte{0x01, 0x02},
+				GasWanted: 100,
+				GasUsed:   90,
+				Events: []abci.Event{
+					{
+						Type: "message",
+						Attributes: []abci.EventAttribute{
+							{Key: []byte("action"), Value: []byte("/cosmos.bank.v1beta1.MsgSend"), Index: true},
+						},
+					},
+				},
+			},
+		},
+		BeginBlock: &abci.ResponseBeginBlock{
+			Events: []abci.Event{{Type: "mint"}},
+		},
+		EndBlock: &abci.ResponseEndBlock{},
+	})
+	require.NoError(t, err)
+
+	err = stateDB.Set(genesisDocKey, []byte(`{"genesis_time":"2023-10-01T00:00:00Z","chain_id":"test-chain","initial_height":"1","app_hash":""}`))
+	require.NoError(t, err)
+
+	return newAPI(blocksDB, stateDB), block
+}
+
+func TestAPI_BlockData(t *testing.T) {
+	api, block := newTestAPI(t)
+
+	data, err := api.BlockData(context.Background(), 1)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 1, data.Height)
+	require.EqualValues(t, block.Hash(), data.BlockID.Hash)
+	require.EqualValues(t, 1, data.Block.Height)
+	require.Equal(t, testChainId, data.Block.ChainID)
+	require.Equal(t, block.Time, data.Block.Time)
+	require.EqualValues(t, block.LastCommitHash, data.Block.LastCommitHash)
+	require.NotNil(t, data.Block.LastCommit)
+	require.Len(t, data.Block.Txs, 1)
+
+	require.Len(t, data.TxsResults, 1)
+	tx := data.TxsResults[0]
+	require.EqualValues(t, 100, tx.GasWanted)
+	require.EqualValues(t, 90, tx.GasUsed)
+	require.JSONEq(t, `"AQI="`, string(tx.Data))
+	require.Len(t, tx.Events, 1)
+	require.Equal(t, "message", tx.Events[0].Type)
+	require.Equal(t, base64.StdEncoding.EncodeToString([]byte("action")), tx.Events[0].Attributes[0].Key)
+	require.True(t, tx.Events[0].Attributes[0].Index)
+
+	require.Len(t, data.BeginBlockEvents, 1)
+	require.Equal(t, "mint", data.BeginBlockEvents[0].Type)
+	require.Empty(t, data.EndBlockEvents)
+}
+
+func TestAPI_Status(t *testing.T) {
+	api, block := newTestAPI(t)
+
+	status, err := api.Status(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, status.SyncInfo.LatestBlockHeight)
+	require.EqualValues(t, block.Hash(), status.SyncInfo.LatestBlockHash)
+	require.EqualValues(t, 1, status.SyncInfo.EarliestBlockHeight)
+	require.Equal(t, testChainId, status.NodeInfo.Network)
+
+	head, err := api.Head(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, head.Block.Height)
+}
+
+func TestAPI_Genesis(t *testing.T) {
+	api, _ := newTestAPI(t)
+
+	genesis, err := api.Genesis(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, testChainId, genesis.ChainID)
+	require.EqualValues(t, 1, genesis.InitialHeight)
+}
+
+func TestAPI_MissingHeight(t *testing.T) {
+	api, _ := newTestAPI(t)
+
+	_, err := api.BlockData(context.Background(), 2)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrRequest))
+
+	_, err = api.BlockDataRange(context.Background(), 1, 2)
+	require.Error(t, err)
+
+	blocks, err := api.BlockDataRange(context.Background(), 1, 1)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	require.Equal(t, pkgTypes.Level(1), blocks[0].Height)
+}
@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package blockstore
+
+import (
+	"encoding/base64"
+
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/goccy/go-json"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmtstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	cmttypes "github.com/tendermint/tendermint/types"
+)
+
+func resultBlock(id cmttypes.BlockID, block *cmttypes.Block) pkgTypes.ResultBlock {
+	return pkgTypes.ResultBlock{
+		BlockID: pkgTypes.BlockId{
+			Hash: pkgTypes.Hex(id.Hash),
+		},
+		Block: &pkgTypes.Block{
+			Header: pkgTypes.Header{
+				Version: pkgTypes.Consensus{
+					Block: block.Version.Block,
+					App:   block.Version.App,
+				},
+				ChainID: block.ChainID,
+				Height:  block.Height,
+				Time:    block.Time,
+				LastBlockID: pkgTypes.BlockId{
+					Hash: pkgTypes.Hex(block.LastBlockID.Hash),
+				},
+				LastCommitHash:     pkgTypes.Hex(block.LastCommitHash),
+				DataHash:           pkgTypes.Hex(block.DataHash),
+				ValidatorsHash:     pkgTypes.Hex(block.ValidatorsHash),
+				NextValidatorsHash: pkgTypes.Hex(block.NextValidatorsHash),
+				ConsensusHash:      pkgTypes.Hex(block.ConsensusHash),
+				AppHash:            pkgTypes.Hex(block.AppHash),
+				LastResultsHash:    pkgTypes.Hex(block.LastResultsHash),
+				EvidenceHash:       pkgTypes.Hex(block.EvidenceHash),
+				ProposerAddress:    pkgTypes.Hex(block.ProposerAddress),
+			},
+			Data: pkgTypes.Data{
+				Txs:        block.Data.Txs,
+				SquareSize: block.Data.SquareSize,
+			},
+			Evidence:   evidence(block.Evidence.Evidence),
+			LastCommit: lastCommit(block.LastCommit),
+		},
+	}
+}
+
+func evidence(list cmttypes.EvidenceList) pkgTypes.EvidenceData {
+	result := pkgTypes.EvidenceData{
+		Evidence: make([]pkgTypes.Evidence, 0, len(list)),
+	}
+	for _, ev := range list {
+		switch typ := ev.(type) {
+		case *cmttypes.DuplicateVoteEvidence:
+			result.Evidence = append(result.Evidence, pkgTypes.Evidence{
+				Type: pkgTypes.EvidenceTypeDuplicateVote,
+				Value: pkgTypes.EvidenceValue{
+					VoteA:            vote(typ.VoteA),
+					VoteB:            vote(typ.VoteB),
+					ValidatorPower:   typ.ValidatorPower,
+					TotalVotingPower: typ.TotalVotingPower,
+					Timestamp:        typ.Timestamp,
+				},
+			})
+		case *cmttypes.LightClientAttackEvidence:
+			validators := make([]pkgTypes.EvidenceValidator, len(typ.ByzantineValidators))
+			for i := range typ.ByzantineValidators {
+				validators[i] = pkgTypes.EvidenceValidator{
+					Address:     pkgTypes.Hex(typ.ByzantineValidators[i].Address),
+					VotingPower: typ.ByzantineValidators[i].VotingPower,
+				}
+			}
+			result.Evidence = append(result.Evidence, pkgTypes.Evidence{
+				Type: pkgTypes.EvidenceTypeLightClientAttack,
+				Value: pkgTypes.EvidenceValue{
+					CommonHeight:        typ.CommonHeight,
+					ByzantineValidators: validators,
+					TotalVotingPower:    typ.TotalVotingPower,
+					Timestamp:           typ.Timestamp,
+				},
+			})
+		}
+	}
+	return result
+}
+
+func vote(v *cmttypes.Vote) *pkgTypes.Vote {
+	if v == nil {
+		return nil
+	}
+	return &pkgTypes.Vote{
+		Type:   int32(v.Type),
+		Height: v.Height,
+		Round:  v.Round,
+		BlockID: pkgTypes.BlockId{
+			Hash: pkgTypes.Hex(v.BlockID.Hash),
+		},
+		Timestamp:        v.Timestamp,
+		ValidatorAddress: pkgTypes.Hex(v.ValidatorAddress),
+		ValidatorIndex:   v.ValidatorIndex,
+		Signature:        v.Signature,
+	}
+}
+
+func lastCommit(commit *cmttypes.Commit) *pkgTypes.Commit {
+	if commit == nil {
+		return nil
+	}
+	result := &pkgTypes.Commit{
+		Height: commit.Height,
+		Round:  commit.Round,
+		BlockID: pkgTypes.BlockId{
+			Hash: pkgTypes.Hex(commit.BlockID.Hash),
+		},
+		Signatures: make([]pkgTypes.CommitSig, len(commit.Signatures)),
+	}
+	for i, sig := range commit.Signatures {
+		result.Signatures[i] = pkgTypes.CommitSig{
+			BlockIDFlag:      pkgTypes.BlockIDFlag(sig.BlockIDFlag),
+			ValidatorAddress: pkgTypes.Hex(sig.ValidatorAddress),
+			Timestamp:        sig.Timestamp,
+			Signature:        sig.Signature,
+		}
+	}
+	return result
+}
+
+func resultBlockResults(height int64, responses *cmtstate.ABCIResponses) pkgTypes.ResultBlockResults {
+	results := pkgTypes.ResultBlockResults{
+		Height:     pkgTypes.Level(height),
+		TxsResults: make([]*pkgTypes.ResponseDeliverTx, len(responses.DeliverTxs)),
+	}
+	for i, tx := range responses.DeliverTxs {
+		results.TxsResults[i] = responseDeliverTx(tx)
+	}
+	if responses.BeginBlock != nil {
+		results.BeginBlockEvents = events(responses.BeginBlock.Events)
+	}
+	if responses.EndBlock != nil {
+		results.EndBlockEvents = events(responses.EndBlock.Events)
+		results.ValidatorUpdates = validatorUpdates(responses.EndBlock.ValidatorUpdates)
+		results.ConsensusParamUpdates = consensusParams(responses.EndBlock.ConsensusParamUpdates)
+	}
+	return results
+}
+
+func responseDeliverTx(tx *abci.ResponseDeliverTx) *pkgTypes.ResponseDeliverTx {
+	if tx == nil {
+		return nil
+	}
+	result := &pkgTypes.ResponseDeliverTx{
+		Code:      tx.Code,
+		Log:       tx.Log,
+		Info:      tx.Info,
+		GasWanted: tx.GasWanted,
+		GasUsed:   tx.GasUsed,
+		Events:    events(tx.Events),
+		Codespace: tx.Codespace,
+	}
+	// node RPC returns bytes as base64 string
+	if len(tx.Data) > 0 {
+		if data, err := json.Marshal(tx.Data); err == nil {
+			result.Data = data
+		}
+	}
+	return result
+}
+
+// events - converts ABCI events. Attributes are encoded to base64 as they are returned by node RPC.
+func events(events []abci.Event) []pkgTypes.Event {
+	if len(events) == 0 {
+		return nil
+	}
+	result := make([]pkgTypes.Event, len(events))
+	for i := range events {
+		result[i].Type = events[i].Type
+		if len(events[i].Attributes) == 0 {
+			continue
+		}
+		result[i].Attributes = make([]pkgTypes.EventAttribute, len(events[i].Attributes))
+		for j, attr := range events[i].Attributes {
+			result[i].Attributes[j] = pkgTypes.EventAttribute{
+				Key:   base64.StdEncoding.EncodeToString(attr.Key),
+				Value: base64.StdEncoding.EncodeToString(attr.Value),
+				Index: attr.Index,
+			}
+		}
+	}
+	return result
+}
+
+func validatorUpdates(updates []abci.ValidatorUpdate) []pkgTypes.ValidatorUpdate {
+	if len(updates) == 0 {
+		return nil
+	}
+	result := make([]pkgTypes.ValidatorUpdate, len(updates))
+	for i := range updates {
+		result[i].Power = updates[i].Power
+		result[i].PubKey.Sum.Value = pkgTypes.PublicKeyValue{
+			Ed25519:   updates[i].PubKey.GetEd25519(),
+			Secp256k1: updates[i].PubKey.GetSecp256K1(),
+		}
+		switch {
+		case len(result[i].PubKey.Sum.Value.Ed25519) > 0:
+			result[i].PubKey.Sum.Type = "tendermint.crypto.PublicKey_Ed25519"
+		case len(result[i].PubKey.Sum.Value.Secp256k1) > 0:
+			result[i].PubKey.Sum.Type = "tendermint.crypto.PublicKey_Secp256K1"
+		}
+	}
+	return result
+}
+
+func consensusParams(params *abci.ConsensusParams) *pkgTypes.ConsensusParams {
+	if params == nil {
+		return nil
+	}
+	result := new(pkgTypes.ConsensusParams)
+	if params.Block != nil {
+		result.Block = &pkgTypes.BlockParams{
+			MaxBytes: params.Block.MaxBytes,
+			MaxGas:   params.Block.MaxGas,
+		}
+	}
+	if params.Evidence != nil {
+		result.Evidence = &pkgTypes.EvidenceParams{
+			MaxAgeNumBlocks: params.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  params.Evidence.MaxAgeDuration,
+			MaxBytes:        params.Evidence.MaxBytes,
+		}
+	}
+	if params.Validator != nil {
+		result.Validator = &pkgTypes.ValidatorParams{
+			PubKeyTypes: params.Validator.PubKeyTypes,
+		}
+	}
+	if params.Version != nil {
+		result.Version = &pkgTypes.VersionParams{
+			AppVersion: params.Version.AppVersion,
+		}
+	}
+	return result
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package failover
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/node"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+type endpoint struct {
+	name  string
+	api   node.Api
+	stats *stats
+}
+
+// API - implementation of node.Api over several endpoints. Requests are routed to the healthiest endpoint
+// which has requested level and are retried on other endpoints in case of failure.
+type API struct {
+	endpoints []endpoint
+	log       zerolog.Logger
+}
+
+var _ node.Api = (*API)(nil)
+
+func New(apis map[string]node.Api) *API {
+	names := make([]string, 0, len(apis))
+	for name := range apis {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	endpoints := make([]endpoint, len(names))
+	for i := range names {
+		endpoints[i] = endpoint{
+			name:  names[i],
+			api:   apis[names[i]],
+			stats: newStats(),
+		}
+	}
+
+	return &API{
+		endpoints: endpoints,
+		log:       log.With().Str("module", "node failover").Logger(),
+	}
+}
+
+// Status - requests status from all endpoints and returns the one with the highest head,
+// so lagging endpoint can't make the indexer consider itself synced
+func (api *API) Status(ctx context.Context) (types.Status, error) {
+	var (
+		wg       sync.WaitGroup
+		statuses = make([]types.Status, len(api.endpoints))
+		errs     = make([]error, len(api.endpoints))
+	)
+
+	for i := range api.endpoints {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			e := api.endpoints[i]
+
+			start := time.Now()
+			status, err := e.api.Status(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					e.stats.failure()
+				}
+				errs[i] = err
+				return
+			}
+			e.stats.success(time.Since(start))
+			e.stats.setHead(status.SyncInfo.LatestBlockHeight)
+			statuses[i] = status
+		}(i)
+	}
+	wg.Wait()
+
+	best := -1
+	lastErr := errors.New("no endpoints")
+	for i := range statuses {
+		if errs[i] != nil {
+			api.log.Err(errs[i]).Str("endpoint", api.endpoints[i].name).Msg("status request")
+			lastErr = errors.Wrap(errs[i], api.endpoints[i].name)
+			continue
+		}
+		if best < 0 || statuses[i].SyncInfo.LatestBlockHeight > statuses[best].SyncInfo.LatestBlockHeight {
+			best = i
+		}
+	}
+	if best < 0 {
+		return types.Status{}, errors.Wrap(lastErr, "status request failed on all endpoints")
+	}
+	return statuses[best], nil
+}
+
+func (api *API) Head(ctx context.Context) (pkgTypes.ResultBlock, error) {
+	return call(ctx, api, 0, func(ctx context.Context, a node.Api) (pkgTypes.ResultBlock, error) {
+		return a.Head(ctx)
+	})
+}
+
+func (api *API) Block(ctx context.Context, level pkgTypes.Level) (pkgTypes.ResultBlock, error) {
+	return call(ctx, api, level, func(ctx context.Context, a node.Api) (pkgTypes.ResultBlock, error) {
+		return a.Block(ctx, level)
+	})
+}
+
+func (api *API) BlockResults(ctx context.Context, level pkgTypes.Level) (pkgTypes.ResultBlockResults, error) {
+	return call(ctx, api, level, func(ctx context.Context, a node.Api) (pkgTypes.ResultBlockResults, error) {
+		return a.BlockResults(ctx, level)
+	})
+}
+
+func (api *API) Genesis(ctx context.Context) (types.Genesis, error) {
+	return call(ctx, api, 0, func(ctx context.Context, a node.Api) (types.Genesis, error) {
+		return a.Genesis(ctx)
+	})
+}
+
+func (api *API) BlockData(ctx context.Context, level pkgTypes.Level) (pkgTypes.BlockData, error) {
+	return call(ctx, api, level, func(ctx context.Context, a node.Api) (pkgTypes.BlockData, error) {
+		return a.BlockData(ctx, level)
+	})
+}
+
+func (api *API) BlockDataRange(ctx context.Context, from, to pkgTypes.Level) ([]pkgTypes.BlockData, error) {
+	return call(ctx, api, to, func(ctx context.Context, a node.Api) ([]pkgTypes.BlockData, error) {
+		return a.BlockDataRange(ctx, from, to)
+	})
+}
+
+// candidates - returns endpoints ordered by priority: healthy ones which have the level, then the rest by score.
+// Unhealthy endpoints are kept at the tail to be used when all others fail.
+func (api *API) candidates(level pkgTypes.Level) []endpoint {
+	snapshots := make([]stats, len(api.endpoints))
+	var bestHead pkgTypes.Level
+	for i := range api.endpoints {
+		snapshots[i] = api.endpoints[i].stats.snapshot()
+		if snapshots[i].head > bestHead {
+			bestHead = snapshots[i].head
+		}
+	}
+
+	priority := func(s stats) int {
+		p := 0
+		if !s.healthy(bestHead) {
+			p += 2
+		}
+		if s.head < level {
+			p += 1
+		}
+		return p
+	}
+
+	indices := make([]int, len(api.endpoints))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		left, right := snapshots[indices[i]], snapshots[indices[j]]
+		if pl, pr := priority(left), priority(right); pl != pr {
+			return pl < pr
+		}
+		return left.score() < right.score()
+	})
+
+	result := make([]endpoint, len(indices))
+	for i := range indices {
+		result[i] = api.endpoints[indices[i]]
+	}
+	return result
+}
+
+func call[T any](ctx context.Context, api *API, level pkgTypes.Level, request func(ctx context.Context, a node.Api) (T, error)) (T, error) {
+	var (
+		result  T
+		lastErr = errors.New("no endpoints")
+	)
+
+	for _, e := range api.candidates(level) {
+		start := time.Now()
+		response, err := request(ctx, e.api)
+		if err != nil {
+			if ctx.Err() != nil {
+				return result, err
+			}
+			e.stats.failure()
+			api.log.Warn().Err(err).Str("endpoint", e.name).Msg("request failed, try next endpoint")
+			lastErr = errors.Wrap(err, e.name)
+			continue
+		}
+		e.stats.success(time.Since(start))
+		e.stats.setHead(level)
+		return response, nil
+	}
+
+	return result, errors.Wrap(lastErr, "request failed on all endpoints")
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package failover
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/node"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/mock"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func status(head pkgTypes.Level) types.Status {
+	return types.Status{
+		SyncInfo: types.SyncInfo{
+			LatestBlockHeight: head,
+		},
+	}
+}
+
+func blockData(level pkgTypes.Level) pkgTypes.BlockData {
+	return pkgTypes.BlockData{
+		ResultBlock: pkgTypes.ResultBlock{
+			Block: &pkgTypes.Block{
+				Header: pkgTypes.Header{
+					Height: int64(level),
+				},
+			},
+		},
+		ResultBlockResults: pkgTypes.ResultBlockResults{
+			Height: level,
+		},
+	}
+}
+
+func TestAPI_StatusReturnsHighestHead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	first := mock.NewMockApi(ctrl)
+	second := mock.NewMockApi(ctrl)
+	third := mock.NewMockApi(ctrl)
+
+	first.EXPECT().Status(gomock.Any()).Return(status(100), nil).Times(1)
+	second.EXPECT().Status(gomock.Any()).Return(status(120), nil).Times(1)
+	third.EXPECT().Status(gomock.Any()).Return(types.Status{}, errors.New("connection refused")).Times(1)
+
+	api := New(map[string]node.Api{
+		"first":  first,
+		"second": second,
+		"third":  third,
+	})
+
+	result, err := api.Status(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 120, result.SyncInfo.LatestBlockHeight)
+}
+
+func TestAPI_StatusFailsOnAllEndpoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	first := mock.NewMockApi(ctrl)
+	second := mock.NewMockApi(ctrl)
+
+	first.EXPECT().Status(gomock.Any()).Return(types.Status{}, errors.New("timeout")).Times(1)
+	second.EXPECT().Status(gomock.Any()).Return(types.Status{}, errors.New("timeout")).Times(1)
+
+	api := New(map[string]node.Api{
+		"first":  first,
+		"second": second,
+	})
+
+	_, err := api.Status(context.Background())
+	require.Error(t, err)
+}
+
+func TestAPI_BlockDataRoutesToEndpointWithLevel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	lagging := mock.NewMockApi(ctrl)
+	synced := mock.NewMockApi(ctrl)
+
+	lagging.EXPECT().Status(gomock.Any()).Return(status(90), nil).Times(1)
+	synced.EXPECT().Status(gomock.Any()).Return(status(100), nil).Times(1)
+	synced.EXPECT().BlockData(gomock.Any(), pkgTypes.Level(95)).Return(blockData(95), nil).Times(1)
+
+	api := New(map[string]node.Api{
+		"a_lagging": lagging,
+		"b_synced":  synced,
+	})
+
+	_, err := api.Status(context.Background())
+	require.NoError(t, err)
+
+	block, err := api.BlockData(context.Background(), 95)
+	require.NoError(t, err)
+	require.EqualValues(t, 95, block.Height)
+}
+
+func TestAPI_BlockDataFailover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	broken := mock.NewMockApi(ctrl)
+	healthy := mock.NewMockApi(ctrl)
+
+	broken.EXPECT().BlockData(gomock.Any(), gomock.Any()).Return(pkgTypes.BlockData{}, errors.New("503")).Times(1)
+	healthy.EXPECT().BlockData(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, level pkgTypes.Level) (pkgTypes.BlockData, error) {
+			return blockData(level), nil
+		},
+	).Times(10)
+
+	api := New(map[string]node.Api{
+		"a_broken":  broken,
+		"b_healthy": healthy,
+	})
+
+	for level := pkgTypes.Level(1); level <= 10; level++ {
+		block, err := api.BlockData(context.Background(), level)
+		require.NoError(t, err)
+		require.EqualValues(t, level, block.Height)
+	}
+
+	// broken endpoint is deprioritized after the first failure
+	candidates := api.candidates(11)
+	require.Equal(t, "b_healthy", candidates[0].name)
+}
+
+func TestAPI_BlockDataFailsOnAllEndpoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	first := mock.NewMockApi(ctrl)
+	second := mock.NewMockApi(ctrl)
+
+	first.EXPECT().BlockData(gomock.Any(), pkgTypes.Level(1)).Return(pkgTypes.BlockData{}, errors.New("503")).Times(1)
+	second.EXPECT().BlockData(gomock.Any(), pkgTypes.Level(1)).Return(pkgTypes.BlockData{}, errors.New("503")).Times(1)
+
+	api := New(map[string]node.Api{
+		"first":  first,
+		"second": second,
+	})
+
+	_, err := api.BlockData(context.Background(), 1)
+	require.Error(t, err)
+}
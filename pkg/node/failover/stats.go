@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package failover
+
+import (
+	"sync"
+	"time"
+
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+)
+
+const (
+	// smoothing factor of exponential moving averages
+	alpha = 0.2
+	// endpoint with greater error rate is considered unhealthy
+	maxErrorRate = 0.5
+	// endpoint which head is behind the best known head more than maxLag is considered unhealthy
+	maxLag pkgTypes.Level = 5
+	// penalty added to score of endpoint with error rate 1
+	errorPenalty = time.Second
+)
+
+// stats - health metrics of endpoint
+type stats struct {
+	latency   time.Duration
+	errorRate float64
+	head      pkgTypes.Level
+	mx        *sync.RWMutex
+}
+
+func newStats() *stats {
+	return &stats{
+		mx: new(sync.RWMutex),
+	}
+}
+
+func (s *stats) success(latency time.Duration) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.latency == 0 {
+		s.latency = latency
+	} else {
+		s.latency = time.Duration(alpha*float64(latency) + (1-alpha)*float64(s.latency))
+	}
+	s.errorRate = (1 - alpha) * s.errorRate
+}
+
+func (s *stats) failure() {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.errorRate = alpha + (1-alpha)*s.errorRate
+}
+
+func (s *stats) setHead(head pkgTypes.Level) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if head > s.head {
+		s.head = head
+	}
+}
+
+func (s *stats) snapshot() stats {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	return stats{
+		latency:   s.latency,
+		errorRate: s.errorRate,
+		head:      s.head,
+	}
+}
+
+// score - lower is better
+func (s stats) score() float64 {
+	return float64(s.latency)*(1+s.errorRate) + float64(errorPenalty)*s.errorRate
+}
+
+func (s stats) healthy(bestHead pkgTypes.Level) bool {
+	return s.errorRate <= maxErrorRate && s.head+maxLag >= bestHead
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/node"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// API - node.Api which reads chain data recorded by Recorder from directory. Node head is the highest recorded block.
+type API struct {
+	dir  string
+	head pkgTypes.Level
+}
+
+var _ node.Api = (*API)(nil)
+
+func NewAPI(dir string) (*API, error) {
+	last, err := lastBlock(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "scanning directory %s", dir)
+	}
+
+	return &API{
+		dir:  dir,
+		head: last,
+	}, nil
+}
+
+// Status - returns recorded status with the highest recorded block as the latest one
+func (api *API) Status(ctx context.Context) (types.Status, error) {
+	var status types.Status
+	if err := readFile(filepath.Join(api.dir, statusFile), &status); err != nil && !os.IsNotExist(err) {
+		return status, err
+	}
+
+	head := api.head
+	if head == 0 {
+		return status, errors.Errorf("there are no blocks in %s", api.dir)
+	}
+	block, err := api.Block(ctx, head)
+	if err != nil {
+		return status, err
+	}
+
+	status.SyncInfo.LatestBlockHeight = head
+	status.SyncInfo.LatestBlockHash = block.BlockID.Hash
+	if block.Block != nil {
+		status.SyncInfo.LatestBlockTime = block.Block.Time
+	}
+	status.SyncInfo.CatchingUp = false
+	return status, nil
+}
+
+func (api *API) Head(ctx context.Context) (pkgTypes.ResultBlock, error) {
+	return api.Block(ctx, api.head)
+}
+
+func (api *API) Block(ctx context.Context, level pkgTypes.Level) (pkgTypes.ResultBlock, error) {
+	block, err := api.readBlock(level)
+	return block.Block, err
+}
+
+func (api *API) BlockResults(ctx context.Context, level pkgTypes.Level) (pkgTypes.ResultBlockResults, error) {
+	block, err := api.readBlock(level)
+	return block.Results, err
+}
+
+func (api *API) Genesis(ctx context.Context) (types.Genesis, error) {
+	var genesis types.Genesis
+	if err := readFile(filepath.Join(api.dir, genesisFile), &genesis); err != nil {
+		return genesis, errors.Wrap(err, "reading genesis")
+	}
+	return genesis, nil
+}
+
+func (api *API) BlockData(ctx context.Context, level pkgTypes.Level) (pkgTypes.BlockData, error) {
+	block, err := api.readBlock(level)
+	if err != nil {
+		return pkgTypes.BlockData{}, err
+	}
+	return pkgTypes.BlockData{
+		ResultBlock:        block.Block,
+		ResultBlockResults: block.Results,
+	}, nil
+}
+
+func (api *API) BlockDataRange(ctx context.Context, from, to pkgTypes.Level) ([]pkgTypes.BlockData, error) {
+	if to < from {
+		return nil, errors.Errorf("invalid range: %d-%d", from, to)
+	}
+
+	blocks := make([]pkgTypes.BlockData, 0, to-from+1)
+	for level := from; level <= to; level++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		block, err := api.BlockData(ctx, level)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+func (api *API) readBlock(level pkgTypes.Level) (blockFile, error) {
+	var block blockFile
+	if err := readFile(blockPath(api.dir, level), &block); err != nil {
+		if os.IsNotExist(err) {
+			return block, errors.Wrapf(types.ErrRequest, "block %d is not recorded", level)
+		}
+		return block, errors.Wrapf(err, "reading block %d", level)
+	}
+	return block, nil
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package file
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/node/mock"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	tmTypes "github.com/tendermint/tendermint/types"
+	"go.uber.org/mock/gomock"
+)
+
+func blockData(level pkgTypes.Level) pkgTypes.BlockData {
+	return pkgTypes.BlockData{
+		ResultBlock: pkgTypes.ResultBlock{
+			BlockID: pkgTypes.BlockId{
+				Hash: pkgTypes.Hex{0x01, byte(level)},
+			},
+			Block: &pkgTypes.Block{
+				Header: pkgTypes.Header{
+					ChainID: "celestia",
+					Height:  int64(level),
+					Time:    time.Date(2023, 10, 1, 12, 0, int(level), 0, time.UTC),
+					LastBlockID: pkgTypes.BlockId{
+						Hash: pkgTypes.Hex{0x01, byte(level - 1)},
+					},
+					AppHash: pkgTypes.Hex{0x02},
+				},
+				Data: pkgTypes.Data{
+					Txs: tmTypes.Txs{tmTypes.Tx("tx")},
+				},
+			},
+		},
+		ResultBlockResults: pkgTypes.ResultBlockResults{
+			Height: level,
+			TxsResults: []*pkgTypes.ResponseDeliverTx{
+				{
+					GasWanted: 100,
+					GasUsed:   90,
+					Events: []pkgTypes.Event{
+						{
+							Type: "message",
+							Attributes: []pkgTypes.EventAttribute{
+								{Key: "action", Value: "/cosmos.bank.v1beta1.MsgSend", Index: true},
+							},
+						},
+					},
+				},
+			},
+			EndBlockEvents: []pkgTypes.Event{
+				{Type: "mint"},
+			},
+		},
+	}
+}
+
+func TestRecorder_ReplayedByAPI(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	node := mock.NewMockApi(ctrl)
+	node.EXPECT().Status(gomock.Any()).Return(types.Status{
+		NodeInfo: types.NodeInfo{Network: "celestia"},
+		SyncInfo: types.SyncInfo{LatestBlockHeight: 100},
+	}, nil).Times(1)
+	node.EXPECT().Genesis(gomock.Any()).Return(types.Genesis{ChainID: "celestia", InitialHeight: 1}, nil).Times(1)
+	node.EXPECT().BlockData(gomock.Any(), pkgTypes.Level(1)).Return(blockData(1), nil).Times(1)
+	node.EXPECT().BlockDataRange(gomock.Any(), pkgTypes.Level(2), pkgTypes.Level(3)).
+		Return([]pkgTypes.BlockData{blockData(2), blockData(3)}, nil).Times(1)
+
+	recorder, err := NewRecorder(node, dir)
+	require.NoError(t, err)
+
+	_, err = recorder.Status(ctx)
+	require.NoError(t, err)
+	_, err = recorder.Genesis(ctx)
+	require.NoError(t, err)
+	_, err = recorder.BlockData(ctx, 1)
+	require.NoError(t, err)
+	_, err = recorder.BlockDataRange(ctx, 2, 3)
+	require.NoError(t, err)
+
+	api, err := NewAPI(dir)
+	require.NoError(t, err)
+
+	status, err := api.Status(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "celestia", status.NodeInfo.Network)
+	require.EqualValues(t, 3, status.SyncInfo.LatestBlockHeight)
+	require.EqualValues(t, []byte{0x01, 0x03}, status.SyncInfo.LatestBlockHash)
+
+	genesis, err := api.Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "celestia", genesis.ChainID)
+	require.EqualValues(t, 1, genesis.InitialHeight)
+
+	block, err := api.BlockData(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, blockData(2), block)
+
+	blocks, err := api.BlockDataRange(ctx, 1, 3)
+	require.NoError(t, err)
+	require.Len(t, blocks, 3)
+	for i := range blocks {
+		require.Equal(t, blockData(pkgTypes.Level(i+1)), blocks[i])
+	}
+
+	head, err := api.Head(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, head.Block.Height)
+}
+
+func TestAPI_MissingBlock(t *testing.T) {
+	api, err := NewAPI(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = api.BlockData(context.Background(), 10)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrRequest))
+
+	_, err = api.Status(context.Background())
+	require.Error(t, err)
+}
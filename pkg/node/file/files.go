@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package file
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/goccy/go-json"
+	"github.com/pkg/errors"
+)
+
+// Directory layout:
+//
+//	<dir>/status.json.gz
+//	<dir>/genesis.json.gz
+//	<dir>/blocks/<height>.json.gz
+const (
+	extension   = ".json.gz"
+	statusFile  = "status" + extension
+	genesisFile = "genesis" + extension
+	blocksDir   = "blocks"
+)
+
+// blockFile - content of block file. Block and its results are stored separately
+// because embedded structures of pkgTypes.BlockData have conflicting JSON fields.
+type blockFile struct {
+	Block   pkgTypes.ResultBlock        `json:"block"`
+	Results pkgTypes.ResultBlockResults `json:"block_results"`
+}
+
+func blockPath(dir string, level pkgTypes.Level) string {
+	return filepath.Join(dir, blocksDir, level.String()+extension)
+}
+
+func readFile(path string, output any) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader, err := gzip.NewReader(f)
+	if err != nil {
+		return errors.Wrapf(err, "gzip reader: %s", path)
+	}
+	defer reader.Close()
+
+	if err := json.NewDecoder(reader).Decode(output); err != nil {
+		return errors.Wrapf(err, "decoding %s", path)
+	}
+	return nil
+}
+
+// writeFile - writes data to temporary file and renames it, so readers never see partially written file
+func writeFile(path string, data any) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	writer := gzip.NewWriter(tmp)
+	if err := json.NewEncoder(writer).Encode(data); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "encoding %s", path)
+	}
+	if err := writer.Close(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// lastBlock - returns the highest height stored in directory
+func lastBlock(dir string) (pkgTypes.Level, error) {
+	entries, err := os.ReadDir(filepath.Join(dir, blocksDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var last pkgTypes.Level
+	for i := range entries {
+		name, ok := strings.CutSuffix(entries[i].Name(), extension)
+		if !ok || entries[i].IsDir() {
+			continue
+		}
+		height, err := strconv.ParseUint(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		last = max(last, pkgTypes.Level(height))
+	}
+	return last, nil
+}
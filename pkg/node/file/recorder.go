@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package file
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/node"
+	"github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// Recorder - node.Api wrapper which writes received status, genesis and blocks to directory in format readable by API
+type Recorder struct {
+	api node.Api
+	dir string
+}
+
+var _ node.Api = (*Recorder)(nil)
+
+func NewRecorder(api node.Api, dir string) (*Recorder, error) {
+	if err := os.MkdirAll(filepath.Join(dir, blocksDir), 0o755); err != nil {
+		return nil, errors.Wrapf(err, "creating directory %s", dir)
+	}
+	return &Recorder{
+		api: api,
+		dir: dir,
+	}, nil
+}
+
+func (r *Recorder) Status(ctx context.Context) (types.Status, error) {
+	status, err := r.api.Status(ctx)
+	if err != nil {
+		return status, err
+	}
+	if err := writeFile(filepath.Join(r.dir, statusFile), status); err != nil {
+		return status, errors.Wrap(err, "recording status")
+	}
+	return status, nil
+}
+
+func (r *Recorder) Head(ctx context.Context) (pkgTypes.ResultBlock, error) {
+	return r.api.Head(ctx)
+}
+
+func (r *Recorder) Block(ctx context.Context, level pkgTypes.Level) (pkgTypes.ResultBlock, error) {
+	return r.api.Block(ctx, level)
+}
+
+func (r *Recorder) BlockResults(ctx context.Context, level pkgTypes.Level) (pkgTypes.ResultBlockResults, error) {
+	return r.api.BlockResults(ctx, level)
+}
+
+func (r *Recorder) Genesis(ctx context.Context) (types.Genesis, error) {
+	genesis, err := r.api.Genesis(ctx)
+	if err != nil {
+		return genesis, err
+	}
+	if err := writeFile(filepath.Join(r.dir, genesisFile), genesis); err != nil {
+		return genesis, errors.Wrap(err, "recording genesis")
+	}
+	return genesis, nil
+}
+
+func (r *Recorder) BlockData(ctx context.Context, level pkgTypes.Level) (pkgTypes.BlockData, error) {
+	block, err := r.api.BlockData(ctx, level)
+	if err != nil {
+		return block, err
+	}
+	if err := r.writeBlock(block); err != nil {
+		return block, err
+	}
+	return block, nil
+}
+
+func (r *Recorder) BlockDataRange(ctx context.Context, from, to pkgTypes.Level) ([]pkgTypes.BlockData, error) {
+	blocks, err := r.api.BlockDataRange(ctx, from, to)
+	if err != nil {
+		return blocks, err
+	}
+	for i := range blocks {
+		if err := r.writeBlock(blocks[i]); err != nil {
+			return blocks, err
+		}
+	}
+	return blocks, nil
+}
+
+func (r *Recorder) writeBlock(block pkgTypes.BlockData) error {
+	if err := writeFile(blockPath(r.dir, block.Height), blockFile{
+		Block:   block.ResultBlock,
+		Results: block.ResultBlockResults,
+	}); err != nil {
+		return errors.Wrapf(err, "recording block %d", block.Height)
+	}
+	return nil
+}
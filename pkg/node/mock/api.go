@@ -118,6 +118,45 @@ func (c *ApiBlockDataCall) DoAndReturn(f func(context.Context, types0.Level) (ty
 	return c
 }
 
+// BlockDataRange mocks base method.
+func (m *MockApi) BlockDataRange(ctx context.Context, from, to types0.Level) ([]types0.BlockData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockDataRange", ctx, from, to)
+	ret0, _ := ret[0].([]types0.BlockData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockDataRange indicates an expected call of BlockDataRange.
+func (mr *MockApiMockRecorder) BlockDataRange(ctx, from, to any) *ApiBlockDataRangeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockDataRange", reflect.TypeOf((*MockApi)(nil).BlockDataRange), ctx, from, to)
+	return &ApiBlockDataRangeCall{Call: call}
+}
+
+// ApiBlockDataRangeCall wrap *gomock.Call
+type ApiBlockDataRangeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *ApiBlockDataRangeCall) Return(arg0 []types0.BlockData, arg1 error) *ApiBlockDataRangeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *ApiBlockDataRangeCall) Do(f func(context.Context, types0.Level, types0.Level) ([]types0.BlockData, error)) *ApiBlockDataRangeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *ApiBlockDataRangeCall) DoAndReturn(f func(context.Context, types0.Level, types0.Level) ([]types0.BlockData, error)) *ApiBlockDataRangeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // BlockResults mocks base method.
 func (m *MockApi) BlockResults(ctx context.Context, level types0.Level) (types0.ResultBlockResults, error) {
 	m.ctrl.T.Helper()
@@ -379,3 +418,103 @@ func (c *DalApiBlobsCall) DoAndReturn(f func(context.Context, types0.Level, ...s
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSubscriber is a mock of Subscriber interface.
+type MockSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriberMockRecorder
+}
+
+// MockSubscriberMockRecorder is the mock recorder for MockSubscriber.
+type MockSubscriberMockRecorder struct {
+	mock *MockSubscriber
+}
+
+// NewMockSubscriber creates a new mock instance.
+func NewMockSubscriber(ctrl *gomock.Controller) *MockSubscriber {
+	mock := &MockSubscriber{ctrl: ctrl}
+	mock.recorder = &MockSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriber) EXPECT() *MockSubscriberMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSubscriber) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSubscriberMockRecorder) Close() *SubscriberCloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSubscriber)(nil).Close))
+	return &SubscriberCloseCall{Call: call}
+}
+
+// SubscriberCloseCall wrap *gomock.Call
+type SubscriberCloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *SubscriberCloseCall) Return(arg0 error) *SubscriberCloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *SubscriberCloseCall) Do(f func() error) *SubscriberCloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *SubscriberCloseCall) DoAndReturn(f func() error) *SubscriberCloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Subscribe mocks base method.
+func (m *MockSubscriber) Subscribe(ctx context.Context) (<-chan types0.Level, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx)
+	ret0, _ := ret[0].(<-chan types0.Level)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockSubscriberMockRecorder) Subscribe(ctx any) *SubscriberSubscribeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSubscriber)(nil).Subscribe), ctx)
+	return &SubscriberSubscribeCall{Call: call}
+}
+
+// SubscriberSubscribeCall wrap *gomock.Call
+type SubscriberSubscribeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *SubscriberSubscribeCall) Return(arg0 <-chan types0.Level, arg1 error) *SubscriberSubscribeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *SubscriberSubscribeCall) Do(f func(context.Context) (<-chan types0.Level, error)) *SubscriberSubscribeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *SubscriberSubscribeCall) DoAndReturn(f func(context.Context) (<-chan types0.Level, error)) *SubscriberSubscribeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -10,6 +10,7 @@ import (
 	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
 
 	"github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	"github.com/goccy/go-json"
 	"github.com/pkg/errors"
 )
 
@@ -79,3 +80,70 @@ func (api *API) BlockData(ctx context.Context, level pkgTypes.Level) (pkgTypes.B
 	blockData.ResultBlockResults = results.Result
 	return blockData, nil
 }
+
+// BlockDataRange - receives blocks and their results for levels from `from` to `to` inclusively in one JSON-RPC batch request.
+// Batch responses may come in any order, so they are matched with requests by id.
+func (api *API) BlockDataRange(ctx context.Context, from, to pkgTypes.Level) ([]pkgTypes.BlockData, error) {
+	if from > to {
+		return nil, errors.Errorf("invalid levels range: from=%d to=%d", from, to)
+	}
+
+	count := int(to-from) + 1
+	requests := make([]types.Request, 0, count*2)
+	for i := 0; i < count; i++ {
+		levelString := (from + pkgTypes.Level(i)).String()
+		requests = append(requests, types.Request{
+			Method:  pathBlock,
+			JsonRpc: "2.0",
+			Id:      int64(i * 2),
+			Params: []any{
+				levelString,
+			},
+		}, types.Request{
+			Method:  pathBlockResults,
+			JsonRpc: "2.0",
+			Id:      int64(i*2 + 1),
+			Params: []any{
+				levelString,
+			},
+		})
+	}
+
+	var responses []types.Response[json.RawMessage]
+	if err := api.post(ctx, requests, &responses); err != nil {
+		return nil, errors.Wrap(err, "api.post")
+	}
+	if len(responses) != len(requests) {
+		return nil, errors.Wrapf(types.ErrRequest, "unexpected count of batch responses: %d instead of %d", len(responses), len(requests))
+	}
+
+	var (
+		blockData = make([]pkgTypes.BlockData, count)
+		received  = make([]bool, len(requests))
+	)
+	for _, response := range responses {
+		if response.Id < 0 || response.Id >= int64(len(requests)) || received[response.Id] {
+			return nil, errors.Wrapf(types.ErrRequest, "unexpected batch response id: %d", response.Id)
+		}
+		received[response.Id] = true
+
+		idx := response.Id / 2
+		level := from + pkgTypes.Level(idx)
+		if response.Id%2 == 0 {
+			if response.Error != nil {
+				return nil, errors.Wrapf(types.ErrRequest, "block %d request error: %s", level, response.Error.Error())
+			}
+			if err := json.Unmarshal(response.Result, &blockData[idx].ResultBlock); err != nil {
+				return nil, errors.Wrapf(err, "decoding block %d", level)
+			}
+		} else {
+			if response.Error != nil {
+				return nil, errors.Wrapf(types.ErrRequest, "block results %d request error: %s", level, response.Error.Error())
+			}
+			if err := json.Unmarshal(response.Result, &blockData[idx].ResultBlockResults); err != nil {
+				return nil, errors.Wrapf(err, "decoding block results %d", level)
+			}
+		}
+	}
+	return blockData, nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	"github.com/dipdup-net/go-lib/config"
+	"github.com/stretchr/testify/require"
+)
+
+// newBatchServer - creates node which answers batch requests in reversed order
+func newBatchServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests []types.Request
+		if err := json.NewDecoder(r.Body).Decode(&requests); err != nil {
+			t.Errorf("decode batch request: %s", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		responses := make([]json.RawMessage, 0, len(requests))
+		for i := len(requests) - 1; i >= 0; i-- {
+			level := requests[i].Params[0]
+			var result string
+			switch requests[i].Method {
+			case pathBlock:
+				result = fmt.Sprintf(`{"block_id":{"hash":"0A"},"block":{"header":{"height":"%s"}}}`, level)
+			case pathBlockResults:
+				result = fmt.Sprintf(`{"height":"%s"}`, level)
+			default:
+				t.Errorf("unexpected method: %s", requests[i].Method)
+			}
+			responses = append(responses, json.RawMessage(fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":%s}`, requests[i].Id, result)))
+		}
+
+		if err := json.NewEncoder(w).Encode(responses); err != nil {
+			t.Errorf("encode batch response: %s", err)
+		}
+	}))
+}
+
+func TestAPI_BlockDataRange_ReorderedResponses(t *testing.T) {
+	server := newBatchServer(t)
+	defer server.Close()
+
+	api := NewAPI(config.DataSource{URL: server.URL, RequestsPerSecond: 10})
+
+	blocks, err := api.BlockDataRange(context.Background(), 100, 102)
+	require.NoError(t, err)
+	require.Len(t, blocks, 3)
+
+	for i := range blocks {
+		require.EqualValues(t, 100+i, blocks[i].ResultBlock.Block.Height)
+		require.EqualValues(t, 100+i, blocks[i].ResultBlockResults.Height)
+	}
+}
+
+func TestAPI_BlockDataRange_InvalidRange(t *testing.T) {
+	api := NewAPI(config.DataSource{URL: "http://localhost"})
+
+	_, err := api.BlockDataRange(context.Background(), 10, 9)
+	require.Error(t, err)
+}
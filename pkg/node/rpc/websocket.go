@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/dipdup-io/celestia-indexer/pkg/node/types"
+	pkgTypes "github.com/dipdup-io/celestia-indexer/pkg/types"
+	"github.com/dipdup-net/go-lib/config"
+	"github.com/goccy/go-json"
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	pathWebsocket    = "websocket"
+	newBlockQuery    = "tm.event='NewBlock'"
+	subscribeId      = 1
+	wsReadTimeout    = time.Minute
+	wsWriteTimeout   = 10 * time.Second
+	wsHeadsQueueSize = 16
+)
+
+// Websocket - client of CometBFT websocket endpoint which subscribes on new blocks
+type Websocket struct {
+	cfg  config.DataSource
+	conn *websocket.Conn
+	mx   *sync.Mutex
+	wg   *sync.WaitGroup
+	log  zerolog.Logger
+}
+
+func NewWebsocket(cfg config.DataSource) *Websocket {
+	return &Websocket{
+		cfg: cfg,
+		mx:  new(sync.Mutex),
+		wg:  new(sync.WaitGroup),
+		log: log.With().Str("module", "node websocket").Logger(),
+	}
+}
+
+// Subscribe - connects to node and subscribes on `NewBlock` events. Returned channel receives heights of new blocks
+// and is closed when connection is lost or context is done. Subscribe may be called again after the channel was closed.
+func (ws *Websocket) Subscribe(ctx context.Context) (<-chan pkgTypes.Level, error) {
+	if err := ws.Close(); err != nil {
+		return nil, err
+	}
+
+	u, err := websocketUrl(ws.cfg.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid websocket url")
+	}
+
+	dialer := *websocket.DefaultDialer
+	if ws.cfg.Timeout > 0 {
+		dialer.HandshakeTimeout = time.Duration(ws.cfg.Timeout) * time.Second
+	}
+
+	conn, _, err := dialer.DialContext(ctx, u, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "dial %s", u)
+	}
+
+	if err := conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+		return nil, closeOnError(conn, err)
+	}
+	if err := conn.WriteJSON(types.Request{
+		Method:  "subscribe",
+		Params:  []any{newBlockQuery},
+		Id:      subscribeId,
+		JsonRpc: "2.0",
+	}); err != nil {
+		return nil, closeOnError(conn, errors.Wrap(err, "subscribe request"))
+	}
+
+	if err := conn.SetReadDeadline(time.Now().Add(wsReadTimeout)); err != nil {
+		return nil, closeOnError(conn, err)
+	}
+	conn.SetPingHandler(func(appData string) error {
+		if err := conn.SetReadDeadline(time.Now().Add(wsReadTimeout)); err != nil {
+			return err
+		}
+		return conn.WriteControl(websocket.PongMessage, []byte(appData), time.Now().Add(wsWriteTimeout))
+	})
+
+	ws.mx.Lock()
+	ws.conn = conn
+	ws.mx.Unlock()
+
+	heads := make(chan pkgTypes.Level, wsHeadsQueueSize)
+	listenCtx, cancel := context.WithCancel(ctx)
+
+	ws.wg.Add(2)
+	go func() {
+		defer ws.wg.Done()
+		<-listenCtx.Done()
+		if err := conn.Close(); err != nil {
+			ws.log.Debug().Err(err).Msg("closing connection")
+		}
+	}()
+	go func() {
+		defer ws.wg.Done()
+		defer cancel()
+		ws.listen(listenCtx, conn, heads)
+	}()
+
+	ws.log.Info().Str("url", u).Msg("subscribed on new blocks")
+	return heads, nil
+}
+
+func (ws *Websocket) listen(ctx context.Context, conn *websocket.Conn, heads chan<- pkgTypes.Level) {
+	defer close(heads)
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			if ctx.Err() == nil {
+				ws.log.Err(err).Msg("reading message")
+			}
+			return
+		}
+		if err := conn.SetReadDeadline(time.Now().Add(wsReadTimeout)); err != nil {
+			ws.log.Err(err).Msg("set read deadline")
+			return
+		}
+
+		var response types.Response[types.NewBlockEvent]
+		if err := json.Unmarshal(data, &response); err != nil {
+			ws.log.Err(err).Msg("decoding message")
+			continue
+		}
+		if response.Error != nil {
+			ws.log.Err(response.Error).Msg("subscription error")
+			return
+		}
+		if response.Result.Data == nil {
+			// subscription acknowledgement
+			continue
+		}
+
+		level := pkgTypes.Level(response.Result.Data.Value.Block.Header.Height)
+		select {
+		case <-ctx.Done():
+			return
+		case heads <- level:
+		default:
+			// heights are monotonic, the next one covers the skipped
+			ws.log.Debug().Int64("height", int64(level)).Msg("heads queue is full, skip")
+		}
+	}
+}
+
+func (ws *Websocket) Close() error {
+	ws.mx.Lock()
+	conn := ws.conn
+	ws.conn = nil
+	ws.mx.Unlock()
+
+	if conn != nil {
+		if err := conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+			return err
+		}
+	}
+	ws.wg.Wait()
+	return nil
+}
+
+func websocketUrl(baseUrl string) (string, error) {
+	u, err := url.Parse(baseUrl)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "https", "wss":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+	u.Path, err = url.JoinPath(u.Path, pathWebsocket)
+	if err != nil {
+		return "", err
+	}
+	return u.String(), nil
+}
+
+func closeOnError(conn *websocket.Conn, err error) error {
+	if closeErr := conn.Close(); closeErr != nil {
+		return errors.Wrap(err, closeErr.Error())
+	}
+	return err
+}
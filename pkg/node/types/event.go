@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+// NewBlockEvent - result of `tm.event='NewBlock'` subscription. Only block header is decoded, the rest of block is received by RPC.
+type NewBlockEvent struct {
+	Query string             `json:"query"`
+	Data  *NewBlockEventData `json:"data,omitempty"`
+}
+
+type NewBlockEventData struct {
+	Type  string             `json:"type"`
+	Value NewBlockEventValue `json:"value"`
+}
+
+type NewBlockEventValue struct {
+	Block struct {
+		Header struct {
+			Height int64 `json:"height,string"`
+		} `json:"header"`
+	} `json:"block"`
+}
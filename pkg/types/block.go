@@ -27,10 +27,10 @@ type BlockId struct {
 
 // Block defines the atomic unit of a CometBFT blockchain.
 type Block struct {
-	Header `json:"header"`
-	Data   `json:"data"`
-	// Evidence   types.EvidenceData `json:"evidence"`
-	// LastCommit *Commit `json:"last_commit"`
+	Header     `json:"header"`
+	Data       `json:"data"`
+	Evidence   EvidenceData `json:"evidence"`
+	LastCommit *Commit      `json:"last_commit"`
 }
 
 // Consensus captures the consensus rules for processing a block in the blockchain,
@@ -88,13 +88,87 @@ type Data struct {
 
 // Commit contains the evidence that a block was committed by a set of validators.
 // NOTE: Commit is empty for height 1, but never nil.
-// type Commit struct {
-// 	// NOTE: The signatures are in order of address to preserve the bonded
-// 	// ValidatorSet order.
-// 	// Any peer with a block can gossip signatures by index with a peer without
-// 	// recalculating the active ValidatorSet.
-// 	Height     int64             `json:"height,string"`
-// 	Round      int32             `json:"round"`
-// 	BlockID    BlockId           `json:"block_id"`
-// 	Signatures []types.CommitSig `json:"signatures"`
-// }
+type Commit struct {
+	// NOTE: The signatures are in order of address to preserve the bonded
+	// ValidatorSet order.
+	// Any peer with a block can gossip signatures by index with a peer without
+	// recalculating the active ValidatorSet.
+	Height     int64       `json:"height,string"`
+	Round      int32       `json:"round"`
+	BlockID    BlockId     `json:"block_id"`
+	Signatures []CommitSig `json:"signatures"`
+}
+
+// BlockIDFlag indicates which BlockID the signature is for.
+type BlockIDFlag byte
+
+const (
+	// BlockIDFlagAbsent - no vote was received from a validator.
+	BlockIDFlagAbsent BlockIDFlag = iota + 1
+	// BlockIDFlagCommit - voted for the Commit.BlockID.
+	BlockIDFlagCommit
+	// BlockIDFlagNil - voted for nil.
+	BlockIDFlagNil
+)
+
+// CommitSig is a part of the Vote included in a Commit.
+type CommitSig struct {
+	BlockIDFlag      BlockIDFlag `json:"block_id_flag"`
+	ValidatorAddress Hex         `json:"validator_address"`
+	Timestamp        time.Time   `json:"timestamp"`
+	Signature        []byte      `json:"signature"`
+}
+
+// Signed - returns true if validator's vote was received: for the block or for nil
+func (sig CommitSig) Signed() bool {
+	return sig.BlockIDFlag != BlockIDFlagAbsent && len(sig.ValidatorAddress) > 0
+}
+
+// EvidenceData contains any evidence of malicious wrong-doing by validators
+type EvidenceData struct {
+	Evidence []Evidence `json:"evidence"`
+}
+
+const (
+	EvidenceTypeDuplicateVote     = "tendermint/DuplicateVoteEvidence"
+	EvidenceTypeLightClientAttack = "tendermint/LightClientAttackEvidence"
+)
+
+// Evidence - amino JSON representation of evidence
+type Evidence struct {
+	Type  string        `json:"type"`
+	Value EvidenceValue `json:"value"`
+}
+
+// EvidenceValue - union of fields of `DuplicateVoteEvidence` and `LightClientAttackEvidence`
+type EvidenceValue struct {
+	// DuplicateVoteEvidence
+	VoteA          *Vote `json:"vote_a,omitempty"`
+	VoteB          *Vote `json:"vote_b,omitempty"`
+	ValidatorPower int64 `json:"ValidatorPower,string"`
+
+	// LightClientAttackEvidence
+	CommonHeight        int64               `json:"CommonHeight,string"`
+	ByzantineValidators []EvidenceValidator `json:"ByzantineValidators"`
+
+	TotalVotingPower int64     `json:"TotalVotingPower,string"`
+	Timestamp        time.Time `json:"Timestamp"`
+}
+
+// Vote represents a prevote, precommit, or commit vote from validators for consensus.
+type Vote struct {
+	Type             int32     `json:"type"`
+	Height           int64     `json:"height,string"`
+	Round            int32     `json:"round"`
+	BlockID          BlockId   `json:"block_id"`
+	Timestamp        time.Time `json:"timestamp"`
+	ValidatorAddress Hex       `json:"validator_address"`
+	ValidatorIndex   int32     `json:"validator_index"`
+	Signature        []byte    `json:"signature"`
+}
+
+// EvidenceValidator - validator which is accused in light client attack
+type EvidenceValidator struct {
+	Address     Hex   `json:"address"`
+	VotingPower int64 `json:"voting_power,string"`
+}
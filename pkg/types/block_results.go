@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/goccy/go-json"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 // ResultBlockResults is an ABCI results from a block
@@ -52,8 +54,35 @@ type EventAttribute struct {
 
 // ValidatorUpdate
 type ValidatorUpdate struct {
-	// PubKey any   `json:"pub_key"                protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3"` // crypto.PublicKey
-	Power int64 `json:"power,omitempty,string" protobuf:"varint,2,opt,name=power,proto3"`
+	PubKey PublicKey `json:"pub_key"                protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3"`
+	Power  int64     `json:"power,omitempty,string" protobuf:"varint,2,opt,name=power,proto3"`
+}
+
+// PublicKey - consensus public key of validator. It's encoded as oneof structure.
+type PublicKey struct {
+	Sum PublicKeySum `json:"Sum"`
+}
+
+// Address - returns consensus address derived from the key. Nil is returned for unknown key type.
+func (pk PublicKey) Address() Hex {
+	switch {
+	case len(pk.Sum.Value.Ed25519) > 0:
+		return Hex(ed25519.PubKey(pk.Sum.Value.Ed25519).Address())
+	case len(pk.Sum.Value.Secp256k1) > 0:
+		return Hex(secp256k1.PubKey(pk.Sum.Value.Secp256k1).Address())
+	default:
+		return nil
+	}
+}
+
+type PublicKeySum struct {
+	Type  string         `json:"type"`
+	Value PublicKeyValue `json:"value"`
+}
+
+type PublicKeyValue struct {
+	Ed25519   []byte `json:"ed25519,omitempty"   protobuf:"bytes,1,opt,name=ed25519,proto3,oneof"`
+	Secp256k1 []byte `json:"secp256k1,omitempty" protobuf:"bytes,2,opt,name=secp256k1,proto3,oneof"`
 }
 
 // ConsensusParams contains all consensus-relevant parameters
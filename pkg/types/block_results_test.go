@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorUpdate_UnmarshalJSON(t *testing.T) {
+	key, err := base64.StdEncoding.DecodeString("Ogs4Ll8Xn3E7LztUnjodbwp0irU1M1KNoBJUi1ou5CE=")
+	require.NoError(t, err)
+	hash := sha256.Sum256(key)
+
+	data := []byte(`{"pub_key":{"Sum":{"type":"tendermint.crypto.PublicKey_Ed25519","value":{"ed25519":"Ogs4Ll8Xn3E7LztUnjodbwp0irU1M1KNoBJUi1ou5CE="}}},"power":"1000"}`)
+
+	var update ValidatorUpdate
+	err = json.Unmarshal(data, &update)
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, update.Power)
+	require.Equal(t, "tendermint.crypto.PublicKey_Ed25519", update.PubKey.Sum.Type)
+	require.Equal(t, key, update.PubKey.Sum.Value.Ed25519)
+	require.Equal(t, Hex(hash[:20]), update.PubKey.Address())
+}
+
+func TestPublicKey_AddressEmpty(t *testing.T) {
+	var pk PublicKey
+	require.Nil(t, pk.Address())
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2023 PK Lab AG <contact@pklab.io>
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommit_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"height": "100",
+		"round": 0,
+		"block_id": {
+			"hash": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF",
+			"parts": {"total": 1, "hash": "C0B6A634B72AE9687EA53B6D277A73ABA1386BA3CFC6D0F26963602F7F6FFCD6"}
+		},
+		"signatures": [
+			{
+				"block_id_flag": 2,
+				"validator_address": "0102030405060708090A0B0C0D0E0F1011121314",
+				"timestamp": "2023-07-04T03:10:57.123Z",
+				"signature": "AQID"
+			},
+			{
+				"block_id_flag": 1,
+				"validator_address": "",
+				"timestamp": "0001-01-01T00:00:00Z",
+				"signature": null
+			},
+			{
+				"block_id_flag": 3,
+				"validator_address": "1415161718191A1B1C1D1E1F2021222324252627",
+				"timestamp": "2023-07-04T03:10:57.456Z",
+				"signature": "BAUG"
+			}
+		]
+	}`)
+
+	var commit Commit
+	err := json.Unmarshal(data, &commit)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, commit.Height)
+	require.Equal(t, "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF", commit.BlockID.Hash.String())
+	require.Len(t, commit.Signatures, 3)
+
+	require.Equal(t, BlockIDFlagCommit, commit.Signatures[0].BlockIDFlag)
+	require.Equal(t, "0102030405060708090A0B0C0D0E0F1011121314", commit.Signatures[0].ValidatorAddress.String())
+	require.Equal(t, time.Date(2023, 7, 4, 3, 10, 57, 123000000, time.UTC), commit.Signatures[0].Timestamp)
+	require.Equal(t, []byte{1, 2, 3}, commit.Signatures[0].Signature)
+	require.True(t, commit.Signatures[0].Signed())
+
+	require.Equal(t, BlockIDFlagAbsent, commit.Signatures[1].BlockIDFlag)
+	require.Empty(t, commit.Signatures[1].ValidatorAddress)
+	require.False(t, commit.Signatures[1].Signed())
+
+	require.Equal(t, BlockIDFlagNil, commit.Signatures[2].BlockIDFlag)
+	require.True(t, commit.Signatures[2].Signed())
+}
+
+func TestEvidenceData_UnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"evidence": [
+			{
+				"type": "tendermint/DuplicateVoteEvidence",
+				"value": {
+					"vote_a": {
+						"type": 2,
+						"height": "100",
+						"round": 0,
+						"block_id": {"hash": "652452A670018D629CC116E510BA88C1CABE061336661B1F3D206D248BD558AF"},
+						"timestamp": "2023-07-04T03:10:57Z",
+						"validator_address": "0102030405060708090A0B0C0D0E0F1011121314",
+						"validator_index": 1,
+						"signature": "AQID"
+					},
+					"vote_b": {
+						"type": 2,
+						"height": "100",
+						"round": 0,
+						"block_id": {"hash": "5F7A8DDFE6136FE76B65B9066D4F816D707F28C05B3362D66084664C5B39BA98"},
+						"timestamp": "2023-07-04T03:10:57Z",
+						"validator_address": "0102030405060708090A0B0C0D0E0F1011121314",
+						"validator_index": 1,
+						"signature": "BAUG"
+					},
+					"TotalVotingPower": "1000",
+					"ValidatorPower": "100",
+					"Timestamp": "2023-07-04T03:10:56Z"
+				}
+			},
+			{
+				"type": "tendermint/LightClientAttackEvidence",
+				"value": {
+					"ConflictingBlock": {},
+					"CommonHeight": "90",
+					"ByzantineValidators": [
+						{
+							"address": "1415161718191A1B1C1D1E1F2021222324252627",
+							"pub_key": {"type": "tendermint/PubKeyEd25519", "value": "Ogs4Ll8Xn3E7LztUnjodbwp0irU1M1KNoBJUi1ou5CE="},
+							"voting_power": "50",
+							"proposer_priority": "0"
+						}
+					],
+					"TotalVotingPower": "1000",
+					"Timestamp": "2023-07-04T03:10:50Z"
+				}
+			}
+		]
+	}`)
+
+	var evidence EvidenceData
+	err := json.Unmarshal(data, &evidence)
+	require.NoError(t, err)
+	require.Len(t, evidence.Evidence, 2)
+
+	duplicateVote := evidence.Evidence[0]
+	require.Equal(t, EvidenceTypeDuplicateVote, duplicateVote.Type)
+	require.NotNil(t, duplicateVote.Value.VoteA)
+	require.NotNil(t, duplicateVote.Value.VoteB)
+	require.EqualValues(t, 100, duplicateVote.Value.VoteA.Height)
+	require.Equal(t, "0102030405060708090A0B0C0D0E0F1011121314", duplicateVote.Value.VoteA.ValidatorAddress.String())
+	require.EqualValues(t, 1000, duplicateVote.Value.TotalVotingPower)
+	require.EqualValues(t, 100, duplicateVote.Value.ValidatorPower)
+	require.Equal(t, time.Date(2023, 7, 4, 3, 10, 56, 0, time.UTC), duplicateVote.Value.Timestamp)
+
+	lightClientAttack := evidence.Evidence[1]
+	require.Equal(t, EvidenceTypeLightClientAttack, lightClientAttack.Type)
+	require.Nil(t, lightClientAttack.Value.VoteA)
+	require.EqualValues(t, 90, lightClientAttack.Value.CommonHeight)
+	require.Len(t, lightClientAttack.Value.ByzantineValidators, 1)
+	require.Equal(t, "1415161718191A1B1C1D1E1F2021222324252627", lightClientAttack.Value.ByzantineValidators[0].Address.String())
+	require.EqualValues(t, 50, lightClientAttack.Value.ByzantineValidators[0].VotingPower)
+	require.EqualValues(t, 1000, lightClientAttack.Value.TotalVotingPower)
+}